@@ -0,0 +1,466 @@
+package winrt
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+)
+
+// Kind describes how the values of a type are represented in the WinRT ABI.
+type Kind int
+
+// ABI kinds
+const (
+	// KindPrimitive is used by the fundamental types (integers, floats and booleans). They are passed by value.
+	KindPrimitive Kind = iota
+	// KindString is used by strings, which are passed as HSTRING handles.
+	KindString
+	// KindEnum is used by enums. They are passed by value using their underlying integer type.
+	KindEnum
+	// KindStruct is used by structs. They are passed by value or by reference depending on their size and the
+	// platform, see passedByReference.
+	KindStruct
+	// KindInterface is used by interfaces, delegates and runtime classes. They are passed as pointers.
+	KindInterface
+)
+
+// KindOf returns the ABI kind of the given type.
+func KindOf[T any]() Kind {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	switch t.Kind() {
+	case reflect.String:
+		return KindString
+	case reflect.Ptr, reflect.UnsafePointer:
+		return KindInterface
+	case reflect.Struct:
+		return KindStruct
+	}
+
+	// enums are integers with a WinRT signature
+	if t.Implements(signerType) {
+		return KindEnum
+	}
+	return KindPrimitive
+}
+
+// abiArch is the architecture whose calling convention is followed to pass arguments to the methods and to decode
+// the arguments received by the delegates.
+var abiArch = runtime.GOARCH
+
+// archSlotSize returns the size of the registers and stack slots used to pass arguments on the given architecture.
+func archSlotSize(arch string) uintptr {
+	switch arch {
+	case "386", "arm":
+		return 4
+	}
+	return 8
+}
+
+// passedByReference returns true if values of the given size are passed as a pointer to a copy of the value
+// on the given architecture. It applies both to the parameters of the methods and to the arguments received
+// by the delegates.
+func passedByReference(size uintptr, arch string) bool {
+	switch arch {
+	case "386", "arm":
+		// 32-bit platforms push the whole value to the stack, spanning as many slots as required
+		return false
+	case "arm64":
+		// the arm64 calling convention passes composites of up to 16 bytes in one or two registers, and the
+		// larger ones by reference
+		return size > 16
+	}
+
+	// the x64 calling convention passes values that are not 1, 2, 4 or 8 bytes long by reference
+	switch size {
+	case 1, 2, 4, 8:
+		return false
+	}
+	return true
+}
+
+// slotCount returns the number of slots spanned by a value of the given size passed by value.
+func slotCount(size, slotSize uintptr) int {
+	return int((size + slotSize - 1) / slotSize)
+}
+
+// inFloatRegisters returns true if values of the given type are passed in floating point registers, when they are
+// passed in a register. The x64 calling convention only uses them for floats, while the ARM ones also use them for
+// the structs made of up to four floats of the same type (homogeneous floating-point aggregates).
+func inFloatRegisters(t reflect.Type, arch string) bool {
+	switch arch {
+	case "amd64":
+		return isFloat(t)
+	case "arm", "arm64":
+		return isFloat(t) || isFloatAggregate(t)
+	}
+	return false
+}
+
+func isFloat(t reflect.Type) bool {
+	return t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+}
+
+// isFloatAggregate returns true if the given type is a struct made of one to four floats of the same type,
+// including the floats of its nested structs.
+func isFloatAggregate(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	var fields []reflect.Kind
+	var flatten func(t reflect.Type) bool
+	flatten = func(t reflect.Type) bool {
+		switch {
+		case isFloat(t):
+			fields = append(fields, t.Kind())
+			return true
+		case t.Kind() == reflect.Struct:
+			for i := 0; i < t.NumField(); i++ {
+				if !flatten(t.Field(i).Type) {
+					return false
+				}
+			}
+			return true
+		}
+		return false
+	}
+	if !flatten(t) || len(fields) == 0 || len(fields) > 4 {
+		return false
+	}
+	for _, kind := range fields {
+		if kind != fields[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// inValueError returns an error if values of the given type can not be passed to the methods on the given
+// architecture. syscall.SyscallN only loads the floating point registers on amd64, where it copies the
+// first arguments to them.
+func inValueError(t reflect.Type, arch string) error {
+	if arch != "amd64" && inFloatRegisters(t, arch) {
+		return fmt.Errorf("winrt: %v parameters are not supported on %s, they are passed in floating point registers", t, arch)
+	}
+	return nil
+}
+
+// DelegateArgError returns an error if the delegates can not receive a value of type T as the argument at the given
+// index, the first one following the delegate itself. Delegates can not receive the values passed in floating point
+// registers: floats on 64-bit platforms, and the structs made of floats on ARM.
+func DelegateArgError[T any](index int) error {
+	return delegateArgError(reflect.TypeOf((*T)(nil)).Elem(), abiArch, index)
+}
+
+// delegateArgError returns an error if the delegates can not receive values of the given type as the argument
+// stored in the given raw slot, the first one following the delegate itself. The callbacks created by
+// syscall.NewCallback only capture the integer registers, so the arguments passed in floating point registers
+// are lost: the first three arguments on amd64, and any of them on ARM.
+func delegateArgError(t reflect.Type, arch string, slot int) error {
+	if !inFloatRegisters(t, arch) || (arch == "amd64" && slot >= 3) {
+		return nil
+	}
+	return fmt.Errorf("winrt: delegates can not receive %v arguments on %s, they are passed in floating point registers", t, arch)
+}
+
+// InValue holds the ABI representation of an input parameter of type T.
+// Release must be called once the value is no longer used.
+type InValue[T any] struct {
+	value T
+	hstr  ole.HString
+}
+
+// NewInValue converts the given value to its ABI representation. It fails for the values that the platform passes
+// in floating point registers, except on amd64.
+func NewInValue[T any](value T) (*InValue[T], error) {
+	if err := inValueError(reflect.TypeOf(&value).Elem(), abiArch); err != nil {
+		return nil, err
+	}
+	in := &InValue[T]{value: value}
+	if KindOf[T]() == KindString {
+		hstr, err := ole.NewHString(*(*string)(unsafe.Pointer(&in.value)))
+		if err != nil {
+			return nil, err
+		}
+		in.hstr = hstr
+	}
+	return in, nil
+}
+
+// ABI returns the raw values to pass as parameter. Values are usually passed using a single slot, but they may
+// span several of them, like 64-bit values on 32-bit platforms.
+func (in *InValue[T]) ABI() []uintptr {
+	return in.slots(abiArch)
+}
+
+// slots returns the raw values to pass as parameter on the given architecture.
+func (in *InValue[T]) slots(arch string) []uintptr {
+	if KindOf[T]() == KindString {
+		return []uintptr{uintptr(in.hstr)}
+	}
+
+	size, slotSize := unsafe.Sizeof(in.value), archSlotSize(arch)
+	if passedByReference(size, arch) {
+		return []uintptr{uintptr(unsafe.Pointer(&in.value))}
+	}
+
+	// the value is stored in the lower bytes of as many slots as required, Windows only runs on little
+	// endian platforms
+	slots := make([]uintptr, slotCount(size, slotSize))
+	src := unsafe.Slice((*byte)(unsafe.Pointer(&in.value)), size)
+	for k, b := range src {
+		slots[uintptr(k)/slotSize] |= uintptr(b) << (8 * (uintptr(k) % slotSize))
+	}
+	return slots
+}
+
+// Release frees the resources allocated by NewInValue.
+func (in *InValue[T]) Release() {
+	if in.hstr != 0 {
+		_ = ole.DeleteHString(in.hstr)
+		in.hstr = 0
+	}
+}
+
+// OutValue holds the ABI representation of an output parameter of type T.
+type OutValue[T any] struct {
+	value T
+	hstr  ole.HString
+}
+
+// Addr returns the address the callee writes the output value to.
+func (out *OutValue[T]) Addr() unsafe.Pointer {
+	if KindOf[T]() == KindString {
+		return unsafe.Pointer(&out.hstr)
+	}
+	return unsafe.Pointer(&out.value)
+}
+
+// Value converts the received ABI value into T. HSTRING handles are released, so this should
+// only be called once.
+func (out *OutValue[T]) Value() T {
+	if KindOf[T]() == KindString {
+		s := out.hstr.String()
+		_ = ole.DeleteHString(out.hstr)
+		out.hstr = 0
+		*(*string)(unsafe.Pointer(&out.value)) = s
+	}
+	return out.value
+}
+
+// ArrayPointer returns the address of the first element of the given array, to pass it as parameter, or nil if
+// the array is empty.
+func ArrayPointer[T any](values []T) unsafe.Pointer {
+	if len(values) == 0 {
+		return nil
+	}
+	return unsafe.Pointer(&values[0])
+}
+
+// InArray holds the ABI representation of an input array of type T, converting strings to HSTRING handles.
+// Release must be called once the array is no longer used.
+type InArray[T any] struct {
+	values []T
+	hstrs  []ole.HString
+}
+
+// NewInArray converts the given array to its ABI representation.
+func NewInArray[T any](values []T) (*InArray[T], error) {
+	in := &InArray[T]{values: values}
+	if KindOf[T]() == KindString {
+		in.hstrs = make([]ole.HString, len(values))
+		for i := range values {
+			hstr, err := ole.NewHString(*(*string)(unsafe.Pointer(&values[i])))
+			if err != nil {
+				in.Release()
+				return nil, err
+			}
+			in.hstrs[i] = hstr
+		}
+	}
+	return in, nil
+}
+
+// Pointer returns the address of the first element to pass as parameter, or nil if the array is empty.
+func (in *InArray[T]) Pointer() unsafe.Pointer {
+	if KindOf[T]() == KindString {
+		return ArrayPointer(in.hstrs)
+	}
+	return ArrayPointer(in.values)
+}
+
+// Release frees the resources allocated by NewInArray.
+func (in *InArray[T]) Release() {
+	for _, hstr := range in.hstrs {
+		if hstr != 0 {
+			_ = ole.DeleteHString(hstr)
+		}
+	}
+	in.hstrs = nil
+}
+
+// OutArray holds the ABI representation of an output array of type T, filled by the callee.
+type OutArray[T any] struct {
+	values []T
+	hstrs  []ole.HString
+}
+
+// NewOutArray returns an output array with the given number of elements.
+func NewOutArray[T any](size uint32) *OutArray[T] {
+	if KindOf[T]() == KindString {
+		return &OutArray[T]{hstrs: make([]ole.HString, size)}
+	}
+	return &OutArray[T]{values: make([]T, size)}
+}
+
+// Pointer returns the address of the first element the callee writes to, or nil if the array is empty.
+func (out *OutArray[T]) Pointer() unsafe.Pointer {
+	if KindOf[T]() == KindString {
+		return ArrayPointer(out.hstrs)
+	}
+	return ArrayPointer(out.values)
+}
+
+// Values converts the received elements into T. HSTRING handles are released, so this should only be called once.
+func (out *OutArray[T]) Values() []T {
+	if KindOf[T]() == KindString && out.hstrs != nil {
+		out.values = make([]T, len(out.hstrs))
+		for i, hstr := range out.hstrs {
+			if hstr == 0 {
+				continue
+			}
+			*(*string)(unsafe.Pointer(&out.values[i])) = hstr.String()
+			_ = ole.DeleteHString(hstr)
+		}
+		out.hstrs = nil
+	}
+	return out.values
+}
+
+// DelegateArgs decodes the raw arguments received by a delegate. Arguments are received as register
+// (or stack slot) sized values, so depending on the platform and the type of the argument, a single
+// argument may span several of them (64-bit integers on 386) or be passed by reference (large structs
+// on 64-bit platforms).
+type DelegateArgs struct {
+	raw  []uintptr
+	next int
+	arch string
+}
+
+// NewDelegateArgs returns a decoder for the given raw arguments.
+func NewDelegateArgs(raw ...unsafe.Pointer) *DelegateArgs {
+	slots := make([]uintptr, len(raw))
+	for i, r := range raw {
+		slots[i] = uintptr(r)
+	}
+	return newDelegateArgs(abiArch, slots)
+}
+
+func newDelegateArgs(arch string, raw []uintptr) *DelegateArgs {
+	return &DelegateArgs{
+		raw:  raw,
+		arch: arch,
+	}
+}
+
+// take returns the next n raw values.
+func (a *DelegateArgs) take(n int) []uintptr {
+	if a.next+n > len(a.raw) {
+		panic("winrt: delegate received fewer arguments than expected")
+	}
+	slots := a.raw[a.next : a.next+n]
+	a.next += n
+	return slots
+}
+
+// NextDelegateArg decodes the next argument as a value of type T. The argument is not owned by the delegate,
+// so HSTRING handles and interfaces are not released. It panics if the argument was passed in a floating
+// point register, which the delegates can not read.
+func NextDelegateArg[T any](a *DelegateArgs) T {
+	var value T
+	size := unsafe.Sizeof(value)
+
+	switch KindOf[T]() {
+	case KindString:
+		hstr := ole.HString(a.take(1)[0])
+		*(*string)(unsafe.Pointer(&value)) = hstr.String()
+		return value
+	case KindInterface:
+		*(*uintptr)(unsafe.Pointer(&value)) = a.take(1)[0]
+		return value
+	}
+
+	if err := delegateArgError(reflect.TypeOf(&value).Elem(), a.arch, a.next); err != nil {
+		panic(err)
+	}
+
+	if passedByReference(size, a.arch) {
+		slot := a.take(1)[0]
+		return *(*T)(*(*unsafe.Pointer)(unsafe.Pointer(&slot)))
+	}
+
+	// The value is stored in the lower bytes of as many slots as required, any upper byte may contain
+	// garbage (booleans only use the lowest byte, etc.).
+	// Windows only runs on little endian platforms.
+	slotSize := archSlotSize(a.arch)
+	n := slotCount(size, slotSize)
+	if n == 0 {
+		return value
+	}
+	dst := unsafe.Slice((*byte)(unsafe.Pointer(&value)), size)
+	for i, slot := range a.take(n) {
+		for j := uintptr(0); j < slotSize; j++ {
+			if k := uintptr(i)*slotSize + j; k < size {
+				dst[k] = byte(uint64(slot) >> (8 * j))
+			}
+		}
+	}
+	return value
+}
+
+// WriteValue writes the ABI representation of the given value through an out pointer received by a delegate.
+// The caller takes ownership of the written value, so strings are copied into a new HSTRING and interfaces
+// are AddRef'd.
+func WriteValue[T any](out unsafe.Pointer, value T) error {
+	if out == nil {
+		return ole.NewError(ole.E_POINTER)
+	}
+
+	switch KindOf[T]() {
+	case KindString:
+		hstr, err := ole.NewHString(*(*string)(unsafe.Pointer(&value)))
+		if err != nil {
+			return err
+		}
+		*(*ole.HString)(out) = hstr
+		return nil
+	case KindInterface:
+		if p := *(*unsafe.Pointer)(unsafe.Pointer(&value)); p != nil {
+			(*ole.IUnknown)(p).AddRef()
+		}
+	}
+
+	*(*T)(out) = value
+	return nil
+}
+
+// HResultFromError returns the HRESULT to report through the ABI for the given error.
+// Errors that do not carry an HRESULT are reported as E_FAIL.
+func HResultFromError(err error) uintptr {
+	if err == nil {
+		return ole.S_OK
+	}
+
+	var hr HResult
+	if errors.As(err, &hr) {
+		return uintptr(hr)
+	}
+	var oleErr *ole.OleError
+	if errors.As(err, &oleErr) {
+		return oleErr.Code()
+	}
+	return ole.E_FAIL
+}
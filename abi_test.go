@@ -0,0 +1,314 @@
+package winrt
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSmallStruct struct {
+	Value int32
+}
+
+func (testSmallStruct) Signature() string { return "struct(Test.SmallStruct;i4)" }
+
+type testLargeStruct struct {
+	A, B, C int64
+}
+
+func (testLargeStruct) Signature() string { return "struct(Test.LargeStruct;i8;i8;i8)" }
+
+func TestKindOf(t *testing.T) {
+	assert.Equal(t, KindPrimitive, KindOf[uint32]())
+	assert.Equal(t, KindPrimitive, KindOf[bool]())
+	assert.Equal(t, KindString, KindOf[string]())
+	assert.Equal(t, KindEnum, KindOf[testEnum]())
+	assert.Equal(t, KindStruct, KindOf[testSmallStruct]())
+	assert.Equal(t, KindInterface, KindOf[*testClass]())
+	assert.Equal(t, KindInterface, KindOf[unsafe.Pointer]())
+}
+
+type testOddStruct struct {
+	A, B, C byte
+}
+
+type testPoint struct {
+	X, Y, Z float32
+}
+
+type testMixedStruct struct {
+	X float32
+	Y float64
+}
+
+func TestPassedByReference(t *testing.T) {
+	for _, size := range []uintptr{1, 2, 4, 8} {
+		assert.False(t, passedByReference(size, "amd64"), "size %d", size)
+	}
+	for _, size := range []uintptr{3, 5, 6, 7, 12, 16, 24} {
+		assert.True(t, passedByReference(size, "amd64"), "size %d", size)
+	}
+	// arm64 passes composites of up to 16 bytes in registers
+	for _, size := range []uintptr{1, 3, 8, 12, 16} {
+		assert.False(t, passedByReference(size, "arm64"), "size %d", size)
+	}
+	for _, size := range []uintptr{17, 24} {
+		assert.True(t, passedByReference(size, "arm64"), "size %d", size)
+	}
+	// 32-bit platforms pass every value by value
+	for _, size := range []uintptr{1, 3, 4, 8, 16} {
+		assert.False(t, passedByReference(size, "386"), "size %d", size)
+	}
+}
+
+func TestInFloatRegisters(t *testing.T) {
+	for _, arch := range []string{"amd64", "arm64"} {
+		assert.True(t, inFloatRegisters(reflect.TypeOf(float32(0)), arch), arch)
+		assert.True(t, inFloatRegisters(reflect.TypeOf(float64(0)), arch), arch)
+		assert.False(t, inFloatRegisters(reflect.TypeOf(int32(0)), arch), arch)
+		assert.False(t, inFloatRegisters(reflect.TypeOf(testMixedStruct{}), arch), arch)
+	}
+	assert.False(t, inFloatRegisters(reflect.TypeOf(float64(0)), "386"))
+
+	// structs made of floats of the same type only use them on ARM
+	assert.False(t, inFloatRegisters(reflect.TypeOf(testPoint{}), "amd64"))
+	assert.True(t, inFloatRegisters(reflect.TypeOf(testPoint{}), "arm64"))
+	assert.False(t, inFloatRegisters(reflect.TypeOf(struct{ A, B testPoint }{}), "arm64"))
+	assert.True(t, inFloatRegisters(reflect.TypeOf(struct{ V struct{ X, Y float64 } }{}), "arm64"))
+
+	// the methods only receive them on amd64, and the delegates on 386 or past the registers on amd64
+	assert.NoError(t, inValueError(reflect.TypeOf(float64(0)), "amd64"))
+	assert.Error(t, inValueError(reflect.TypeOf(float64(0)), "arm64"))
+	assert.Error(t, inValueError(reflect.TypeOf(testPoint{}), "arm64"))
+	assert.NoError(t, inValueError(reflect.TypeOf(testLargeStruct{}), "arm64"))
+	assert.Error(t, delegateArgError(reflect.TypeOf(float32(0)), "amd64", 2))
+	assert.NoError(t, delegateArgError(reflect.TypeOf(float32(0)), "amd64", 3))
+	assert.Error(t, delegateArgError(reflect.TypeOf(float32(0)), "arm64", 5))
+	assert.NoError(t, delegateArgError(reflect.TypeOf(float32(0)), "386", 0))
+}
+
+func TestInValueRoundTrip(t *testing.T) {
+	assertRoundTrip(t, int32(-42))
+	assertRoundTrip(t, uint8(200))
+	assertRoundTrip(t, true)
+	assertRoundTrip(t, int64(-1<<40))
+	assertRoundTrip(t, testEnum(3))
+	assertRoundTrip(t, testSmallStruct{Value: 7})
+	assertRoundTrip(t, testOddStruct{A: 1, B: 2, C: 3})
+	assertRoundTrip(t, testToken{Value: 1<<40 + 2})
+	assertRoundTrip(t, testLargeStruct{A: 1, B: 2, C: 3})
+	assertRoundTrip(t, testMixedStruct{X: 1.5, Y: -2.25})
+	assertRoundTrip(t, &testClass{})
+}
+
+func assertRoundTrip[T any](t *testing.T, value T) {
+	t.Helper()
+
+	in, err := NewInValue(value)
+	assert.NoError(t, err)
+	defer in.Release()
+
+	// both directions share the same rules
+	for _, arch := range []string{"amd64", "arm64", "386"} {
+		if KindOf[T]() == KindInterface && archSlotSize(arch) < unsafe.Sizeof(uintptr(0)) {
+			continue
+		}
+		slots := in.slots(arch)
+		if !passedByReference(unsafe.Sizeof(value), arch) {
+			// values passed by value on 32-bit platforms span several slots
+			assert.Len(t, slots, slotCount(unsafe.Sizeof(value), archSlotSize(arch)), arch)
+		}
+		args := newDelegateArgs(arch, slots)
+		assert.Equal(t, value, NextDelegateArg[T](args), arch)
+		assert.Equal(t, len(args.raw), args.next, arch)
+	}
+}
+
+func TestInValueSlots(t *testing.T) {
+	in, err := NewInValue(int64(0x00000001_00000002))
+	assert.NoError(t, err)
+	assert.Equal(t, []uintptr{2, 1}, in.slots("386"))
+
+	if unsafe.Sizeof(uintptr(0)) < 8 {
+		t.Skip("pointers are 32 bits long")
+	}
+
+	// on amd64, structs that are not 1, 2, 4 or 8 bytes long are passed by reference
+	odd, err := NewInValue(testOddStruct{A: 1, B: 2, C: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []uintptr{uintptr(unsafe.Pointer(&odd.value))}, odd.slots("amd64"))
+	assert.Equal(t, []uintptr{0x030201}, odd.slots("arm64"))
+
+	token, err := NewInValue(testToken{Value: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []uintptr{3}, token.slots("amd64"))
+
+	// on arm64, structs of up to 16 bytes are passed in registers
+	guid, err := NewInValue(testGUID{Data1: 1, Data2: 2, Data3: 3, Data4: [8]byte{4, 5, 6, 7, 8, 9, 10, 11}})
+	assert.NoError(t, err)
+	assert.Equal(t, []uintptr{uintptr(unsafe.Pointer(&guid.value))}, guid.slots("amd64"))
+	assert.Equal(t, slots(0x00030002_00000001, 0x0b0a0908_07060504), guid.slots("arm64"))
+
+	large, err := NewInValue(testLargeStruct{A: 1, B: 2, C: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []uintptr{uintptr(unsafe.Pointer(&large.value))}, large.slots("arm64"))
+}
+
+func TestArrays(t *testing.T) {
+	assert.Equal(t, unsafe.Pointer(nil), ArrayPointer([]uint32{}))
+	assert.Equal(t, unsafe.Pointer(nil), ArrayPointer[uint32](nil))
+	values := []uint32{1, 2}
+	assert.Equal(t, unsafe.Pointer(&values[0]), ArrayPointer(values))
+
+	in, err := NewInArray(values)
+	assert.NoError(t, err)
+	assert.Equal(t, unsafe.Pointer(&values[0]), in.Pointer())
+	in.Release()
+
+	empty, err := NewInArray([]*testClass{})
+	assert.NoError(t, err)
+	assert.Equal(t, unsafe.Pointer(nil), empty.Pointer())
+
+	out := NewOutArray[uint32](2)
+	(*[2]uint32)(out.Pointer())[1] = 7
+	assert.Equal(t, []uint32{0, 7}, out.Values())
+	assert.Equal(t, unsafe.Pointer(nil), NewOutArray[uint32](0).Pointer())
+
+	// strings are passed as HSTRING handles
+	strs := NewOutArray[string](2)
+	assert.Len(t, strs.hstrs, 2)
+	assert.Equal(t, unsafe.Pointer(&strs.hstrs[0]), strs.Pointer())
+	assert.Equal(t, []string{"", ""}, strs.Values())
+}
+
+func TestOutValue(t *testing.T) {
+	var out OutValue[int64]
+	*(*int64)(out.Addr()) = -1
+	assert.Equal(t, int64(-1), out.Value())
+
+	var outStruct OutValue[testLargeStruct]
+	*(*testLargeStruct)(outStruct.Addr()) = testLargeStruct{A: 1, B: 2, C: 3}
+	assert.Equal(t, testLargeStruct{A: 1, B: 2, C: 3}, outStruct.Value())
+}
+
+func TestWriteValue(t *testing.T) {
+	var i uint32
+	assert.NoError(t, WriteValue(unsafe.Pointer(&i), uint32(12)))
+	assert.Equal(t, uint32(12), i)
+
+	var s testLargeStruct
+	assert.NoError(t, WriteValue(unsafe.Pointer(&s), testLargeStruct{A: 1, B: 2, C: 3}))
+	assert.Equal(t, testLargeStruct{A: 1, B: 2, C: 3}, s)
+
+	var c *testClass
+	assert.NoError(t, WriteValue[*testClass](unsafe.Pointer(&c), nil))
+	assert.Nil(t, c)
+
+	assert.Error(t, WriteValue(nil, uint32(12)))
+}
+
+func TestHResultFromError(t *testing.T) {
+	assert.Equal(t, uintptr(ole.S_OK), HResultFromError(nil))
+	assert.Equal(t, uintptr(ole.E_NOTIMPL), HResultFromError(ole.NewError(ole.E_NOTIMPL)))
+	assert.Equal(t, uintptr(ole.E_NOTIMPL), HResultFromError(fmt.Errorf("wrapped: %w", ole.NewError(ole.E_NOTIMPL))))
+	assert.Equal(t, uintptr(ole.E_FAIL), HResultFromError(errors.New("some error")))
+	assert.Equal(t, uintptr(0x80070005), HResultFromError(fmt.Errorf("wrapped: %w", ErrAccessDenied)))
+}
+
+type testToken struct {
+	Value int64
+}
+
+type testGUID struct {
+	Data1 uint32
+	Data2 uint16
+	Data3 uint16
+	Data4 [8]byte
+}
+
+func TestDelegateArgs64(t *testing.T) {
+	if unsafe.Sizeof(uintptr(0)) < 8 {
+		t.Skip("pointers are 32 bits long")
+	}
+
+	guid := testGUID{Data1: 1, Data2: 2, Data3: 3, Data4: [8]byte{4, 5, 6, 7, 8, 9, 10, 11}}
+	args := newDelegateArgs("amd64", slots(
+		0xdeadbeef_00000001,                    // bool, only the lowest byte is relevant
+		0xdeadbeef_00000100,                    // bool
+		0xdeadbeef_fffffffe,                    // int32
+		0x00000001_00000002,                    // int64
+		0x00000001_00000002,                    // struct passed by value
+		uint64(uintptr(unsafe.Pointer(&guid))), // struct passed by reference
+		0xdeadbeef_00000007,                    // enum
+	))
+
+	assert.Equal(t, true, NextDelegateArg[bool](args))
+	assert.Equal(t, false, NextDelegateArg[bool](args))
+	assert.Equal(t, int32(-2), NextDelegateArg[int32](args))
+	assert.Equal(t, int64(0x00000001_00000002), NextDelegateArg[int64](args))
+	assert.Equal(t, testToken{Value: 0x00000001_00000002}, NextDelegateArg[testToken](args))
+	assert.Equal(t, guid, NextDelegateArg[testGUID](args))
+	assert.Equal(t, testEnum(7), NextDelegateArg[testEnum](args))
+	assert.Panics(t, func() { NextDelegateArg[int32](args) })
+
+	// arm64 passes the GUID in two registers
+	args = newDelegateArgs("arm64", slots(0x00030002_00000001, 0x0b0a0908_07060504))
+	assert.Equal(t, guid, NextDelegateArg[testGUID](args))
+}
+
+func TestDelegateArgsFloatRegisters(t *testing.T) {
+	// syscall.NewCallback does not capture the floating point registers, where amd64 passes the floats
+	// of the first arguments
+	args := newDelegateArgs("amd64", slots(0, 0, math.Float64bits(-2.25), math.Float64bits(-2.25)))
+	_ = NextDelegateArg[unsafe.Pointer](args)
+	_ = NextDelegateArg[unsafe.Pointer](args)
+	assert.Panics(t, func() { NextDelegateArg[float64](args) })
+	args.next++
+	assert.Equal(t, float64(-2.25), NextDelegateArg[float64](args))
+
+	// arm64 passes every float and float aggregate in floating point registers
+	args = newDelegateArgs("arm64", slots(0, 0, 0, 0, 0))
+	_ = NextDelegateArg[unsafe.Pointer](args)
+	_ = NextDelegateArg[unsafe.Pointer](args)
+	_ = NextDelegateArg[unsafe.Pointer](args)
+	assert.Panics(t, func() { NextDelegateArg[float32](args) })
+	assert.Panics(t, func() { NextDelegateArg[testPoint](args) })
+}
+
+// slots converts the given values to uintptr, truncating them on 32-bit platforms.
+func slots(values ...uint64) []uintptr {
+	s := make([]uintptr, len(values))
+	for i, v := range values {
+		s[i] = uintptr(v)
+	}
+	return s
+}
+
+func TestDelegateArgs32(t *testing.T) {
+	f64 := math.Float64bits(-2.25)
+	args := newDelegateArgs("386", []uintptr{
+		0x00000002, 0x00000001, // int64, split in two slots
+		0xfffffffe,                               // int32
+		uintptr(uint32(f64)), uintptr(f64 >> 32), // float64, split in two slots
+		1, 0x00030002, 0x07060504, 0x0b0a0908, // GUID passed by value
+		0xdead0101, // bool
+	})
+
+	assert.Equal(t, int64(0x00000001_00000002), NextDelegateArg[int64](args))
+	assert.Equal(t, int32(-2), NextDelegateArg[int32](args))
+	assert.Equal(t, float64(-2.25), NextDelegateArg[float64](args))
+	assert.Equal(t, testGUID{Data1: 1, Data2: 2, Data3: 3, Data4: [8]byte{4, 5, 6, 7, 8, 9, 10, 11}}, NextDelegateArg[testGUID](args))
+	assert.Equal(t, true, NextDelegateArg[bool](args))
+}
+
+func TestDelegateArgsString(t *testing.T) {
+	// a null HSTRING is an empty string
+	args := NewDelegateArgs(nil)
+	assert.Equal(t, "", NextDelegateArg[string](args))
+}
@@ -0,0 +1,178 @@
+package winrt
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-ole/go-ole"
+)
+
+// AsyncStatus is the status of an asynchronous operation, as reported by IAsyncInfo. It mirrors the
+// Windows.Foundation.AsyncStatus enum, which can not be used here because the generated packages
+// depend on this one.
+type AsyncStatus int32
+
+// Asynchronous operation status values
+const (
+	AsyncStatusStarted   AsyncStatus = 0
+	AsyncStatusCompleted AsyncStatus = 1
+	AsyncStatusCanceled  AsyncStatus = 2
+	AsyncStatusError     AsyncStatus = 3
+)
+
+func (s AsyncStatus) String() string {
+	switch s {
+	case AsyncStatusStarted:
+		return "Started"
+	case AsyncStatusCompleted:
+		return "Completed"
+	case AsyncStatusCanceled:
+		return "Canceled"
+	case AsyncStatusError:
+		return "Error"
+	}
+	return fmt.Sprintf("AsyncStatus(%d)", int32(s))
+}
+
+// IIDs and parameterized IIDs used to wait for asynchronous operations.
+const (
+	guidIAsyncInfo = "00000036-0000-0000-c000-000000000046"
+
+	guidIAsyncAction                               = "5a648006-843a-4da9-865b-9d26e5dfad7b"
+	guidAsyncActionCompletedHandler                = "a4ed5c81-76c9-40bd-8be6-b1d90fb20ae7"
+	guidIAsyncActionWithProgress                   = "1f6db258-e803-48a1-9546-eb7353398884"
+	guidAsyncActionWithProgressCompletedHandler    = "9c029f91-cc84-44fd-ac26-0a6c4e555281"
+	guidAsyncActionProgressHandler                 = "6d844858-0cff-4590-ae89-95a5a5c8b4b8"
+	guidIAsyncOperation                            = "9fc2b0bb-e446-44e2-aa61-9cab8f636af2"
+	guidAsyncOperationCompletedHandler             = "fcdcf02c-e5d8-4478-915a-4d90b74b83a5"
+	guidIAsyncOperationWithProgress                = "b5d036d7-e297-498f-ba60-0289e76e23dd"
+	guidAsyncOperationWithProgressCompletedHandler = "e85df41d-6aa7-46e3-a8e2-f009d840c627"
+	guidAsyncOperationProgressHandler              = "55690902-0aab-421a-8778-f8ce5026d758"
+)
+
+// iidIAsyncInfo is parsed once, since it is used to wait for every asynchronous action and operation.
+var iidIAsyncInfo = ole.NewGUID(guidIAsyncInfo)
+
+// asyncIIDs holds the IIDs required to wait for an asynchronous action or operation.
+type asyncIIDs struct {
+	// name is the name of the interface of the action or operation, used to report errors.
+	name string
+	// iid is the IID of the action or operation.
+	iid *ole.GUID
+	// completed is the IID of its completion handler.
+	completed *ole.GUID
+	// progress is the IID of its progress handler, it is nil if it does not report progress.
+	progress *ole.GUID
+}
+
+func actionIIDs() asyncIIDs {
+	return asyncIIDs{
+		name:      "Windows.Foundation.IAsyncAction",
+		iid:       ole.NewGUID(guidIAsyncAction),
+		completed: ole.NewGUID(guidAsyncActionCompletedHandler),
+	}
+}
+
+func actionWithProgressIIDs[P any]() asyncIIDs {
+	p := SignatureOf[P]()
+	return asyncIIDs{
+		name:      "Windows.Foundation.IAsyncActionWithProgress`1",
+		iid:       ole.NewGUID(ParameterizedInstanceGUID(guidIAsyncActionWithProgress, p)),
+		completed: ole.NewGUID(ParameterizedInstanceGUID(guidAsyncActionWithProgressCompletedHandler, p)),
+		progress:  ole.NewGUID(ParameterizedInstanceGUID(guidAsyncActionProgressHandler, p)),
+	}
+}
+
+func operationIIDs[T any]() asyncIIDs {
+	t := SignatureOf[T]()
+	return asyncIIDs{
+		name:      "Windows.Foundation.IAsyncOperation`1",
+		iid:       ole.NewGUID(ParameterizedInstanceGUID(guidIAsyncOperation, t)),
+		completed: ole.NewGUID(ParameterizedInstanceGUID(guidAsyncOperationCompletedHandler, t)),
+	}
+}
+
+func operationWithProgressIIDs[T, P any]() asyncIIDs {
+	t, p := SignatureOf[T](), SignatureOf[P]()
+	return asyncIIDs{
+		name:      "Windows.Foundation.IAsyncOperationWithProgress`2",
+		iid:       ole.NewGUID(ParameterizedInstanceGUID(guidIAsyncOperationWithProgress, t, p)),
+		completed: ole.NewGUID(ParameterizedInstanceGUID(guidAsyncOperationWithProgressCompletedHandler, t, p)),
+		progress:  ole.NewGUID(ParameterizedInstanceGUID(guidAsyncOperationProgressHandler, t, p)),
+	}
+}
+
+// ErrCanceled is reported by asynchronous operations that have been canceled.
+var ErrCanceled = errors.New("winrt: asynchronous operation canceled")
+
+// AsyncError is returned when an asynchronous operation does not complete successfully.
+// It wraps the error code reported by the operation, and matches ErrCanceled if it was canceled.
+type AsyncError struct {
+	Status AsyncStatus
+	// HResult is the ErrorCode reported by the operation.
+	HResult uintptr
+}
+
+func (e *AsyncError) Error() string {
+	if e.Status == AsyncStatusCanceled {
+		return fmt.Sprintf("winrt: asynchronous operation canceled (HRESULT 0x%08x)", uint32(e.HResult))
+	}
+	return fmt.Sprintf("winrt: asynchronous operation failed: %v", ole.NewError(e.HResult))
+}
+
+// Unwrap returns the error code of the operation as an *ole.OleError.
+func (e *AsyncError) Unwrap() error {
+	return ole.NewError(e.HResult)
+}
+
+// Is reports whether the error matches ErrCanceled, or carries the given HResult.
+func (e *AsyncError) Is(target error) bool {
+	if hr, ok := target.(HResult); ok {
+		return hr == HResult(e.HResult)
+	}
+	return target == ErrCanceled && e.Status == AsyncStatusCanceled
+}
+
+// asyncStatusError returns the error reported by an operation that finished with the given status.
+func asyncStatusError(status AsyncStatus, errorCode int32) error {
+	switch status {
+	case AsyncStatusCompleted:
+		return nil
+	case AsyncStatusCanceled, AsyncStatusError:
+		return &AsyncError{Status: status, HResult: uintptr(uint32(errorCode))}
+	}
+	return fmt.Errorf("winrt: asynchronous operation finished with unexpected status %v", status)
+}
+
+// ProgressChannel returns a progress function that sends the reported progress to the given channel.
+// Progress is dropped while the channel is full, so progress reports never block the asynchronous operation.
+func ProgressChannel[P any](c chan<- P) func(P) {
+	return func(p P) {
+		select {
+		case c <- p:
+		default:
+		}
+	}
+}
+
+// stoppable returns a function that calls f until stop is called. stop waits for the call in progress, if any,
+// so f is never called once stop returns. It is used to stop reporting the progress of the asynchronous actions
+// and operations once they are no longer awaited, since their progress handlers may still be invoked.
+func stoppable[A any](f func(A)) (call func(A), stop func()) {
+	var mu sync.Mutex
+	stopped := false
+	call = func(a A) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !stopped {
+			f(a)
+		}
+	}
+	stop = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		stopped = true
+	}
+	return call, stop
+}
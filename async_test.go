@@ -0,0 +1,123 @@
+package winrt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go/internal/winmd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncStatusError(t *testing.T) {
+	assert.NoError(t, asyncStatusError(AsyncStatusCompleted, 0))
+
+	err := asyncStatusError(AsyncStatusError, -2147024891) // E_ACCESSDENIED
+	require.Error(t, err)
+	var asyncErr *AsyncError
+	require.True(t, errors.As(err, &asyncErr))
+	assert.Equal(t, AsyncStatusError, asyncErr.Status)
+	assert.Equal(t, uintptr(0x80070005), asyncErr.HResult)
+	assert.False(t, errors.Is(err, ErrCanceled))
+	assert.True(t, errors.Is(err, ErrAccessDenied))
+	assert.Equal(t, uintptr(0x80070005), HResultFromError(err))
+
+	var oleErr *ole.OleError
+	require.True(t, errors.As(err, &oleErr))
+	assert.Equal(t, uintptr(0x80070005), oleErr.Code())
+
+	err = asyncStatusError(AsyncStatusCanceled, -2147467260) // E_ABORT
+	assert.True(t, errors.Is(err, ErrCanceled))
+	assert.Equal(t, "winrt: asynchronous operation canceled (HRESULT 0x80004004)", err.Error())
+
+	err = asyncStatusError(AsyncStatusStarted, 0)
+	assert.EqualError(t, err, "winrt: asynchronous operation finished with unexpected status Started")
+}
+
+func TestAsyncIIDs(t *testing.T) {
+	// the parameterized IIDs of well-known instances
+	assert.Equal(t,
+		"{C1D3D1A2-AE17-5A5F-B5A2-BDCC8844889A}",
+		ParameterizedInstanceGUID(guidAsyncOperationCompletedHandler, SignatureBool),
+	)
+	assert.Equal(t,
+		"{CDB5EFB3-5788-509D-9BE1-71CCB8A3362A}",
+		ParameterizedInstanceGUID(guidIAsyncOperation, SignatureBool),
+	)
+}
+
+func TestAsyncGUIDs(t *testing.T) {
+	store, err := winmd.NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	guids := map[string]string{
+		"Windows.Foundation.IAsyncInfo":                                   guidIAsyncInfo,
+		"Windows.Foundation.IAsyncAction":                                 guidIAsyncAction,
+		"Windows.Foundation.AsyncActionCompletedHandler":                  guidAsyncActionCompletedHandler,
+		"Windows.Foundation.IAsyncActionWithProgress`1":                   guidIAsyncActionWithProgress,
+		"Windows.Foundation.AsyncActionWithProgressCompletedHandler`1":    guidAsyncActionWithProgressCompletedHandler,
+		"Windows.Foundation.AsyncActionProgressHandler`1":                 guidAsyncActionProgressHandler,
+		"Windows.Foundation.IAsyncOperation`1":                            guidIAsyncOperation,
+		"Windows.Foundation.AsyncOperationCompletedHandler`1":             guidAsyncOperationCompletedHandler,
+		"Windows.Foundation.IAsyncOperationWithProgress`2":                guidIAsyncOperationWithProgress,
+		"Windows.Foundation.AsyncOperationWithProgressCompletedHandler`2": guidAsyncOperationWithProgressCompletedHandler,
+		"Windows.Foundation.AsyncOperationProgressHandler`2":              guidAsyncOperationProgressHandler,
+	}
+	for name, expected := range guids {
+		typeDef, err := store.TypeDefByName(name)
+		require.NoError(t, err, name)
+		guid, err := typeDef.GUID()
+		require.NoError(t, err, name)
+		assert.Equal(t, expected, guid, name)
+	}
+}
+
+func TestAsyncWithProgressIIDs(t *testing.T) {
+	// IAsyncOperationWithProgress<UInt32, UInt32> is returned by IOutputStream.WriteAsync
+	iids := operationWithProgressIIDs[uint32, uint32]()
+	assert.Equal(t, ole.NewGUID(ParameterizedInstanceGUID(guidIAsyncOperationWithProgress, SignatureUInt32, SignatureUInt32)), iids.iid)
+	assert.Equal(t, ole.NewGUID(ParameterizedInstanceGUID(guidAsyncOperationProgressHandler, SignatureUInt32, SignatureUInt32)), iids.progress)
+	assert.NotEqual(t, iids.iid, iids.completed)
+
+	// actions without progress are not parameterized
+	assert.Equal(t, ole.NewGUID(guidIAsyncAction), actionIIDs().iid)
+	assert.Nil(t, actionIIDs().progress)
+
+	iids = actionWithProgressIIDs[float64]()
+	assert.Equal(t, ole.NewGUID(ParameterizedInstanceGUID(guidAsyncActionProgressHandler, SignatureFloat64)), iids.progress)
+}
+
+func TestProgressChannel(t *testing.T) {
+	c := make(chan uint32, 1)
+	progress := ProgressChannel(c)
+	progress(1)
+	progress(2) // dropped, the channel is full
+	assert.Equal(t, uint32(1), <-c)
+	assert.Len(t, c, 0)
+}
+
+func TestStoppable(t *testing.T) {
+	var calls []int
+	call, stop := stoppable(func(i int) { calls = append(calls, i) })
+	call(1)
+	stop()
+	call(2) // dropped, the function is stopped
+	stop()
+	assert.Equal(t, []int{1}, calls)
+
+	// stop waits for the call in progress
+	started, release := make(chan struct{}), make(chan struct{})
+	finished := false
+	call, stop = stoppable(func(int) {
+		close(started)
+		<-release
+		finished = true
+	})
+	go call(0)
+	<-started
+	go close(release)
+	stop()
+	assert.True(t, finished)
+}
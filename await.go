@@ -0,0 +1,333 @@
+//go:build windows
+
+package winrt
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go/internal/delegate"
+	"github.com/saltosystems/winrt-go/internal/kernel32"
+)
+
+// comObject is implemented by all the generated interfaces and classes, through the embedded ole.IUnknown.
+type comObject interface {
+	QueryInterface(iid *ole.GUID) (*ole.IDispatch, error)
+}
+
+// AsyncOperation is implemented by the instances of IAsyncOperation<TResult> and IAsyncOperationWithProgress<TResult, TProgress>,
+// like *foundation.IAsyncOperation[TResult].
+type AsyncOperation[T any] interface {
+	comObject
+	GetResults() (T, error)
+}
+
+// AsyncAction is implemented by IAsyncAction and the instances of IAsyncActionWithProgress<TProgress>.
+type AsyncAction interface {
+	comObject
+	GetResults() error
+}
+
+// Await waits for the given asynchronous operation to complete, and returns its results.
+// If the context is done before the operation completes, the operation is canceled and the
+// context error is returned. Operations that fail or are canceled return an *AsyncError.
+func Await[T any](ctx context.Context, op AsyncOperation[T]) (T, error) {
+	if err := await(ctx, op, operationIIDs[T](), nil); err != nil {
+		return *new(T), err
+	}
+	return op.GetResults()
+}
+
+// AwaitWithProgress waits for the given asynchronous operation, an IAsyncOperationWithProgress<TResult, TProgress>,
+// to complete and returns its results. The progress reported by the operation is passed to the given function,
+// which is called from a thread owned by Windows. Interfaces received as progress are only valid during
+// the call. The function is not called once AwaitWithProgress returns, which waits for the call in progress, if any.
+// It behaves like Await otherwise, and fails without waiting if the progress can not be received on the platform,
+// like floating point values on 64-bit platforms.
+func AwaitWithProgress[T, P any](ctx context.Context, op AsyncOperation[T], progress func(P)) (T, error) {
+	if err := progressError[P](); err != nil {
+		return *new(T), err
+	}
+	if err := await(ctx, op, operationWithProgressIIDs[T, P](), progressInvoker(progress)); err != nil {
+		return *new(T), err
+	}
+	return op.GetResults()
+}
+
+// AwaitAction waits for the given asynchronous action to complete. If the context is done before
+// the action completes, the action is canceled and the context error is returned. Actions that
+// fail or are canceled return an *AsyncError.
+func AwaitAction(ctx context.Context, action AsyncAction) error {
+	if err := await(ctx, action, actionIIDs(), nil); err != nil {
+		return err
+	}
+	return action.GetResults()
+}
+
+// AwaitActionWithProgress waits for the given asynchronous action, an IAsyncActionWithProgress<TProgress>, to
+// complete. The progress reported by the action is passed to the given function, which is called from a thread
+// owned by Windows, and not once AwaitActionWithProgress returns. It behaves like AwaitAction otherwise, and fails
+// like AwaitWithProgress if the progress can not be received.
+func AwaitActionWithProgress[P any](ctx context.Context, action AsyncAction, progress func(P)) error {
+	if err := progressError[P](); err != nil {
+		return err
+	}
+	if err := await(ctx, action, actionWithProgressIIDs[P](), progressInvoker(progress)); err != nil {
+		return err
+	}
+	return action.GetResults()
+}
+
+// progressInvoker returns the function invoked by a progress handler: AsyncActionProgressHandler<TProgress>
+// or AsyncOperationProgressHandler<TResult, TProgress>. Both receive the asynchronous action or operation
+// and the progress.
+func progressInvoker[P any](progress func(P)) func(*DelegateArgs) {
+	return func(args *DelegateArgs) {
+		_ = NextDelegateArg[unsafe.Pointer](args) // asyncInfo
+		progress(NextDelegateArg[P](args))
+	}
+}
+
+// progressError returns an error if the progress handlers can not receive a progress of type P, which follows
+// the asynchronous action or operation.
+func progressError[P any]() error {
+	return DelegateArgError[P](1)
+}
+
+// asyncInfoName is the name of the IAsyncInfo interface, used to report errors.
+const asyncInfoName = "Windows.Foundation.IAsyncInfo"
+
+// asyncInfoVtbl is the vtable of IAsyncInfo.
+type asyncInfoVtbl struct {
+	ole.IInspectableVtbl
+	GetId        uintptr
+	GetStatus    uintptr
+	GetErrorCode uintptr
+	Cancel       uintptr
+	Close        uintptr
+}
+
+// asyncVtbl holds the methods shared by the vtables of the asynchronous actions and operations
+// that do not report progress.
+type asyncVtbl struct {
+	ole.IInspectableVtbl
+	SetCompleted uintptr
+	GetCompleted uintptr
+	GetResults   uintptr
+}
+
+// asyncWithProgressVtbl holds the methods shared by the vtables of the asynchronous actions and
+// operations that report progress.
+type asyncWithProgressVtbl struct {
+	ole.IInspectableVtbl
+	SetProgress  uintptr
+	GetProgress  uintptr
+	SetCompleted uintptr
+	GetCompleted uintptr
+	GetResults   uintptr
+}
+
+// await registers a completion handler on the asynchronous action or operation, which implements the interface
+// with the given IIDs, and waits for the handler to be called. If the action or operation reports progress, the
+// given function is invoked by its progress handler.
+func await(ctx context.Context, async comObject, iids asyncIIDs, progress func(*DelegateArgs)) error {
+	info, err := async.QueryInterface(iidIAsyncInfo)
+	if err != nil {
+		return err
+	}
+	defer info.Release()
+
+	itf, err := async.QueryInterface(iids.iid)
+	if err != nil {
+		return err
+	}
+	defer itf.Release()
+
+	setCompleted := (*asyncVtbl)(unsafe.Pointer(itf.RawVTable)).SetCompleted
+	if iids.progress != nil {
+		vtbl := (*asyncWithProgressVtbl)(unsafe.Pointer(itf.RawVTable))
+		setCompleted = vtbl.SetCompleted
+
+		if progress != nil {
+			// the handler may still be invoked once the action or operation is no longer awaited, like when
+			// the context is done, so the progress is no longer reported once await returns
+			report, stop := stoppable(progress)
+			defer stop()
+
+			progressHandler := newDelegateHandler(iids.progress, report)
+			defer (*ole.IUnknown)(progressHandler.ptr).Release()
+			if err := setHandler(vtbl.SetProgress, itf, progressHandler, iids.name, "put_Progress"); err != nil {
+				return err
+			}
+		}
+	}
+
+	done := make(chan struct{})
+	var once sync.Once
+	handler := newDelegateHandler(iids.completed, func(*DelegateArgs) {
+		once.Do(func() { close(done) })
+	})
+	// release the reference through the vtable, so the handler is also removed from the delegate registry
+	defer (*ole.IUnknown)(handler.ptr).Release()
+	if err := setHandler(setCompleted, itf, handler, iids.name, "put_Completed"); err != nil {
+		return err
+	}
+
+	// The handler is called from a thread not created by Go, so the runtime may consider that
+	// all goroutines are asleep while waiting for it. See https://github.com/golang/go/issues/55015
+	keepAlive := time.NewTicker(time.Minute)
+	defer keepAlive.Stop()
+
+	infoVtbl := (*asyncInfoVtbl)(unsafe.Pointer(info.RawVTable))
+	for {
+		select {
+		case <-done:
+			return asyncInfoError(info, infoVtbl)
+		case <-ctx.Done():
+			// the handler is still referenced by the operation, which calls it once canceled
+			if err := cancelAsync(info, infoVtbl); err != nil {
+				return err
+			}
+			return ctx.Err()
+		case <-keepAlive.C:
+		}
+	}
+}
+
+// cancelAsync cancels an asynchronous action or operation.
+func cancelAsync(info *ole.IDispatch, vtbl *asyncInfoVtbl) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	hr, _, _ := syscall.SyscallN(vtbl.Cancel, uintptr(unsafe.Pointer(info)))
+	if hr != 0 {
+		return NewError(hr, asyncInfoName, "Cancel")
+	}
+	return nil
+}
+
+// asyncInfoError returns the error reported by a finished asynchronous action or operation.
+func asyncInfoError(info *ole.IDispatch, vtbl *asyncInfoVtbl) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var status AsyncStatus
+	hr, _, _ := syscall.SyscallN(
+		vtbl.GetStatus,
+		uintptr(unsafe.Pointer(info)),    // this
+		uintptr(unsafe.Pointer(&status)), // out AsyncStatus
+	)
+	if hr != 0 {
+		return NewError(hr, asyncInfoName, "get_Status")
+	}
+	if status == AsyncStatusCompleted {
+		return nil
+	}
+
+	var errorCode int32
+	hr, _, _ = syscall.SyscallN(
+		vtbl.GetErrorCode,
+		uintptr(unsafe.Pointer(info)),       // this
+		uintptr(unsafe.Pointer(&errorCode)), // out HResult
+	)
+	if hr != 0 {
+		return NewError(hr, asyncInfoName, "get_ErrorCode")
+	}
+	return asyncStatusError(status, errorCode)
+}
+
+// setHandler calls the given method of the asynchronous action or operation to set one of its handlers.
+// The interface and method names are used to report errors.
+func setHandler(method uintptr, itf *ole.IDispatch, handler *delegateHandler, itfName, methodName string) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	hr, _, _ := syscall.SyscallN(
+		method,
+		uintptr(unsafe.Pointer(itf)), // this
+		uintptr(handler.ptr),         // in handler
+	)
+	if hr != 0 {
+		return NewError(hr, itfName, methodName)
+	}
+	return nil
+}
+
+// delegateHandlerVtbl is the vtable of the handlers of asynchronous actions and operations.
+type delegateHandlerVtbl struct {
+	ole.IUnknownVtbl
+	Invoke uintptr
+}
+
+// delegateHandler implements the completion and progress handlers of asynchronous actions and operations,
+// calling a function with the arguments it receives. The COM object is allocated outside of the Go heap,
+// because it is referenced by the asynchronous operation, and only holds the vtable. The rest of the
+// state lives in the Go heap, and is found through the delegate registry.
+type delegateHandler struct {
+	ptr    unsafe.Pointer
+	iid    ole.GUID
+	invoke func(*DelegateArgs)
+
+	mu   sync.Mutex
+	refs uintptr
+}
+
+// newDelegateHandler returns a new handler that implements the delegate with the given IID.
+// The returned handler has a single reference, owned by the caller.
+func newDelegateHandler(iid *ole.GUID, invoke func(*DelegateArgs)) *delegateHandler {
+	h := &delegateHandler{iid: *iid, invoke: invoke, refs: 1}
+
+	h.ptr = kernel32.Malloc(unsafe.Sizeof(ole.IUnknown{}))
+	callbacks := delegate.RegisterCallbacks(h.ptr, h)
+
+	vTable := (*delegateHandlerVtbl)(kernel32.Malloc(unsafe.Sizeof(delegateHandlerVtbl{})))
+	vTable.IUnknownVtbl = ole.IUnknownVtbl{
+		QueryInterface: callbacks.QueryInterface,
+		AddRef:         callbacks.AddRef,
+		Release:        callbacks.Release,
+	}
+	vTable.Invoke = callbacks.Invoke
+	(*ole.IUnknown)(h.ptr).RawVTable = (*interface{})(unsafe.Pointer(vTable))
+	return h
+}
+
+func (h *delegateHandler) GetIID() *ole.GUID {
+	return &h.iid
+}
+
+func (h *delegateHandler) Invoke(instancePtr, rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8 unsafe.Pointer) uintptr {
+	h.invoke(NewDelegateArgs(rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8))
+	return ole.S_OK
+}
+
+func (h *delegateHandler) AddRef() uintptr {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.refs++
+	delegate.SetRefs(h.ptr, h.refs)
+	return h.refs
+}
+
+// Release decrements the reference counter, and frees the COM object once it is no longer referenced.
+func (h *delegateHandler) Release() uintptr {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.refs == 0 {
+		return 0
+	}
+	h.refs--
+	delegate.SetRefs(h.ptr, h.refs)
+	if h.refs == 0 {
+		kernel32.Free(unsafe.Pointer((*ole.IUnknown)(h.ptr).RawVTable))
+		kernel32.Free(h.ptr)
+	}
+	return h.refs
+}
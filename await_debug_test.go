@@ -0,0 +1,29 @@
+//go:build windows && winrtdebug
+
+package winrt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/saltosystems/winrt-go/internal/leak"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAwaitLiveObjects(t *testing.T) {
+	before := leak.Default.Live()
+
+	op := newFakeAsyncOperation()
+	completed := make(chan struct{})
+	go func() {
+		op.complete(AsyncStatusCompleted, 0)
+		close(completed)
+	}()
+	_, err := Await[uint32](context.Background(), op)
+	require.NoError(t, err)
+	<-completed
+
+	// the completion handler is no longer reported once the operation releases it
+	assert.Equal(t, before, leak.Default.Live())
+}
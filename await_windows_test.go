@@ -0,0 +1,185 @@
+//go:build windows
+
+package winrt
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAsyncInterface is one of the interfaces of a fakeAsyncOperation, the vtable is followed by the operation.
+type fakeAsyncInterface struct {
+	ole.IUnknown
+	op *fakeAsyncOperation
+}
+
+// fakeAsyncOperation is an IAsyncOperation<UInt32> that completes when told to. It implements IAsyncInfo, and
+// any other IID is answered with the interface of the operation.
+type fakeAsyncOperation struct {
+	info  fakeAsyncInterface
+	async fakeAsyncInterface
+
+	mu        sync.Mutex
+	refs      int
+	status    AsyncStatus
+	errorCode int32
+	canceled  bool
+	handler   *ole.IUnknown
+	// registered is closed once the completion handler is set.
+	registered chan struct{}
+}
+
+// fakeAsyncInfoVtbl and fakeAsyncOperationVtbl are shared by all the fake operations, the number of callbacks of a
+// process is limited.
+var (
+	fakeAsyncAddRef = syscall.NewCallback(func(this *fakeAsyncInterface) uintptr {
+		this.op.mu.Lock()
+		defer this.op.mu.Unlock()
+		this.op.refs++
+		return uintptr(this.op.refs)
+	})
+	fakeAsyncRelease = syscall.NewCallback(func(this *fakeAsyncInterface) uintptr {
+		this.op.mu.Lock()
+		defer this.op.mu.Unlock()
+		this.op.refs--
+		return uintptr(this.op.refs)
+	})
+
+	fakeAsyncInfoVtbl = &asyncInfoVtbl{
+		IInspectableVtbl: ole.IInspectableVtbl{
+			IUnknownVtbl: ole.IUnknownVtbl{AddRef: fakeAsyncAddRef, Release: fakeAsyncRelease},
+		},
+		GetStatus: syscall.NewCallback(func(this *fakeAsyncInterface, out *AsyncStatus) uintptr {
+			this.op.mu.Lock()
+			defer this.op.mu.Unlock()
+			*out = this.op.status
+			return ole.S_OK
+		}),
+		GetErrorCode: syscall.NewCallback(func(this *fakeAsyncInterface, out *int32) uintptr {
+			this.op.mu.Lock()
+			defer this.op.mu.Unlock()
+			*out = this.op.errorCode
+			return ole.S_OK
+		}),
+		Cancel: syscall.NewCallback(func(this *fakeAsyncInterface) uintptr {
+			this.op.mu.Lock()
+			defer this.op.mu.Unlock()
+			this.op.canceled = true
+			return ole.S_OK
+		}),
+	}
+
+	fakeAsyncOperationVtbl = &asyncVtbl{
+		IInspectableVtbl: ole.IInspectableVtbl{
+			IUnknownVtbl: ole.IUnknownVtbl{AddRef: fakeAsyncAddRef, Release: fakeAsyncRelease},
+		},
+		SetCompleted: syscall.NewCallback(func(this *fakeAsyncInterface, handler *ole.IUnknown) uintptr {
+			handler.AddRef()
+			this.op.mu.Lock()
+			this.op.handler = handler
+			this.op.mu.Unlock()
+			close(this.op.registered)
+			return ole.S_OK
+		}),
+	}
+)
+
+func newFakeAsyncOperation() *fakeAsyncOperation {
+	op := &fakeAsyncOperation{registered: make(chan struct{})}
+	op.info = fakeAsyncInterface{op: op}
+	op.info.RawVTable = (*interface{})(unsafe.Pointer(fakeAsyncInfoVtbl))
+	op.async = fakeAsyncInterface{op: op}
+	op.async.RawVTable = (*interface{})(unsafe.Pointer(fakeAsyncOperationVtbl))
+	return op
+}
+
+func (op *fakeAsyncOperation) QueryInterface(iid *ole.GUID) (*ole.IDispatch, error) {
+	itf := &op.async
+	if ole.IsEqualGUID(iid, iidIAsyncInfo) {
+		itf = &op.info
+	}
+	itf.AddRef()
+	return (*ole.IDispatch)(unsafe.Pointer(itf)), nil
+}
+
+func (op *fakeAsyncOperation) GetResults() (uint32, error) {
+	return 42, nil
+}
+
+// complete finishes the operation with the given status, once the completion handler is set, and calls the
+// handler. The handler is released afterwards, like the operations do.
+func (op *fakeAsyncOperation) complete(status AsyncStatus, errorCode int32) {
+	<-op.registered
+
+	op.mu.Lock()
+	op.status = status
+	op.errorCode = errorCode
+	handler := op.handler
+	op.handler = nil
+	op.mu.Unlock()
+
+	_, _, _ = syscall.SyscallN(
+		(*delegateHandlerVtbl)(unsafe.Pointer(handler.RawVTable)).Invoke,
+		uintptr(unsafe.Pointer(handler)),  // this
+		uintptr(unsafe.Pointer(&op.info)), // in IAsyncInfo
+		uintptr(status),                   // in AsyncStatus
+	)
+	handler.Release()
+}
+
+// references returns the number of references to the operation held by the caller.
+func (op *fakeAsyncOperation) references() int {
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	return op.refs
+}
+
+func TestAwait(t *testing.T) {
+	op := newFakeAsyncOperation()
+	go op.complete(AsyncStatusCompleted, 0)
+
+	result, err := Await[uint32](context.Background(), op)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), result)
+	assert.Zero(t, op.references())
+}
+
+func TestAwaitError(t *testing.T) {
+	op := newFakeAsyncOperation()
+	go op.complete(AsyncStatusError, int32(-2147024891)) // E_ACCESSDENIED
+
+	_, err := Await[uint32](context.Background(), op)
+	var asyncErr *AsyncError
+	require.True(t, errors.As(err, &asyncErr))
+	assert.Equal(t, AsyncStatusError, asyncErr.Status)
+	assert.True(t, errors.Is(err, ErrAccessDenied))
+	assert.False(t, errors.Is(err, ErrCanceled))
+	assert.Zero(t, op.references())
+}
+
+func TestAwaitCanceled(t *testing.T) {
+	op := newFakeAsyncOperation()
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-op.registered
+		cancel()
+	}()
+
+	_, err := Await[uint32](ctx, op)
+	assert.Equal(t, context.Canceled, err)
+	op.mu.Lock()
+	assert.True(t, op.canceled, "the operation was not canceled through IAsyncInfo")
+	op.mu.Unlock()
+	assert.Zero(t, op.references())
+
+	// canceled operations still call their completion handler, which is still referenced by the operation
+	op.complete(AsyncStatusCanceled, int32(-2147467260)) // E_ABORT
+}
@@ -0,0 +1,53 @@
+package winrt
+
+import (
+	"fmt"
+
+	"github.com/go-ole/go-ole"
+)
+
+// Error is returned by the generated methods when the WinRT method they call fails.
+// It matches the HResult sentinels of this package using errors.Is, and wraps an *ole.OleError
+// carrying the same code.
+type Error struct {
+	HResult HResult
+	// Interface is the fully qualified name of the WinRT interface that declares the failed method.
+	Interface string
+	// Method is the name of the failed method, as declared by the interface.
+	Method string
+	// Description is the description of the error captured from the restricted error information
+	// set by the failed method, if any.
+	Description string
+}
+
+// NewError returns the error reported by a method of a WinRT interface that failed with the given HRESULT.
+// It captures the description of the error from the restricted error information of the calling thread,
+// so it must be called right after the failed method, on the same OS thread: callers must lock the goroutine
+// to its thread using runtime.LockOSThread around both calls, like the generated methods do.
+func NewError(hr uintptr, iface, method string) error {
+	return &Error{
+		HResult:     HResult(hr),
+		Interface:   iface,
+		Method:      method,
+		Description: restrictedErrorDescription(HResult(hr)),
+	}
+}
+
+func (e *Error) Error() string {
+	// the description replaces the generic message of the HResult
+	if e.Description != "" {
+		return fmt.Sprintf("winrt: %s.%s: %s: %s", e.Interface, e.Method, e.HResult.label(), e.Description)
+	}
+	return fmt.Sprintf("winrt: %s.%s: %s", e.Interface, e.Method, e.HResult.Error())
+}
+
+// Is reports whether the error carries the given HResult.
+func (e *Error) Is(target error) bool {
+	hr, ok := target.(HResult)
+	return ok && hr == e.HResult
+}
+
+// Unwrap returns the HRESULT of the error as an *ole.OleError.
+func (e *Error) Unwrap() error {
+	return ole.NewError(uintptr(e.HResult))
+}
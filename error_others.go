@@ -0,0 +1,8 @@
+//go:build !windows
+
+package winrt
+
+// restrictedErrorDescription returns an empty string, the restricted error information is only available on Windows.
+func restrictedErrorDescription(HResult) string {
+	return ""
+}
@@ -0,0 +1,48 @@
+package winrt
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestError(t *testing.T) {
+	err := fmt.Errorf("connecting: %w", &Error{
+		HResult:   ErrAccessDenied,
+		Interface: "Windows.Devices.Bluetooth.IBluetoothLEDevice3",
+		Method:    "GetGattServicesAsync",
+	})
+	assert.EqualError(t, err, "connecting: winrt: Windows.Devices.Bluetooth.IBluetoothLEDevice3.GetGattServicesAsync: E_ACCESSDENIED (0x80070005): access denied")
+	assert.True(t, errors.Is(err, ErrAccessDenied))
+	assert.False(t, errors.Is(err, ErrNoInterface))
+	assert.Equal(t, uintptr(0x80070005), HResultFromError(err))
+
+	// errors can still be handled as OLE errors
+	var oleErr *ole.OleError
+	require.True(t, errors.As(err, &oleErr))
+	assert.Equal(t, uintptr(0x80070005), oleErr.Code())
+
+	err = &Error{
+		HResult:     ErrBluetoothDisabled,
+		Interface:   "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider",
+		Method:      "StartAdvertising",
+		Description: "The radio is off.",
+	}
+	assert.EqualError(t, err, "winrt: Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider.StartAdvertising: ERROR_DEVICE_NOT_AVAILABLE (0x800710DF): The radio is off.")
+	assert.True(t, errors.Is(err, ErrBluetoothDisabled))
+	assert.True(t, errors.Is(err, ErrDeviceNotAvailable))
+}
+
+func TestNewError(t *testing.T) {
+	err := NewError(0x80004002, "Windows.Foundation.IClosable", "Close")
+	var winrtErr *Error
+	require.True(t, errors.As(err, &winrtErr))
+	assert.Equal(t, ErrNoInterface, winrtErr.HResult)
+	assert.Equal(t, "Windows.Foundation.IClosable", winrtErr.Interface)
+	assert.Equal(t, "Close", winrtErr.Method)
+	assert.True(t, errors.Is(err, ErrNoInterface))
+}
@@ -0,0 +1,64 @@
+//go:build windows
+
+package winrt
+
+import (
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go/internal/combase"
+)
+
+// restrictedErrorInfoVtbl is the vtable of IRestrictedErrorInfo.
+type restrictedErrorInfoVtbl struct {
+	ole.IUnknownVtbl
+	GetErrorDetails uintptr
+	GetReference    uintptr
+}
+
+// restrictedErrorDescription returns the description of the given error, as set by the failed method in the
+// restricted error information of the calling thread. It returns an empty string if there is no information
+// about the error.
+func restrictedErrorDescription(hr HResult) string {
+	info := combase.GetRestrictedErrorInfo()
+	if info == nil {
+		return ""
+	}
+	defer info.Release()
+
+	var description, restrictedDescription, capabilitySid *uint16
+	var code HResult
+	vtbl := (*restrictedErrorInfoVtbl)(unsafe.Pointer(info.RawVTable))
+	ret, _, _ := syscall.SyscallN(
+		vtbl.GetErrorDetails,
+		uintptr(unsafe.Pointer(info)),                   // this
+		uintptr(unsafe.Pointer(&description)),           // out BSTR
+		uintptr(unsafe.Pointer(&code)),                  // out HRESULT
+		uintptr(unsafe.Pointer(&restrictedDescription)), // out BSTR
+		uintptr(unsafe.Pointer(&capabilitySid)),         // out BSTR
+	)
+	if ret != 0 {
+		return ""
+	}
+	defer freeBSTR(description)
+	defer freeBSTR(restrictedDescription)
+	defer freeBSTR(capabilitySid)
+
+	// the information may have been set by a previous failure
+	if code != hr {
+		return ""
+	}
+	// the restricted description is the detailed one
+	if s := strings.TrimSpace(ole.BstrToString(restrictedDescription)); s != "" {
+		return s
+	}
+	return strings.TrimSpace(ole.BstrToString(description))
+}
+
+func freeBSTR(s *uint16) {
+	if s != nil {
+		_ = ole.SysFreeString((*int16)(unsafe.Pointer(s)))
+	}
+}
@@ -0,0 +1,117 @@
+package winrt
+
+import (
+	"sync"
+
+	"github.com/go-ole/go-ole"
+)
+
+// guidIActivationFactory is the IID of IActivationFactory, implemented by the activation factories of
+// the classes that can be activated without arguments.
+const guidIActivationFactory = "00000035-0000-0000-C000-000000000046"
+
+// iidIActivationFactory is parsed once, since it is used to activate every instance.
+var iidIActivationFactory = ole.NewGUID(guidIActivationFactory)
+
+// factories caches the activation factories returned by GetActivationFactory.
+var factories = newFactoryCache(
+	ole.RoGetActivationFactory,
+	func(factory *ole.IInspectable) { factory.AddRef() },
+	func(factory *ole.IInspectable) { factory.Release() },
+)
+
+// GetActivationFactory returns the activation factory of the given class that implements the interface with the given
+// IID, like ole.RoGetActivationFactory. Factories are cached process-wide, so the class is only looked up once. The
+// returned factory holds a new reference, which must be released by the caller once the call through it returns,
+// so it remains valid even if the cache is cleared meanwhile.
+func GetActivationFactory(class string, iid *ole.GUID) (*ole.IInspectable, error) {
+	return factories.get(class, iid)
+}
+
+// ClearActivationFactories releases the references held by the cache of GetActivationFactory, like the ones used by the
+// generated static functions and constructors. It must be called before ole.RoUninitialize, the factories are
+// looked up again if they are used afterwards. Factories that are still being used keep their own reference, so it
+// may be called while other goroutines call the generated functions.
+func ClearActivationFactories() {
+	factories.reset()
+}
+
+// factoryKey identifies an activation factory, by class name and interface IID.
+type factoryKey struct {
+	class string
+	iid   ole.GUID
+}
+
+// factoryCache holds the activation factories of each class, by IID.
+type factoryCache struct {
+	mu        sync.Mutex
+	factories map[factoryKey]*ole.IInspectable
+	// lookup returns a new reference to the activation factory of a class.
+	lookup func(class string, iid *ole.GUID) (*ole.IInspectable, error)
+	// addRef adds a reference to the factories returned by the cache.
+	addRef func(*ole.IInspectable)
+	// release releases the factories removed from the cache.
+	release func(*ole.IInspectable)
+}
+
+// newFactoryCache returns an empty cache that looks up, references and releases the factories using the given functions.
+func newFactoryCache(lookup func(string, *ole.GUID) (*ole.IInspectable, error), addRef, release func(*ole.IInspectable)) *factoryCache {
+	return &factoryCache{
+		factories: make(map[factoryKey]*ole.IInspectable),
+		lookup:    lookup,
+		addRef:    addRef,
+		release:   release,
+	}
+}
+
+// get returns a new reference to the cached activation factory, looking it up if it is not cached yet. The reference
+// is added while holding the lock, so the factory can not be released by reset meanwhile. Factories are not looked up
+// while holding the lock, so concurrent calls may look up the same factory, in which case only the first one is cached
+// and the rest are released.
+func (c *factoryCache) get(class string, iid *ole.GUID) (*ole.IInspectable, error) {
+	key := factoryKey{class: class, iid: *iid}
+
+	c.mu.Lock()
+	factory, ok := c.factories[key]
+	if ok {
+		c.addRef(factory)
+	}
+	c.mu.Unlock()
+	if ok {
+		return factory, nil
+	}
+
+	factory, err := c.lookup(class, iid)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	cached, ok := c.factories[key]
+	if ok {
+		c.addRef(cached)
+	} else {
+		// the looked up reference is owned by the cache, and the caller gets a new one
+		c.factories[key] = factory
+		c.addRef(factory)
+	}
+	c.mu.Unlock()
+
+	if ok {
+		c.release(factory)
+		return cached, nil
+	}
+	return factory, nil
+}
+
+// reset removes all the cached factories, and releases them.
+func (c *factoryCache) reset() {
+	c.mu.Lock()
+	factories := c.factories
+	c.factories = make(map[factoryKey]*ole.IInspectable)
+	c.mu.Unlock()
+
+	for _, factory := range factories {
+		c.release(factory)
+	}
+}
@@ -0,0 +1,10 @@
+//go:build !windows
+
+package winrt
+
+import "github.com/go-ole/go-ole"
+
+// ActivateInstance returns an error, classes can only be activated on Windows.
+func ActivateInstance(string) (*ole.IInspectable, error) {
+	return nil, ole.NewError(ole.E_NOTIMPL)
+}
@@ -0,0 +1,136 @@
+package winrt
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactoryCache(t *testing.T) {
+	var lookups []string
+	var released []*ole.IInspectable
+	lookup := func(class string, iid *ole.GUID) (*ole.IInspectable, error) {
+		lookups = append(lookups, class+" "+iid.String())
+		if class == "Windows.Missing" {
+			return nil, ErrClassNotRegistered
+		}
+		return &ole.IInspectable{}, nil
+	}
+	refs := make(map[*ole.IInspectable]int)
+	cache := newFactoryCache(lookup, func(factory *ole.IInspectable) {
+		refs[factory]++
+	}, func(factory *ole.IInspectable) {
+		released = append(released, factory)
+	})
+
+	statics := ole.NewGUID("1DB5D2CF-9B0E-43D1-9B67-C5D6E3B15A4D")
+	factory, err := cache.get("Windows.Foo", statics)
+	require.NoError(t, err)
+	cached, err := cache.get("Windows.Foo", statics)
+	require.NoError(t, err)
+	assert.Same(t, factory, cached)
+	assert.Len(t, lookups, 1)
+	// each call returns a new reference, owned by the caller
+	assert.Equal(t, 2, refs[factory])
+
+	// factories are cached by class and IID
+	other, err := cache.get("Windows.Foo", ole.NewGUID(guidIActivationFactory))
+	require.NoError(t, err)
+	assert.NotSame(t, factory, other)
+	other, err = cache.get("Windows.Bar", statics)
+	require.NoError(t, err)
+	assert.NotSame(t, factory, other)
+	assert.Len(t, lookups, 3)
+
+	// errors are not cached
+	for i := 0; i < 2; i++ {
+		_, err = cache.get("Windows.Missing", statics)
+		assert.Equal(t, ErrClassNotRegistered, err)
+	}
+	assert.Len(t, lookups, 5)
+	assert.Empty(t, released)
+
+	cache.reset()
+	assert.Len(t, released, 3)
+	assert.Contains(t, released, factory)
+	cache.reset()
+	assert.Len(t, released, 3)
+
+	// factories are looked up again once cleared
+	cached, err = cache.get("Windows.Foo", statics)
+	require.NoError(t, err)
+	assert.NotSame(t, factory, cached)
+	assert.Len(t, lookups, 6)
+}
+
+func TestFactoryCacheConcurrentReset(t *testing.T) {
+	// the references of each factory: the one returned by the lookup, plus the ones added by the cache
+	var mu sync.Mutex
+	refs := make(map[*ole.IInspectable]int)
+	lookup := func(string, *ole.GUID) (*ole.IInspectable, error) {
+		factory := &ole.IInspectable{}
+		mu.Lock()
+		refs[factory] = 1
+		mu.Unlock()
+		return factory, nil
+	}
+	update := func(delta int) func(*ole.IInspectable) {
+		return func(factory *ole.IInspectable) {
+			mu.Lock()
+			defer mu.Unlock()
+			refs[factory] += delta
+			if refs[factory] < 0 {
+				t.Errorf("factory %p released too many times", factory)
+			}
+		}
+	}
+	release := update(-1)
+	cache := newFactoryCache(lookup, update(1), release)
+
+	statics := ole.NewGUID("1DB5D2CF-9B0E-43D1-9B67-C5D6E3B15A4D")
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				cache.reset()
+			}
+		}
+	}()
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				factory, err := cache.get("Windows.Foo", statics)
+				if !assert.NoError(t, err) {
+					return
+				}
+				// the factory is used while the cache is cleared, so it must still be referenced
+				mu.Lock()
+				alive := refs[factory] > 0
+				mu.Unlock()
+				assert.True(t, alive, "factory %p released while in use", factory)
+				release(factory)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(stop)
+	wg.Wait()
+
+	cache.reset()
+	for factory, n := range refs {
+		assert.Zero(t, n, "factory %p is still referenced", factory)
+	}
+}
@@ -0,0 +1,42 @@
+//go:build windows
+
+package winrt
+
+import (
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+)
+
+// activationFactoryVtbl is the vtable of IActivationFactory.
+type activationFactoryVtbl struct {
+	ole.IInspectableVtbl
+	ActivateInstance uintptr
+}
+
+// ActivateInstance returns a new instance of the given class, created using its default constructor, like
+// ole.RoActivateInstance. The activation factory of the class is cached, see GetActivationFactory.
+func ActivateInstance(class string) (*ole.IInspectable, error) {
+	factory, err := GetActivationFactory(class, iidIActivationFactory)
+	if err != nil {
+		return nil, err
+	}
+	defer factory.Release()
+
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var instance *ole.IInspectable
+	hr, _, _ := syscall.SyscallN(
+		(*activationFactoryVtbl)(unsafe.Pointer(factory.RawVTable)).ActivateInstance,
+		uintptr(unsafe.Pointer(factory)),   // this
+		uintptr(unsafe.Pointer(&instance)), // out IInspectable
+	)
+	if hr != 0 {
+		return nil, NewError(hr, "Windows.Foundation.IActivationFactory", "ActivateInstance")
+	}
+	return instance, nil
+}
@@ -0,0 +1,195 @@
+package winrt
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go/internal/leak"
+)
+
+// RefCounted is implemented by the generated classes and interfaces, through the embedded ole.IUnknown.
+type RefCounted interface {
+	AddRef() int32
+	Release() int32
+}
+
+// Handle owns a reference to a projected object, like the ones returned by the generated methods and
+// collections, and releases it when closed. Closing a handle more than once has no effect, so handles
+// can be closed using defer regardless of how the object is used afterwards.
+//
+// Handles also cache the interfaces queried from their object by QueryInterface, which is used by the handles
+// generated for the classes using the -cache-interfaces option, and release them when closed.
+//
+// A nil handle, returned for nil objects, behaves like a closed handle.
+//
+// In debug builds, those using the winrtdebug build tag, the objects owned by handles are reported by
+// LiveObjects until the handles are closed. Handles that are garbage collected without being closed are
+// logged along with the stack that created them, and their object is released. The release happens in
+// the goroutine that runs the finalizers, which is not bound to any apartment, so objects that must be
+// released from the single-threaded apartment that created them, like most UI objects, must always be
+// closed explicitly.
+type Handle[T RefCounted] struct {
+	*handleState[T]
+	// stack is the stack that created the handle, only captured in debug builds.
+	stack []byte
+}
+
+// handleState holds the object owned by a handle. It is referenced by the leak registry in debug builds,
+// instead of the handle, so the handle can be garbage collected.
+type handleState[T RefCounted] struct {
+	mu     sync.Mutex
+	obj    T
+	closed bool
+	// ptr is the address of the object, used to query its interfaces, or nil if T is not a pointer.
+	ptr unsafe.Pointer
+	// itfs holds the interfaces queried from the object by IID, until the handle is closed.
+	itfs map[ole.GUID]*ole.IUnknown
+}
+
+// refs returns the reference count of the object, or zero once the handle is closed.
+func (s *handleState[T]) refs() int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return 0
+	}
+	s.obj.AddRef()
+	return s.obj.Release()
+}
+
+// NewHandle returns a handle that owns the reference to the given object held by the caller, like the
+// objects returned by the generated methods. The reference must not be released by the caller. It returns
+// nil if the object is a nil pointer.
+func NewHandle[T RefCounted](obj T) *Handle[T] {
+	if isNil(obj) {
+		return nil
+	}
+	h := &Handle[T]{handleState: &handleState[T]{obj: obj, ptr: objectPointer(obj)}}
+	if leak.Enabled {
+		h.stack = debug.Stack()
+		leak.Default.Track(uintptr(unsafe.Pointer(h)), leak.KindObject, fmt.Sprintf("%T", obj), string(h.stack), h.handleState.refs)
+		runtime.SetFinalizer(h, (*Handle[T]).leaked)
+	}
+	return h
+}
+
+// RetainHandle returns a handle that owns a new reference to the given object, so it can be used beyond
+// the lifetime of the reference held by the caller, like the arguments received by the event handlers,
+// that are only valid during the call. It returns nil if the object is a nil pointer.
+func RetainHandle[T RefCounted](obj T) *Handle[T] {
+	if isNil(obj) {
+		return nil
+	}
+	obj.AddRef()
+	return NewHandle(obj)
+}
+
+// Get returns the object owned by the handle, or the zero value of T once the handle is closed.
+// The object must not be used after the handle is closed.
+func (h *Handle[T]) Get() T {
+	if h == nil {
+		return *new(T)
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.obj
+}
+
+// Close releases the object owned by the handle. Only the first call has any effect. It implements io.Closer,
+// but never fails.
+func (h *Handle[T]) Close() error {
+	if h == nil {
+		return nil
+	}
+	h.mu.Lock()
+	obj, closed, itfs := h.obj, h.closed, h.itfs
+	h.obj, h.closed, h.itfs = *new(T), true, nil
+	h.mu.Unlock()
+
+	if !closed {
+		if leak.Enabled {
+			leak.Default.Untrack(uintptr(unsafe.Pointer(h)))
+			runtime.SetFinalizer(h, nil)
+		}
+		for _, itf := range itfs {
+			itf.Release()
+		}
+		obj.Release()
+	}
+	return nil
+}
+
+// QueryInterface works like the QueryInterface function, but the interfaces of the object are cached by the handle
+// until it is closed, so each interface is only queried once. The returned interface is borrowed from the handle:
+// it must not be released, nor used once the handle is closed. Closed handles return an *Error matching ErrClosed.
+func (h *Handle[T]) QueryInterface(iid *ole.GUID, iface string) (*ole.IUnknown, error) {
+	if h == nil {
+		return nil, &Error{HResult: ErrClosed, Interface: iface, Method: "QueryInterface"}
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, &Error{HResult: ErrClosed, Interface: iface, Method: "QueryInterface"}
+	}
+	if itf, ok := h.itfs[*iid]; ok {
+		return itf, nil
+	}
+	if h.ptr == nil {
+		return nil, fmt.Errorf("winrt: %T is not a projected object", h.obj)
+	}
+	itf, err := QueryInterface((*ole.IUnknown)(h.ptr), iid, iface)
+	if err != nil {
+		return nil, err
+	}
+	if h.itfs == nil {
+		h.itfs = make(map[ole.GUID]*ole.IUnknown)
+	}
+	h.itfs[*iid] = itf
+	return itf, nil
+}
+
+// objectPointer returns the address of the given object, or nil if it is not a pointer.
+func objectPointer(obj interface{}) unsafe.Pointer {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return nil
+	}
+	return v.UnsafePointer()
+}
+
+// isNil returns true if the given object is nil, or a nil pointer.
+func isNil(obj interface{}) bool {
+	v := reflect.ValueOf(obj)
+	return !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil())
+}
+
+// leaked is the finalizer of the handles created in debug builds, called if they are not closed.
+func (h *Handle[T]) leaked() {
+	log.Printf("winrt: %T was garbage collected without being closed, created at:\n%s", h.obj, h.stack)
+	_ = h.Close()
+}
+
+// LiveObject describes a projected object owned by a Handle, or a delegate implemented in Go, that is still alive.
+type LiveObject = leak.Object
+
+// LiveObjects returns the projected objects owned by handles that are not closed yet, and the delegates that are still
+// referenced, ordered by creation, along with their reference count and the stack that created them. Objects are only
+// tracked by debug builds, those using the winrtdebug build tag, so it returns an empty list otherwise.
+func LiveObjects() []LiveObject {
+	return leak.Default.Live()
+}
+
+// ReportLiveObjects writes the objects returned by LiveObjects to the given writer, like os.Stderr before the process
+// exits, to find the objects that are never released.
+func ReportLiveObjects(w io.Writer) error {
+	return leak.Default.Report(w)
+}
@@ -0,0 +1,29 @@
+//go:build winrtdebug
+
+package winrt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleLiveObjects(t *testing.T) {
+	before := len(LiveObjects())
+
+	obj := &refCounter{refs: 1}
+	h := NewHandle(obj)
+	live := LiveObjects()
+	require.Len(t, live, before+1)
+	tracked := live[len(live)-1]
+	assert.Equal(t, "object", tracked.Kind)
+	assert.Equal(t, "*winrt.refCounter", tracked.Type)
+	assert.EqualValues(t, 1, tracked.Refs)
+	assert.Contains(t, tracked.Stack, "TestHandleLiveObjects")
+	// reading the reference count does not change it
+	assert.EqualValues(t, 1, obj.refs)
+
+	require.NoError(t, h.Close())
+	assert.Len(t, LiveObjects(), before)
+}
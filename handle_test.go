@@ -0,0 +1,84 @@
+package winrt
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+)
+
+// refCounter implements RefCounted, counting its references.
+type refCounter struct {
+	refs int32
+}
+
+func (r *refCounter) AddRef() int32 {
+	r.refs++
+	return r.refs
+}
+
+func (r *refCounter) Release() int32 {
+	r.refs--
+	return r.refs
+}
+
+func TestHandle(t *testing.T) {
+	obj := &refCounter{refs: 1}
+	h := NewHandle(obj)
+	assert.Same(t, obj, h.Get())
+	assert.EqualValues(t, 1, obj.refs)
+
+	var closer io.Closer = h
+	assert.NoError(t, closer.Close())
+	assert.EqualValues(t, 0, obj.refs)
+	assert.Nil(t, h.Get())
+
+	// closing the handle again has no effect
+	assert.NoError(t, h.Close())
+	assert.EqualValues(t, 0, obj.refs)
+}
+
+func TestRetainHandle(t *testing.T) {
+	// the reference of the caller is still valid once the handle is closed
+	obj := &refCounter{refs: 1}
+	h := RetainHandle(obj)
+	assert.EqualValues(t, 2, obj.refs)
+	assert.NoError(t, h.Close())
+	assert.NoError(t, h.Close())
+	assert.EqualValues(t, 1, obj.refs)
+}
+
+func TestNilHandle(t *testing.T) {
+	// the generated methods may return nil objects, their handles behave like closed handles
+	h := NewHandle((*refCounter)(nil))
+	assert.Nil(t, h)
+	assert.Nil(t, h.Get())
+	assert.NoError(t, h.Close())
+	assert.Nil(t, RetainHandle((*refCounter)(nil)))
+
+	_, err := h.QueryInterface(ole.IID_IInspectable, "Windows.Foundation.IInspectable")
+	assert.True(t, errors.Is(err, ErrClosed))
+}
+
+func TestClosedHandleQueryInterface(t *testing.T) {
+	h := NewHandle(&refCounter{refs: 1})
+	assert.NoError(t, h.Close())
+
+	_, err := h.QueryInterface(ole.IID_IInspectable, "Windows.Foundation.IInspectable")
+	assert.True(t, errors.Is(err, ErrClosed))
+	assert.EqualError(t, err, "winrt: Windows.Foundation.IInspectable.QueryInterface: RO_E_CLOSED (0x80000013): the object has been closed")
+}
+
+func TestHandleStateRefs(t *testing.T) {
+	obj := &refCounter{refs: 1}
+	h := NewHandle(obj)
+	assert.EqualValues(t, 1, h.handleState.refs())
+	assert.EqualValues(t, 1, obj.refs)
+
+	// the registry may read the count of a handle closed after copying its entry
+	assert.NoError(t, h.Close())
+	assert.EqualValues(t, 0, h.handleState.refs())
+	assert.EqualValues(t, 0, obj.refs)
+}
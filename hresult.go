@@ -0,0 +1,186 @@
+package winrt
+
+import "fmt"
+
+// HResult is a Windows error code. HResults implement error, so the ones defined by this package can be
+// used as sentinels: errors.Is(err, ErrAccessDenied) reports whether err carries E_ACCESSDENIED.
+type HResult uint32
+
+// Well-known HResults, returned by the WinRT APIs.
+const (
+	ErrNotImplemented     HResult = 0x80004001 // E_NOTIMPL
+	ErrNoInterface        HResult = 0x80004002 // E_NOINTERFACE
+	ErrPointer            HResult = 0x80004003 // E_POINTER
+	ErrAbort              HResult = 0x80004004 // E_ABORT
+	ErrFail               HResult = 0x80004005 // E_FAIL
+	ErrUnexpected         HResult = 0x8000FFFF // E_UNEXPECTED
+	ErrBounds             HResult = 0x8000000B // E_BOUNDS
+	ErrChangedState       HResult = 0x8000000C // E_CHANGED_STATE
+	ErrIllegalStateChange HResult = 0x8000000D // E_ILLEGAL_STATE_CHANGE
+	ErrIllegalMethodCall  HResult = 0x8000000E // E_ILLEGAL_METHOD_CALL
+	ErrClosed             HResult = 0x80000013 // RO_E_CLOSED
+	ErrWrongThread        HResult = 0x8001010E // RPC_E_WRONG_THREAD
+	ErrDisconnected       HResult = 0x80010108 // RPC_E_DISCONNECTED
+	ErrClassNotRegistered HResult = 0x80040154 // REGDB_E_CLASSNOTREG
+	ErrNotInitialized     HResult = 0x800401F0 // CO_E_NOTINITIALIZED
+	ErrAccessDenied       HResult = 0x80070005 // E_ACCESSDENIED
+	ErrHandle             HResult = 0x80070006 // E_HANDLE
+	ErrOutOfMemory        HResult = 0x8007000E // E_OUTOFMEMORY
+	ErrNotSupported       HResult = 0x80070032 // HRESULT_FROM_WIN32(ERROR_NOT_SUPPORTED)
+	ErrInvalidArg         HResult = 0x80070057 // E_INVALIDARG
+	ErrDeviceNotConnected HResult = 0x8007048F // HRESULT_FROM_WIN32(ERROR_DEVICE_NOT_CONNECTED)
+	ErrNotFound           HResult = 0x80070490 // HRESULT_FROM_WIN32(ERROR_NOT_FOUND)
+	ErrTimeout            HResult = 0x800705B4 // HRESULT_FROM_WIN32(ERROR_TIMEOUT)
+	ErrDeviceNotAvailable HResult = 0x800710DF // HRESULT_FROM_WIN32(ERROR_DEVICE_NOT_AVAILABLE)
+
+	// ErrBluetoothDisabled is reported by the Bluetooth APIs when the radio is turned off.
+	ErrBluetoothDisabled = ErrDeviceNotAvailable
+)
+
+// hresultInfo describes a well-known HResult.
+type hresultInfo struct {
+	name    string
+	message string
+}
+
+// hresults holds the HResults known by this package, used to format them.
+var hresults = map[HResult]hresultInfo{
+	0x00000000: {"S_OK", "success"},
+	0x00000001: {"S_FALSE", "success"},
+
+	ErrNotImplemented:     {"E_NOTIMPL", "not implemented"},
+	ErrNoInterface:        {"E_NOINTERFACE", "no such interface supported"},
+	ErrPointer:            {"E_POINTER", "invalid pointer"},
+	ErrAbort:              {"E_ABORT", "operation aborted"},
+	ErrFail:               {"E_FAIL", "unspecified error"},
+	ErrUnexpected:         {"E_UNEXPECTED", "catastrophic failure"},
+	ErrBounds:             {"E_BOUNDS", "the operation attempted to access data outside the valid range"},
+	ErrChangedState:       {"E_CHANGED_STATE", "a concurrent or interleaved operation changed the state of the object"},
+	ErrIllegalStateChange: {"E_ILLEGAL_STATE_CHANGE", "an illegal state change was requested"},
+	ErrIllegalMethodCall:  {"E_ILLEGAL_METHOD_CALL", "a method was called at an unexpected time"},
+	ErrClosed:             {"RO_E_CLOSED", "the object has been closed"},
+	ErrWrongThread:        {"RPC_E_WRONG_THREAD", "the application called an interface that was marshalled for a different thread"},
+	ErrDisconnected:       {"RPC_E_DISCONNECTED", "the object invoked has disconnected from its clients"},
+	ErrClassNotRegistered: {"REGDB_E_CLASSNOTREG", "class not registered"},
+	ErrNotInitialized:     {"CO_E_NOTINITIALIZED", "CoInitialize has not been called"},
+	ErrAccessDenied:       {"E_ACCESSDENIED", "access denied"},
+	ErrHandle:             {"E_HANDLE", "invalid handle"},
+	ErrOutOfMemory:        {"E_OUTOFMEMORY", "out of memory"},
+	ErrNotSupported:       {"ERROR_NOT_SUPPORTED", "the request is not supported"},
+	ErrInvalidArg:         {"E_INVALIDARG", "invalid argument"},
+	ErrDeviceNotConnected: {"ERROR_DEVICE_NOT_CONNECTED", "the device is not connected"},
+	ErrNotFound:           {"ERROR_NOT_FOUND", "element not found"},
+	ErrTimeout:            {"ERROR_TIMEOUT", "the timeout period expired"},
+	ErrDeviceNotAvailable: {"ERROR_DEVICE_NOT_AVAILABLE", "the device is not ready for use"},
+	0x800704C7:            {"ERROR_CANCELLED", "the operation was canceled by the user"},
+
+	// errors of the Bluetooth Attribute Protocol, reported by the GATT APIs
+	0x80650001: {"E_BLUETOOTH_ATT_INVALID_HANDLE", "the attribute handle given was not valid on this server"},
+	0x80650002: {"E_BLUETOOTH_ATT_READ_NOT_PERMITTED", "the attribute cannot be read"},
+	0x80650003: {"E_BLUETOOTH_ATT_WRITE_NOT_PERMITTED", "the attribute cannot be written"},
+	0x80650004: {"E_BLUETOOTH_ATT_INVALID_PDU", "the attribute PDU was invalid"},
+	0x80650005: {"E_BLUETOOTH_ATT_INSUFFICIENT_AUTHENTICATION", "the attribute requires authentication before it can be read or written"},
+	0x80650006: {"E_BLUETOOTH_ATT_REQUEST_NOT_SUPPORTED", "the attribute server does not support the request received from the client"},
+	0x80650007: {"E_BLUETOOTH_ATT_INVALID_OFFSET", "the offset specified was past the end of the attribute"},
+	0x80650008: {"E_BLUETOOTH_ATT_INSUFFICIENT_AUTHORIZATION", "the attribute requires authorization before it can be read or written"},
+	0x80650009: {"E_BLUETOOTH_ATT_PREPARE_QUEUE_FULL", "too many prepare writes have been queued"},
+	0x8065000A: {"E_BLUETOOTH_ATT_ATTRIBUTE_NOT_FOUND", "no attribute found within the given attribute handle range"},
+	0x8065000B: {"E_BLUETOOTH_ATT_ATTRIBUTE_NOT_LONG", "the attribute cannot be read or written using the read blob request"},
+	0x8065000C: {"E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION_KEY_SIZE", "the encryption key size is insufficient"},
+	0x8065000D: {"E_BLUETOOTH_ATT_INVALID_ATTRIBUTE_VALUE_LENGTH", "the attribute value length is invalid for the operation"},
+	0x8065000E: {"E_BLUETOOTH_ATT_UNLIKELY", "the attribute request encountered an unlikely error"},
+	0x8065000F: {"E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION", "the attribute requires encryption before it can be read or written"},
+	0x80650010: {"E_BLUETOOTH_ATT_UNSUPPORTED_GROUP_TYPE", "the attribute type is not a supported grouping attribute"},
+	0x80650011: {"E_BLUETOOTH_ATT_INSUFFICIENT_RESOURCES", "insufficient resources to complete the request"},
+	0x80651000: {"E_BLUETOOTH_ATT_UNKNOWN_ERROR", "an error in the reserved range was received"},
+}
+
+// Facility identifies the system component that defines an HResult.
+type Facility uint16
+
+// Facilities of the HResults returned by the WinRT APIs.
+const (
+	FacilityNull          Facility = 0
+	FacilityRPC           Facility = 1
+	FacilityDispatch      Facility = 2
+	FacilityStorage       Facility = 3
+	FacilityITF           Facility = 4
+	FacilityWin32         Facility = 7
+	FacilityWindows       Facility = 8
+	FacilityControl       Facility = 10
+	FacilityWindowsUpdate Facility = 36
+	FacilityBluetoothATT  Facility = 101
+)
+
+var facilityNames = map[Facility]string{
+	FacilityNull:          "FACILITY_NULL",
+	FacilityRPC:           "FACILITY_RPC",
+	FacilityDispatch:      "FACILITY_DISPATCH",
+	FacilityStorage:       "FACILITY_STORAGE",
+	FacilityITF:           "FACILITY_ITF",
+	FacilityWin32:         "FACILITY_WIN32",
+	FacilityWindows:       "FACILITY_WINDOWS",
+	FacilityControl:       "FACILITY_CONTROL",
+	FacilityWindowsUpdate: "FACILITY_WINDOWSUPDATE",
+	FacilityBluetoothATT:  "FACILITY_BLUETOOTH_ATT",
+}
+
+func (f Facility) String() string {
+	if name, ok := facilityNames[f]; ok {
+		return name
+	}
+	return fmt.Sprintf("Facility(%d)", uint16(f))
+}
+
+// HResultFromWin32 returns the HResult that carries the given Win32 error code, like the HRESULT_FROM_WIN32 macro.
+func HResultFromWin32(code uint32) HResult {
+	if int32(code) <= 0 {
+		return HResult(code)
+	}
+	return HResult(code&0xFFFF | uint32(FacilityWin32)<<16 | 0x80000000)
+}
+
+// Failed reports whether the HResult is an error, i.e. its severity bit is set.
+func (hr HResult) Failed() bool {
+	return hr&0x80000000 != 0
+}
+
+// Facility returns the facility that defines the HResult.
+func (hr HResult) Facility() Facility {
+	return Facility(hr >> 16 & 0x1FFF)
+}
+
+// Code returns the error code of the HResult within its facility. The code of FACILITY_WIN32 HResults
+// is a Win32 error code.
+func (hr HResult) Code() uint16 {
+	return uint16(hr)
+}
+
+// String returns the name of the HResult, like E_ACCESSDENIED, or its hexadecimal representation if it
+// is not known by this package.
+func (hr HResult) String() string {
+	if info, ok := hresults[hr]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("0x%08X", uint32(hr))
+}
+
+// Error describes the HResult, including its name and message if it is known by this package, or
+// its facility and code otherwise.
+func (hr HResult) Error() string {
+	if info, ok := hresults[hr]; ok {
+		return hr.label() + ": " + info.message
+	}
+	if hr.Facility() == FacilityWin32 {
+		return fmt.Sprintf("%s (%v, Win32 error %d)", hr.label(), hr.Facility(), hr.Code())
+	}
+	return fmt.Sprintf("%s (%v, code 0x%04X)", hr.label(), hr.Facility(), hr.Code())
+}
+
+// label identifies the HResult in error messages: E_ACCESSDENIED (0x80070005).
+func (hr HResult) label() string {
+	if info, ok := hresults[hr]; ok {
+		return fmt.Sprintf("%s (0x%08X)", info.name, uint32(hr))
+	}
+	return fmt.Sprintf("HRESULT 0x%08X", uint32(hr))
+}
@@ -0,0 +1,70 @@
+package winrt
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHResultFacility(t *testing.T) {
+	assert.Equal(t, FacilityWin32, ErrAccessDenied.Facility())
+	assert.Equal(t, uint16(5), ErrAccessDenied.Code())
+	assert.Equal(t, FacilityNull, ErrNoInterface.Facility())
+	assert.Equal(t, FacilityRPC, ErrWrongThread.Facility())
+	assert.Equal(t, FacilityITF, ErrClassNotRegistered.Facility())
+	assert.Equal(t, FacilityBluetoothATT, HResult(0x80650003).Facility())
+	assert.Equal(t, uint16(3), HResult(0x80650003).Code())
+
+	assert.True(t, ErrFail.Failed())
+	assert.False(t, HResult(0).Failed())
+	assert.False(t, HResult(1).Failed())
+
+	assert.Equal(t, "FACILITY_WIN32", FacilityWin32.String())
+	assert.Equal(t, "Facility(1234)", Facility(1234).String())
+}
+
+func TestHResultFromWin32(t *testing.T) {
+	assert.Equal(t, ErrAccessDenied, HResultFromWin32(5))              // ERROR_ACCESS_DENIED
+	assert.Equal(t, ErrDeviceNotAvailable, HResultFromWin32(4319))     // ERROR_DEVICE_NOT_AVAILABLE
+	assert.Equal(t, ErrDeviceNotConnected, HResultFromWin32(1167))     // ERROR_DEVICE_NOT_CONNECTED
+	assert.Equal(t, HResult(0), HResultFromWin32(0))                   // ERROR_SUCCESS
+	assert.Equal(t, HResult(0x80004005), HResultFromWin32(0x80004005)) // already an HRESULT
+}
+
+func TestHResultFormat(t *testing.T) {
+	assert.Equal(t, "E_ACCESSDENIED", ErrAccessDenied.String())
+	assert.Equal(t, "E_ACCESSDENIED (0x80070005): access denied", ErrAccessDenied.Error())
+	assert.Equal(t, "E_BLUETOOTH_ATT_READ_NOT_PERMITTED", HResult(0x80650002).String())
+	assert.Equal(t, "ERROR_DEVICE_NOT_AVAILABLE", ErrBluetoothDisabled.String())
+
+	// unknown HResults are described by their facility and code
+	assert.Equal(t, "0x800710E0", HResult(0x800710E0).String())
+	assert.Equal(t, "HRESULT 0x800710E0 (FACILITY_WIN32, Win32 error 4320)", HResult(0x800710E0).Error())
+	assert.Equal(t, "HRESULT 0x8004A001 (FACILITY_ITF, code 0xA001)", HResult(0x8004A001).Error())
+	assert.Equal(t, "HRESULT 0x87AF0001 (Facility(1967), code 0x0001)", HResult(0x87AF0001).Error())
+}
+
+func TestHResultCatalog(t *testing.T) {
+	names := make(map[string]HResult, len(hresults))
+	for hr, info := range hresults {
+		assert.NotEmpty(t, info.message, info.name)
+		assert.NotContains(t, names, info.name, "duplicate name")
+		names[info.name] = hr
+	}
+	// only S_OK and S_FALSE are not errors
+	for hr, info := range hresults {
+		assert.Equal(t, hr > 1, hr.Failed(), info.name)
+	}
+}
+
+func TestHResultSentinels(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", ErrAccessDenied)
+	assert.True(t, errors.Is(err, ErrAccessDenied))
+	assert.False(t, errors.Is(err, ErrNoInterface))
+
+	var hr HResult
+	assert.True(t, errors.As(err, &hr))
+	assert.Equal(t, ErrAccessDenied, hr)
+}
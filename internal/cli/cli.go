@@ -21,6 +21,29 @@ You can use the '!' character to negate a filter. For example, to generate all m
 You can also use the '*' character to match any method, so if you want to generate only the 'Add' method, you can do:
     -method-filter Add -method-filter !*`
 
+const maxContractUsage = `The maximum version of an API contract to generate, using the 'Contract=major[.minor]' format.
+This option can be set several times, once per contract. Types and members introduced in a newer version of the
+contract are excluded, so the generated code only uses the APIs available in the oldest supported Windows build.
+For example, to exclude the APIs introduced after Windows 10 version 2004:
+    -max-contract Windows.Foundation.UniversalApiContract=10`
+
+const defaultOverloadNamesUsage = `Names the default overload of each method after the method, and the other overloads after their overload name.
+By default, all the overloads use their overload name. A default overload keeps its overload name if the method name
+collides with another method. Method filters always use the overload name.`
+
+const mustQueryInterfaceUsage = `Makes the methods of the generated classes panic when the object does not implement the interface
+of the method, like the classes of a Windows build that predates the interface. By default, they return an error
+matching winrt.ErrNoInterface.`
+
+const cacheInterfacesUsage = `Generates a handle type for each class, like BluetoothLEDeviceHandle, which wraps a winrt.Handle of the object.
+The methods of the handle cache the interfaces they query from the object, so each interface is only queried once
+per handle. The cached interfaces are released when the handle is closed.`
+
+const handlesUsage = `Makes the methods, static functions and constructors of the generated classes return the objects owned by the
+caller wrapped in a winrt.Handle, which releases the object when closed. The event helpers of the classes pass
+handles to the callbacks too, owning a new reference to their arguments. The objects read from the collections
+are returned as handles as well.`
+
 // NewGenerateCommand returns a new subcommand for generating code.
 func NewGenerateCommand(logger log.Logger) *subcommands.Command {
 	cfg := codegen.NewConfig()
@@ -32,6 +55,13 @@ func NewGenerateCommand(logger log.Logger) *subcommands.Command {
 		cfg.AddMethodFilter(m)
 		return nil
 	})
+	fs.Func("max-contract", maxContractUsage, cfg.AddMaxContract)
+	fs.BoolVar(&cfg.ExcludeDeprecated, "exclude-deprecated", cfg.ExcludeDeprecated, "Excludes the types and members marked as deprecated in the metadata.")
+	fs.BoolVar(&cfg.DefaultOverloadNames, "default-overload-names", cfg.DefaultOverloadNames, defaultOverloadNamesUsage)
+	fs.BoolVar(&cfg.MustQueryInterface, "must-query-interface", cfg.MustQueryInterface, mustQueryInterfaceUsage)
+	fs.BoolVar(&cfg.CacheInterfaces, "cache-interfaces", cfg.CacheInterfaces, cacheInterfacesUsage)
+	fs.BoolVar(&cfg.Handles, "handles", cfg.Handles, handlesUsage)
+	fs.BoolVar(&cfg.ExcludeExperimental, "exclude-experimental", cfg.ExcludeExperimental, "Excludes the types and members marked as experimental in the metadata.")
 	fs.BoolVar(&cfg.Debug, "debug", cfg.Debug, "Enables the debug logging.")
 	return subcommands.NewCommand(fs.Name(), fs, func() error {
 		if cfg.Debug {
@@ -6,6 +6,8 @@ import (
 	"go/format"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/go-kit/log"
@@ -25,12 +27,30 @@ type generator struct {
 	class        string
 	validateOnly bool
 	methodFilter *MethodFilter
+	// maxContracts holds the maximum version of each API contract to generate, by contract name.
+	maxContracts        map[string]uint32
+	excludeDeprecated   bool
+	excludeExperimental bool
+	// defaultOverloadNames names the default overloads after their method instead of their overload name
+	defaultOverloadNames bool
+	// mustQueryInterface makes the methods of the classes panic if their interface is not implemented
+	mustQueryInterface bool
+	// cacheInterfaces generates a handle for each class, whose methods cache the interfaces they query
+	cacheInterfaces bool
+	// handles makes the classes return the objects owned by the caller wrapped in a winrt.Handle
+	handles bool
 
 	logger log.Logger
 
 	genDataFiles []*genDataFile
 
-	mdStore *winmd.Store
+	// opaques holds the stand-in types required by the generated code, by name.
+	opaques map[string]*genOpaque
+	// instances holds the wrappers of the parameterized interface instances used by the generated code, by name.
+	instances map[string]*genInstance
+
+	mdStore    *winmd.Store
+	signatures *signatureBuilder
 }
 
 // Generate generates the code for the given config.
@@ -45,11 +65,21 @@ func Generate(cfg *Config, logger log.Logger) error {
 	}
 
 	g := &generator{
-		class:        cfg.Class,
-		validateOnly: cfg.ValidateOnly,
-		methodFilter: cfg.MethodFilter(),
-		logger:       logger,
-		mdStore:      mdStore,
+		class:                cfg.Class,
+		validateOnly:         cfg.ValidateOnly,
+		methodFilter:         cfg.MethodFilter(),
+		maxContracts:         cfg.maxContracts,
+		excludeDeprecated:    cfg.ExcludeDeprecated,
+		excludeExperimental:  cfg.ExcludeExperimental,
+		defaultOverloadNames: cfg.DefaultOverloadNames,
+		mustQueryInterface:   cfg.MustQueryInterface,
+		cacheInterfaces:      cfg.CacheInterfaces,
+		handles:              cfg.Handles,
+		logger:               logger,
+		opaques:              make(map[string]*genOpaque),
+		instances:            make(map[string]*genInstance),
+		mdStore:              mdStore,
+		signatures:           &signatureBuilder{mdStore: mdStore},
 	}
 	return g.run()
 }
@@ -73,6 +103,13 @@ func (g *generator) generate(typeDef *winmd.TypeDef) error {
 		return fmt.Errorf("%s.%s is not a WinRT class", typeDef.TypeNamespace, typeDef.TypeName)
 	}
 
+	// the requested type is always generated, even if it should be excluded
+	if reason, err := g.typeExclusionReason(typeDef); err != nil {
+		return err
+	} else if reason != "" {
+		_ = level.Warn(g.logger).Log("msg", "generating an excluded type", "type", typeDef.TypeNamespace+"."+typeDef.TypeName, "reason", reason)
+	}
+
 	// get data & execute templates
 	if err := g.loadCodeGenData(typeDef); err != nil {
 		return err
@@ -93,7 +130,7 @@ func (g *generator) generateDataFile(fData *genDataFile, typeDef *winmd.TypeDef)
 		return err
 	}
 
-	fData.Data.ComputeImports(typeDef)
+	fData.Data.ComputeImports(fData.Namespace)
 
 	var buf bytes.Buffer
 	if err := tmpl.ExecuteTemplate(&buf, "file.tmpl", fData.Data); err != nil {
@@ -206,14 +243,109 @@ func (g *generator) loadCodeGenData(typeDef *winmd.TypeDef) error {
 		f.Data.Classes = append(f.Data.Classes, class)
 	}
 
+	g.addRelocatedFiles(typeDef, &f.Data)
+	g.addSupportFiles(typeDef)
+
 	return nil
 }
 
+// addRelocatedFiles adds a file to each of the packages that declare functions of the generated type relocated to
+// them, see relocatedNamespace. The file holds copies of the classes and interfaces that only implement the relocated
+// functions, which are no longer implemented by the file of the type. The files are named after the package and the
+// name of the type, so they do not collide with the files of the types of the package.
+func (g *generator) addRelocatedFiles(typeDef *winmd.TypeDef, data *genData) {
+	var funcs []*genFunc
+	addFuncs := func(itfs []*genInterface) {
+		for _, itf := range itfs {
+			for _, f := range itf.Funcs {
+				if f.Implement && f.RelocatedTo != "" {
+					funcs = append(funcs, f)
+				}
+			}
+		}
+	}
+	addFuncs(data.Interfaces)
+	for _, c := range data.Classes {
+		addFuncs(c.ImplInterfaces)
+		addFuncs(c.ExclusiveInterfaces)
+	}
+
+	var namespaces []string
+	seen := make(map[string]bool)
+	for _, f := range funcs {
+		if !seen[f.RelocatedTo] {
+			seen[f.RelocatedTo] = true
+			namespaces = append(namespaces, f.RelocatedTo)
+		}
+	}
+	sort.Strings(namespaces)
+
+	for _, ns := range namespaces {
+		_ = level.Info(g.logger).Log("msg", "relocating functions", "type", typeDef.TypeNamespace+"."+typeDef.TypeName, "namespace", ns)
+		d := genData{Package: typePackage(ns, "")}
+		for _, itf := range data.Interfaces {
+			if r := itf.relocated(ns); r != nil {
+				d.Interfaces = append(d.Interfaces, r)
+			}
+		}
+		for _, c := range data.Classes {
+			if r := c.relocated(ns); r != nil {
+				d.Classes = append(d.Classes, r)
+			}
+		}
+		g.genDataFiles = append(g.genDataFiles, &genDataFile{
+			Filename:  typeToFolder(ns, "") + "/" + typePackage(typeDef.TypeNamespace, typeDef.TypeName) + "_" + typeFilename(typeDef.TypeName) + ".go",
+			Namespace: ns,
+			Data:      d,
+		})
+	}
+
+	// the relocated functions are only implemented by the copies
+	for _, f := range funcs {
+		f.Implement = false
+		f.RequiresImports = nil
+	}
+}
+
+// addSupportFiles adds a file for each of the opaque types and parameterized interface instances
+// required by the generated code. Each one is stored in its own file because several classes of
+// the same package may require it. The opaque types belong to the package of typeDef, and the
+// instances to the package of their namespace, see instanceType.
+func (g *generator) addSupportFiles(typeDef *winmd.TypeDef) {
+	files := make(map[string]*genDataFile, len(g.opaques)+len(g.instances))
+	for name, o := range g.opaques {
+		files[typeDef.TypeNamespace+"."+name] = &genDataFile{
+			Namespace: typeDef.TypeNamespace,
+			Data:      genData{Opaques: []*genOpaque{o}},
+		}
+	}
+	for _, i := range g.instances {
+		files[i.namespace+"."+i.Name] = &genDataFile{
+			Namespace: i.namespace,
+			Data:      genData{Instances: []*genInstance{i}},
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		f := files[name]
+		f.Filename = typeToFolder(f.Namespace, "") + "/" + strings.ToLower(name[len(f.Namespace)+1:]) + ".go"
+		f.Data.Package = typePackage(f.Namespace, "")
+		g.genDataFiles = append(g.genDataFiles, f)
+	}
+}
+
 func (g *generator) addFile(typeDef *winmd.TypeDef, suffix string) *genDataFile {
 	folder := typeToFolder(typeDef.TypeNamespace, typeDef.TypeName)
 	filename := folder + "/" + typeFilename(typeDef.TypeName) + suffix + ".go"
 	f := genDataFile{
-		Filename: filename,
+		Filename:  filename,
+		Namespace: typeDef.TypeNamespace,
 		Data: genData{
 			Package: typePackage(typeDef.TypeNamespace, typeDef.TypeName),
 		},
@@ -248,16 +380,36 @@ func (g *generator) createGenInterface(typeDef *winmd.TypeDef, requiresActivatio
 		return nil, err
 	}
 
-	typeSig, err := g.Signature(typeDef)
+	typeSig, err := g.signatures.TypeDef(typeDef)
+	if err != nil {
+		return nil, err
+	}
+
+	typeParams, err := g.typeParams(typeDef)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := typeDef.ContractVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	deprecated, err := g.typeDeprecation(typeDef)
 	if err != nil {
 		return nil, err
 	}
 
 	return &genInterface{
-		Name:      typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
-		GUID:      guid,
-		Signature: typeSig,
-		Funcs:     funcs,
+		Name:               typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
+		FullyQualifiedName: typeDef.TypeNamespace + "." + typeDef.TypeName,
+		IsPublic:           typeDef.Flags.Public(),
+		GUID:               guid,
+		Signature:          typeSig,
+		TypeParams:         typeParams,
+		Funcs:              funcs,
+		Contract:           contract,
+		Deprecated:         deprecated,
 	}, nil
 }
 
@@ -276,26 +428,29 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 	}
 	implInterfaces := make([]*genInterface, 0, len(interfaces))
 	for _, iface := range interfaces {
-		// the interface needs to be implemented by this class
-		requiredImports = append(requiredImports, &genImport{iface.Namespace, iface.Name})
-
 		ifaceTypeDef, err := g.mdStore.TypeDefByName(iface.Namespace + "." + iface.Name)
 		if err != nil {
 			return nil, err
 		}
 
+		// excluded interfaces are not implemented
+		if excluded, err := g.typeExcluded(ifaceTypeDef); err != nil {
+			return nil, err
+		} else if excluded {
+			continue
+		}
+
 		itf, err := g.createGenInterface(ifaceTypeDef, false)
 		if err != nil {
 			return nil, err
 		}
 
-		pkg := ""
-		if typeDef.TypeNamespace != ifaceTypeDef.TypeNamespace {
-			pkg = typePackage(iface.Namespace, iface.Name)
-		}
+		// the interface needs to be implemented by this class
+		requiredImports = append(requiredImports, &genImport{iface.Namespace, iface.Name})
+
 		for _, f := range itf.Funcs {
 			f.InheritedFrom = winmd.QualifiedID{
-				Namespace: pkg,
+				Namespace: ifaceTypeDef.TypeNamespace,
 				Name:      typeDefGoName(ifaceTypeDef.TypeName, ifaceTypeDef.Flags.Public()),
 			}
 		}
@@ -315,15 +470,23 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 
 	// Runtime classes have zero or more StaticAttribute custom attributes
 	// https://docs.microsoft.com/en-us/uwp/winrt-cref/winmd-files#static-interfaces
-	staticAttributeBlobs := typeDef.GetTypeDefAttributesWithType(winmd.AttributeTypeStaticAttribute)
-	for _, blob := range staticAttributeBlobs {
-		class := extractClassFromBlob(blob)
+	staticAttributes, err := typeDef.GetAttributes(winmd.AttributeTypeStaticAttribute)
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range staticAttributes {
+		class, _ := attributeTypeArg(attr)
 		_ = level.Debug(g.logger).Log("msg", "found static interface", "class", class)
 		staticClass, err := g.mdStore.TypeDefByName(class)
 		if err != nil {
 			_ = level.Error(g.logger).Log("msg", "static class defined in StaticAttribute not found", "class", class, "err", err)
 			return nil, err
 		}
+		if excluded, err := g.typeExcluded(staticClass); err != nil {
+			return nil, err
+		} else if excluded {
+			continue
+		}
 
 		exclusiveInterfaceTypes = append(exclusiveInterfaceTypes, staticClass)
 		activatedInterfaces[staticClass.TypeNamespace+"."+staticClass.TypeName] = true // static interfaces require activation
@@ -331,18 +494,24 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 
 	// Runtime classes have zero or more ActivatableAttribute custom attributes
 	// https://docs.microsoft.com/en-us/uwp/winrt-cref/winmd-files#activation
-	activatableAttributeBlobs := typeDef.GetTypeDefAttributesWithType(winmd.AttributeTypeActivatableAttribute)
+	activatableAttributes, err := typeDef.GetAttributes(winmd.AttributeTypeActivatableAttribute)
+	if err != nil {
+		return nil, err
+	}
 	hasEmptyConstructor := false
-	for _, blob := range activatableAttributeBlobs {
-		// check for empty constructor
-		if activatableAttrIsEmpty(blob) {
-			// this activatable attribute is empty, so the class has an empty constructor
+	for _, attr := range activatableAttributes {
+		// check for an activation interface
+		class, ok := attributeTypeArg(attr)
+		if !ok {
+			// this activatable attribute does not define a factory interface, so the class has an empty constructor
+			if cv, ok := winmd.AttributeContractVersion(attr); ok && g.excludedByContract(cv) {
+				_ = level.Info(g.logger).Log("msg", "skipping excluded constructor", "reason", "newer than the maximum contract version", "contract", cv)
+				continue
+			}
 			hasEmptyConstructor = true
 			continue
 		}
 
-		// check for an activation interface
-		class := extractClassFromBlob(blob)
 		_ = level.Debug(g.logger).Log("msg", "found activatable interface", "class", class)
 		activatableClass, err := g.mdStore.TypeDefByName(class)
 		if err != nil {
@@ -353,6 +522,11 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 			// so do not fail
 			continue
 		}
+		if excluded, err := g.typeExcluded(activatableClass); err != nil {
+			return nil, err
+		} else if excluded {
+			continue
+		}
 		exclusiveInterfaceTypes = append(exclusiveInterfaceTypes, activatableClass)
 		activatedInterfaces[activatableClass.TypeNamespace+"."+activatableClass.TypeName] = true // activatable interfaces require activation
 	}
@@ -383,7 +557,19 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 		}
 	}
 
-	typeSig, err := g.Signature(typeDef)
+	g.disambiguateClassFuncs(typeDef, implInterfaces, exclusiveGenInterfaces)
+
+	typeSig, err := g.signatures.TypeDef(typeDef)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := typeDef.ContractVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	deprecated, err := g.typeDeprecation(typeDef)
 	if err != nil {
 		return nil, err
 	}
@@ -397,6 +583,11 @@ func (g *generator) createGenClass(typeDef *winmd.TypeDef) (*genClass, error) {
 		ExclusiveInterfaces: exclusiveGenInterfaces,
 		HasEmptyConstructor: hasEmptyConstructor,
 		IsAbstract:          typeDef.Flags.Abstract(),
+		MustQueryInterface:  g.mustQueryInterface,
+		CacheInterfaces:     g.cacheInterfaces,
+		Handles:             g.handles,
+		Contract:            contract,
+		Deprecated:          deprecated,
 	}, nil
 }
 
@@ -422,7 +613,7 @@ func (g *generator) createGenEnum(typeDef *winmd.TypeDef) (*genEnum, error) {
 	if err != nil {
 		return nil, err
 	}
-	elType, err := g.elementType(typeDef.Ctx(), fieldSig.Field)
+	elType, err := g.elementType(typeDef, fieldSig.Field)
 	if err != nil {
 		return nil, err
 	}
@@ -441,27 +632,59 @@ func (g *generator) createGenEnum(typeDef *winmd.TypeDef) (*genEnum, error) {
 		}
 
 		var fieldIndex uint32 = typeDef.FieldList.Start() + 1 + uint32(i)
+
+		attrs, err := typeDef.FieldAttributes(fieldIndex)
+		if err != nil {
+			return nil, err
+		}
+		deprecation, err := winmd.FindDeprecation(attrs)
+		if err != nil {
+			return nil, err
+		}
+		if excluded, err := g.memberExcluded(field.Name, attrs, deprecation); err != nil {
+			return nil, err
+		} else if excluded {
+			continue
+		}
+
 		enumRawValue, err := typeDef.GetValueForEnumField(fieldIndex)
 		if err != nil {
 			return nil, err
 		}
 
+		valueName := enumName(typeDef.TypeName, sanitizeIdentifier(field.Name, nil))
 		enumValues = append(enumValues, &genEnumValue{
-			Name:  enumName(typeDef.TypeName, field.Name),
+			Name:  valueName,
 			Value: enumRawValue,
+			Text:  field.Name,
+
+			Deprecated: deprecationDoc(valueName, deprecation),
 		})
 	}
 
-	typeSig, err := g.Signature(typeDef)
+	typeSig, err := g.signatures.TypeDef(typeDef)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := typeDef.ContractVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	deprecated, err := g.typeDeprecation(typeDef)
 	if err != nil {
 		return nil, err
 	}
 
 	return &genEnum{
-		Name:      typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
-		Type:      enumType,
-		Signature: typeSig,
-		Values:    enumValues,
+		Name:       typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
+		Type:       enumType,
+		Signature:  typeSig,
+		Values:     enumValues,
+		Flags:      typeDef.IsFlags(),
+		Contract:   contract,
+		Deprecated: deprecated,
 	}, nil
 }
 
@@ -473,8 +696,6 @@ func (g *generator) createGenStruct(typeDef *winmd.TypeDef) (*genStruct, error)
 		return nil, err
 	}
 
-	curPkg := typePackage(typeDef.TypeNamespace, typeDef.TypeName)
-
 	var genFields []*genParam
 	for _, f := range fields {
 		fSig, err := f.Signature.Reader().Field(typeDef.Ctx())
@@ -482,29 +703,40 @@ func (g *generator) createGenStruct(typeDef *winmd.TypeDef) (*genStruct, error)
 			return nil, err
 		}
 
-		fieldType, err := g.elementType(typeDef.Ctx(), fSig.Field)
+		fieldType, err := g.elementType(typeDef, fSig.Field)
 		if err != nil {
 			return nil, err
 		}
 
 		// Struct fields must be fundamental types, enums, or other structs
 		genFields = append(genFields, &genParam{
-			callerPackage: curPkg,
-			varName:       cleanReservedWords(f.Name),
-			IsOut:         false,
-			Type:          fieldType,
+			varName: sanitizeIdentifier(f.Name, isReservedFieldName),
+			IsOut:   false,
+			Type:    fieldType,
 		})
 	}
 
-	typeSig, err := g.Signature(typeDef)
+	typeSig, err := g.signatures.TypeDef(typeDef)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := typeDef.ContractVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	deprecated, err := g.typeDeprecation(typeDef)
 	if err != nil {
 		return nil, err
 	}
 
 	return &genStruct{
-		Name:      typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
-		Signature: typeSig,
-		Fields:    genFields,
+		Name:       typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
+		Signature:  typeSig,
+		Fields:     genFields,
+		Contract:   contract,
+		Deprecated: deprecated,
 	}, nil
 }
 
@@ -543,55 +775,162 @@ func (g *generator) createGenDelegate(typeDef *winmd.TypeDef) (*genDelegate, err
 	// this is going to be used to define the callback type. We don't
 	// really need the whole function, only its input parameters,
 	// so we can reuse the logic used for getting them.
-	f, err := g.genFuncFromMethod(typeDef, &invokeMethod, "", false)
+	f, err := g.genFuncFromMethod(typeDef, &invokeMethod, typeDef.MethodList.Start()+1, "", false)
 	if err != nil {
 		return nil, err
 	}
 
-	typeSig, err := g.Signature(typeDef)
+	// syscall.NewCallback does not capture the floating point registers
+	if p, err := g.floatRegisterParam(f.InParams); err != nil {
+		return nil, err
+	} else if p != nil {
+		return nil, fmt.Errorf("delegate %s is not supported: its parameter %s is passed in floating point registers",
+			typeDef.TypeNamespace+"."+typeDef.TypeName, p.varName)
+	}
+
+	typeSig, err := g.signatures.TypeDef(typeDef)
+	if err != nil {
+		return nil, err
+	}
+
+	// the return value (if any) is written through a trailing out pointer
+	var returnParam *genParam
+	if len(f.ReturnParams) > 0 {
+		returnParam = f.ReturnParams[0]
+	}
+
+	contract, err := typeDef.ContractVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	deprecated, err := g.typeDeprecation(typeDef)
 	if err != nil {
 		return nil, err
 	}
 
 	return &genDelegate{
-		Name:      typeDefGoName(typeDef.TypeName, true),
-		GUID:      guid,
-		Signature: typeSig,
-		InParams:  f.InParams,
+		Name:        typeDefGoName(typeDef.TypeName, true),
+		GUID:        guid,
+		Signature:   typeSig,
+		TypeParams:  f.TypeParams,
+		InParams:    f.InParams,
+		ReturnParam: returnParam,
+		Contract:    contract,
+		Deprecated:  deprecated,
 	}, nil
 }
 
 func (g *generator) interfaceIsExclusiveTo(typeDef *winmd.TypeDef) (string, bool) {
-	exclusiveToBlob, err := typeDef.GetAttributeWithType(winmd.AttributeTypeExclusiveTo)
+	exclusiveToAttributes, err := typeDef.GetAttributes(winmd.AttributeTypeExclusiveTo)
 	// an error here is fine, we just won't have the ExclusiveTo attribute
-	if err != nil {
+	if err != nil || len(exclusiveToAttributes) == 0 {
 		return "", false
 	}
-	exclusiveToClass := extractClassFromBlob(exclusiveToBlob)
-	return exclusiveToClass, true
+	return attributeTypeArg(exclusiveToAttributes[0])
 }
 
-func activatableAttrIsEmpty(blob []byte) bool {
-	// the activatable attribute is empty if the size is 0
-	// 01 00 - header
-	// 00 - size
-	return len(blob) >= 3 && blob[0] == 0x01 && blob[1] == 0x00 && blob[2] == 0x00
+// excludedByContract returns true if the given contract version is newer than the maximum version
+// configured for its contract.
+func (g *generator) excludedByContract(cv *winmd.ContractVersion) bool {
+	if cv == nil {
+		return false
+	}
+	maxVersion, ok := g.maxContracts[cv.Contract]
+	return ok && cv.Version > maxVersion
 }
 
-func extractClassFromBlob(blob []byte) string {
-	// the blob contains a two byte header
-	// 01 00
-	// followed by a byte with the size
-	// XX
-	// followed by the type name
+// exclusionReason returns the reason to exclude a type or member from the generated code,
+// or an empty string if it must be generated.
+func (g *generator) exclusionReason(cv *winmd.ContractVersion, deprecation *winmd.Deprecation, experimental bool) string {
+	switch {
+	case g.excludedByContract(cv):
+		return "newer than the maximum contract version"
+	case g.excludeDeprecated && deprecation != nil:
+		return "deprecated"
+	case g.excludeExperimental && experimental:
+		return "experimental"
+	}
+	return ""
+}
+
+// typeExclusionReason returns the reason to exclude the given type from the generated code, if any.
+func (g *generator) typeExclusionReason(typeDef *winmd.TypeDef) (string, error) {
+	cv, err := typeDef.ContractVersion()
+	if err != nil {
+		return "", err
+	}
+	deprecation, err := typeDef.Deprecation()
+	if err != nil {
+		return "", err
+	}
+	experimental, err := typeDef.IsExperimental()
+	if err != nil {
+		return "", err
+	}
+	return g.exclusionReason(cv, deprecation, experimental), nil
+}
 
-	// so we need at least 4 bytes
-	if len(blob) < 4 {
+// typeExcluded returns true if the given type must be excluded from the generated code.
+func (g *generator) typeExcluded(typeDef *winmd.TypeDef) (bool, error) {
+	reason, err := g.typeExclusionReason(typeDef)
+	if err != nil || reason == "" {
+		return false, err
+	}
+	_ = level.Info(g.logger).Log("msg", "skipping excluded type", "type", typeDef.TypeNamespace+"."+typeDef.TypeName, "reason", reason)
+	return true, nil
+}
+
+// memberExcluded returns true if the member with the given attributes, like a method or an enum value,
+// must be excluded from the generated code.
+func (g *generator) memberExcluded(name string, attrs []*winmd.Attribute, deprecation *winmd.Deprecation) (bool, error) {
+	cv, err := winmd.FindContractVersion(attrs)
+	if err != nil {
+		return false, err
+	}
+	reason := g.exclusionReason(cv, deprecation, winmd.HasAttribute(attrs, winmd.AttributeTypeExperimentalAttribute))
+	if reason == "" {
+		return false, nil
+	}
+	_ = level.Info(g.logger).Log("msg", "skipping excluded member", "member", name, "reason", reason)
+	return true, nil
+}
+
+// typeDeprecation returns the text of the Deprecated paragraph of the type documentation, or an
+// empty string if the type is not deprecated.
+func (g *generator) typeDeprecation(typeDef *winmd.TypeDef) (string, error) {
+	deprecation, err := typeDef.Deprecation()
+	if err != nil {
+		return "", err
+	}
+	return deprecationDoc(typeDef.TypeName, deprecation), nil
+}
+
+// deprecationDoc returns the text of the Deprecated paragraph of the documentation of the given
+// element, or an empty string if it is not deprecated.
+func deprecationDoc(name string, deprecation *winmd.Deprecation) string {
+	if deprecation == nil {
 		return ""
 	}
-	size := blob[2]
-	class := blob[3 : 3+size]
-	return string(class)
+	// doc comments are written in a single line
+	msg := strings.Join(strings.Fields(deprecation.Message), " ")
+	if msg != "" {
+		return msg
+	}
+	if deprecation.Since.Version == 0 {
+		return name + " is deprecated."
+	}
+	return fmt.Sprintf("%s is deprecated since %s.", name, deprecation.Since)
+}
+
+// attributeTypeArg returns the first System.Type argument of the given attribute, if any.
+func attributeTypeArg(attr *winmd.Attribute) (string, bool) {
+	for _, arg := range attr.FixedArgs {
+		if arg.Kind == types.ELEMENT_TYPE_CLASS {
+			return arg.String()
+		}
+	}
+	return "", false
 }
 
 func (g *generator) getGenFuncs(typeDef *winmd.TypeDef, requiresActivation bool) ([]*genFunc, error) {
@@ -607,9 +946,10 @@ func (g *generator) getGenFuncs(typeDef *winmd.TypeDef, requiresActivation bool)
 		exclusiveToType = ex
 	}
 
-	for _, m := range methods {
+	for i, m := range methods {
 		methodDef := m
-		generatedFunc, err := g.genFuncFromMethod(typeDef, &methodDef, exclusiveToType, requiresActivation)
+		methodIndex := typeDef.MethodList.Start() + uint32(i)
+		generatedFunc, err := g.genFuncFromMethod(typeDef, &methodDef, methodIndex, exclusiveToType, requiresActivation)
 		if err != nil {
 			return nil, err
 		}
@@ -617,74 +957,466 @@ func (g *generator) getGenFuncs(typeDef *winmd.TypeDef, requiresActivation bool)
 		genFuncs = append(genFuncs, generatedFunc)
 	}
 
+	if g.defaultOverloadNames {
+		g.useDefaultOverloadNames(typeDef, genFuncs)
+	}
+	g.disambiguateAccessors(typeDef, genFuncs)
+
+	// static events are not supported, their accessors are declared as package functions
+	if !requiresActivation {
+		if err := g.pairEvents(typeDef, genFuncs); err != nil {
+			return nil, err
+		}
+	}
+
 	return genFuncs, nil
 }
 
-func (g *generator) genFuncFromMethod(typeDef *winmd.TypeDef, methodDef *types.MethodDef, exclusiveTo string, requiresActivation bool) (*genFunc, error) {
+// useDefaultOverloadNames renames the default overloads of the methods of an interface after the method,
+// instead of using their overload name. Default overloads are defined per arity, so a method may have
+// several of them. A default overload keeps its overload name if the method name is already used by
+// another method of the interface, or by another default overload.
+func (g *generator) useDefaultOverloadNames(typeDef *winmd.TypeDef, funcs []*genFunc) {
+	used := make(map[string]bool, len(funcs))
+	renamed := make(map[string]int)
+	for _, f := range funcs {
+		used[f.Name] = true
+		if f.DefaultOverload && f.Name != f.MethodName {
+			renamed[f.MethodName]++
+		}
+	}
+
+	for _, f := range funcs {
+		if !f.DefaultOverload || f.Name == f.MethodName {
+			continue
+		}
+		if used[f.MethodName] || renamed[f.MethodName] > 1 {
+			_ = level.Warn(g.logger).Log(
+				"msg", "default overload name collides with another method, using the overload name",
+				"interface", typeDef.TypeNamespace+"."+typeDef.TypeName,
+				"method", f.MethodName,
+				"overload", f.Name,
+			)
+			continue
+		}
+		f.Name = f.MethodName
+	}
+}
+
+// accessorSuffixes holds the suffix given to the accessors of properties and events whose Go name
+// collides with another method of the interface.
+var accessorSuffixes = map[string]string{
+	"get_":    "Property",
+	"put_":    "Property",
+	"add_":    "Event",
+	"remove_": "Event",
+}
+
+// reservedFuncNames holds the names of the methods declared by the generated interfaces and classes.
+var reservedFuncNames = map[string]bool{
+	"Signature": true, "VTable": true, "IsPresent": true, "IsMethodPresent": true,
+}
+
+// disambiguateAccessors renames the property and event accessors of an interface whose Go name collides
+// with another method: get_Text becomes GetTextProperty if the interface also declares a GetText method.
+// Methods keep their names, so the accessors are the only ones renamed.
+func (g *generator) disambiguateAccessors(typeDef *winmd.TypeDef, funcs []*genFunc) {
+	used := make(map[string]bool, len(funcs))
+	for name := range reservedFuncNames {
+		used[name] = true
+	}
+	var accessors []*genFunc
+	for _, f := range funcs {
+		if accessorPrefix(f.Name) != "" {
+			accessors = append(accessors, f)
+			continue
+		}
+		used[funcName(*f)] = true
+	}
+
+	for _, f := range accessors {
+		name := funcName(*f)
+		if used[name] {
+			f.nameSuffix = uniqueSuffix(accessorSuffixes[accessorPrefix(f.Name)], func(suffix string) bool {
+				return used[name+suffix]
+			})
+			_ = level.Warn(g.logger).Log(
+				"msg", "accessor name collides with another method, renaming it",
+				"interface", typeDef.TypeNamespace+"."+typeDef.TypeName,
+				"accessor", f.Name,
+				"collides", name,
+				"name", funcName(*f),
+			)
+		}
+		used[funcName(*f)] = true
+	}
+}
+
+// pairEvents pairs the add and remove accessors of the events of an interface, so a helper that subscribes
+// to each event is generated along with them: add_Received and remove_Received produce OnReceived.
+// Helpers whose name collides with another method of the interface are not generated.
+func (g *generator) pairEvents(typeDef *winmd.TypeDef, funcs []*genFunc) error {
+	used := make(map[string]bool, len(funcs))
+	for name := range reservedFuncNames {
+		used[name] = true
+	}
+	removers := make(map[string]*genFunc)
+	for _, f := range funcs {
+		used[funcName(*f)] = true
+		if name := strings.TrimPrefix(f.MethodName, "remove_"); name != f.MethodName {
+			removers[name] = f
+		}
+	}
+
+	for _, f := range funcs {
+		name := strings.TrimPrefix(f.MethodName, "add_")
+		remove, ok := removers[name]
+		if name == f.MethodName || !ok || !f.Implement || !remove.Implement {
+			continue
+		}
+
+		event, err := g.createGenEvent(typeDef, f, remove)
+		if err != nil {
+			return err
+		}
+		if event == nil {
+			continue
+		}
+
+		helper := eventFuncName(funcName(*f))
+		if used[helper] {
+			_ = level.Warn(g.logger).Log(
+				"msg", "event helper name collides with another method, skipping it",
+				"interface", typeDef.TypeNamespace+"."+typeDef.TypeName,
+				"event", name,
+				"name", helper,
+			)
+			continue
+		}
+		used[helper] = true
+
+		f.event = event
+		for _, p := range event.Params {
+			f.RequiresImports = append(f.RequiresImports, p.Type.requiredImports()...)
+		}
+	}
+	return nil
+}
+
+// createGenEvent returns the event whose handlers are added and removed by the given accessors of typeDef,
+// or nil if the helper of the event can not be generated. The parameters passed to the helper's callback
+// are the ones of the delegate that handles the event, instantiated with its type arguments.
+func (g *generator) createGenEvent(typeDef *winmd.TypeDef, add, remove *genFunc) (*genEvent, error) {
+	eventName := typeDef.TypeNamespace + "." + typeDef.TypeName + "." + strings.TrimPrefix(add.MethodName, "add_")
+	skip := func(reason string) (*genEvent, error) {
+		_ = level.Debug(g.logger).Log("msg", "skipping event helper", "event", eventName, "reason", reason)
+		return nil, nil
+	}
+
+	if len(add.InParams) != 1 || add.InParams[0].IsOut {
+		return skip("unexpected add accessor parameters")
+	}
+	handler := add.InParams[0].Type
+	if handler.IsPrimitive || handler.IsGeneric || handler.IsArray {
+		return skip("the handler is not a delegate")
+	}
+
+	delegateTypeDef, err := g.mdStore.TypeDefByName(handler.namespace + "." + handler.name)
+	if err != nil {
+		return nil, err
+	}
+	if !delegateTypeDef.IsDelegate() {
+		return skip("the handler is not a delegate")
+	}
+
+	ctx := delegateTypeDef.Ctx()
+	methods, err := delegateTypeDef.ResolveMethodList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(methods) != 2 || methods[1].Name != invokeMethodName {
+		return nil, fmt.Errorf("delegate %s does not declare an %s method", handler.namespace+"."+handler.name, invokeMethodName)
+	}
+	invoke := methods[1]
+
+	paramDefs, err := invoke.ResolveParamList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := invoke.Signature.Reader().Method(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if sig.Return.Type.Kind != types.ELEMENT_TYPE_VOID {
+		return skip("the delegate returns a value")
+	}
+
+	params := make([]*genParam, 0, len(sig.Params))
+	for i, e := range sig.Params {
+		var paramType *genParamType
+		switch {
+		case e.ByRef:
+			return skip("the delegate has out parameters")
+		case e.Type.Kind == types.ELEMENT_TYPE_VAR:
+			// the type parameters of the delegate are replaced by the type arguments of the handler
+			index := int(e.Type.GenericTypeVar.Index)
+			if index >= len(handler.typeArgs) {
+				return nil, fmt.Errorf("handler %s has no type argument %d", handler.namespace+"."+handler.name, index)
+			}
+			paramType = handler.typeArgs[index]
+		case e.Type.Kind == types.ELEMENT_TYPE_GENERICINST,
+			e.Type.Kind == types.ELEMENT_TYPE_SZARRAY,
+			e.Type.Kind == types.ELEMENT_TYPE_ARRAY:
+			return skip("the delegate has array or parameterized parameters")
+		default:
+			if paramType, err = g.elementType(delegateTypeDef, e); err != nil {
+				return nil, err
+			}
+			if !paramType.IsPrimitive && !canImport(typeDef.TypeNamespace, paramType.namespace) {
+				return skip("the delegate parameters can not be imported")
+			}
+		}
+
+		params = append(params, &genParam{
+			varName: getParamName(paramDefs, uint16(i+1)),
+			Type:    paramType,
+		})
+	}
+	if p, err := g.floatRegisterParam(params); err != nil {
+		return nil, err
+	} else if p != nil {
+		return skip("the delegate receives floating point values")
+	}
+	sanitizeParamNames(params, paramPackages(typeDef, add.RequiresImports))
+
+	return &genEvent{
+		remove:  remove,
+		handler: handler,
+		Params:  params,
+		handles: g.handles,
+	}, nil
+}
+
+// disambiguateClassFuncs renames the methods of a class that are declared by several of its interfaces,
+// as well as its static functions that share the same name. The first interface that declares a name
+// keeps it, in the order the interfaces are implemented by the class, and the methods of the following
+// interfaces are suffixed with the name of their interface: Close becomes CloseIClosable.
+func (g *generator) disambiguateClassFuncs(typeDef *winmd.TypeDef, implInterfaces, exclusiveInterfaces []*genInterface) {
+	className := typeDef.TypeNamespace + "." + typeDef.TypeName
+
+	methods := make(map[string]string)
+	for name := range reservedFuncNames {
+		methods[name] = className
+	}
+	for _, itf := range implInterfaces {
+		for _, f := range itf.Funcs {
+			if !f.Implement {
+				continue
+			}
+			name := f.ClassFuncName()
+			if owner, ok := methods[name]; ok {
+				f.classSuffix = uniqueSuffix(interfaceSuffix(itf), func(suffix string) bool {
+					_, ok := methods[name+suffix]
+					return ok
+				})
+				g.logFuncCollision(className, itf, name, owner, f.ClassFuncName())
+			}
+			methods[f.ClassFuncName()] = itf.FullyQualifiedName
+		}
+	}
+
+	// event helpers do not rename other methods, they are not generated if their name is taken
+	for _, itf := range implInterfaces {
+		for _, f := range itf.Funcs {
+			if f.event == nil || !f.Implement {
+				continue
+			}
+			name := eventFuncName(f.ClassFuncName())
+			if owner, ok := methods[name]; ok {
+				_ = level.Warn(g.logger).Log(
+					"msg", "event helper name collides with a method of the class, skipping it",
+					"class", className,
+					"interface", itf.FullyQualifiedName,
+					"name", name,
+					"with", owner,
+				)
+				f.event = nil
+				continue
+			}
+			methods[name] = itf.FullyQualifiedName
+		}
+	}
+
+	// static and activation functions are declared as package functions, which are renamed including their vtable
+	functions := make(map[string]string)
+	for _, itf := range exclusiveInterfaces {
+		for _, f := range itf.Funcs {
+			if !f.Implement || !f.RequiresActivation {
+				continue
+			}
+			name := funcName(*f)
+			if owner, ok := functions[name]; ok {
+				f.nameSuffix += uniqueSuffix(interfaceSuffix(itf), func(suffix string) bool {
+					_, ok := functions[name+suffix]
+					return ok
+				})
+				g.logFuncCollision(className, itf, name, owner, funcName(*f))
+			}
+			functions[funcName(*f)] = itf.FullyQualifiedName
+		}
+	}
+}
+
+func (g *generator) logFuncCollision(className string, itf *genInterface, name, owner, newName string) {
+	_ = level.Warn(g.logger).Log(
+		"msg", "method name collides with a method of another interface of the class, renaming it",
+		"class", className,
+		"interface", itf.FullyQualifiedName,
+		"collides", name,
+		"with", owner,
+		"name", newName,
+	)
+}
+
+// interfaceSuffix returns the suffix given to the methods of an interface that collide with other
+// methods of a class. Private interfaces start with a lower case letter, but the suffix does not.
+func interfaceSuffix(itf *genInterface) string {
+	return strings.ToUpper(itf.Name[:1]) + itf.Name[1:]
+}
+
+// uniqueSuffix returns the given suffix, followed by a number if required to make it unique.
+func uniqueSuffix(suffix string, used func(string) bool) string {
+	unique := suffix
+	for n := 2; used(unique); n++ {
+		unique = suffix + strconv.Itoa(n)
+	}
+	return unique
+}
+
+func (g *generator) genFuncFromMethod(typeDef *winmd.TypeDef, methodDef *types.MethodDef, methodIndex uint32, exclusiveTo string, requiresActivation bool) (*genFunc, error) {
 	// add the type imports to the top of the file
 	// only if the method is going to be implemented
 
 	overloadName := winmd.GetMethodOverloadName(typeDef.Ctx(), methodDef)
 	implement := g.shouldImplementMethod(overloadName)
+
+	attrs, err := typeDef.MethodAttributes(methodIndex)
+	if err != nil {
+		return nil, err
+	}
+	// default overloads may be renamed after the method, so this is required even if the method is not implemented
+	defaultOverload := winmd.HasAttribute(attrs, winmd.AttributeTypeDefaultOverloadAttribute)
+
+	var deprecation *winmd.Deprecation
+	if implement {
+		if deprecation, err = winmd.FindDeprecation(attrs); err != nil {
+			return nil, err
+		}
+		excluded, err := g.memberExcluded(overloadName, attrs, deprecation)
+		if err != nil {
+			return nil, err
+		}
+		implement = !excluded
+	}
+
+	typeParams, err := g.typeParams(typeDef)
+	if err != nil {
+		return nil, err
+	}
+
 	if !implement {
 		// if we don't implement the method, we don't need to gather
 		// all the information, just the name of it is enough
 		return &genFunc{
 			Name:               overloadName,
+			MethodName:         methodDef.Name,
+			DefaultOverload:    defaultOverload,
 			RequiresImports:    nil,
 			Implement:          implement,
 			InParams:           nil,
 			ReturnParams:       nil,
 			FuncOwner:          typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
+			Interface:          typeDef.TypeNamespace + "." + typeDef.TypeName,
+			TypeParams:         typeParams,
 			ExclusiveTo:        exclusiveTo,
 			RequiresActivation: requiresActivation,
 		}, nil
 	}
 
-	curPackage := typePackage(typeDef.TypeNamespace, typeDef.TypeName)
-
-	params, err := g.getInParameters(curPackage, typeDef, methodDef)
+	params, err := g.getInParameters(typeDef, methodDef)
 	if err != nil {
 		return nil, err
 	}
 
-	retParams, err := g.getReturnParameters(curPackage, typeDef, methodDef)
+	retParams, err := g.getReturnParameters(typeDef, methodDef)
 	if err != nil {
 		return nil, err
 	}
 
 	// iterate over all parameters (in or out) to gather the required imports
-	// and calculate if we need the package name when referencing a type
-	// based on the current package
 	var allImplementedParams []*genParam
 	allImplementedParams = append(allImplementedParams, params...)
 	allImplementedParams = append(allImplementedParams, retParams...)
 
 	var requiredImports []*genImport
 	for _, p := range allImplementedParams {
-		p.callerPackage = curPackage
-		if !p.Type.IsPrimitive {
-			requiredImports = append(requiredImports, &genImport{p.Type.namespace, p.Type.name})
-		}
+		requiredImports = append(requiredImports, p.Type.requiredImports()...)
+	}
+
+	sanitizeParamNames(params, paramPackages(typeDef, requiredImports))
+
+	// functions that use the types of descendant namespaces are declared by their package
+	paramTypes := make([]*genParamType, 0, len(allImplementedParams))
+	for _, p := range allImplementedParams {
+		paramTypes = append(paramTypes, p.Type)
+	}
+	namespace, err := relocatedNamespace(typeDef.TypeNamespace, paramTypes)
+	if err != nil {
+		return nil, fmt.Errorf("method %s: %w", overloadName, err)
+	}
+	relocatedTo := ""
+	if namespace != typeDef.TypeNamespace {
+		relocatedTo = namespace
 	}
 
 	return &genFunc{
 		Name:               overloadName,
+		MethodName:         methodDef.Name,
+		DefaultOverload:    defaultOverload,
+		Deprecated:         deprecationDoc(overloadName, deprecation),
 		RequiresImports:    requiredImports,
 		Implement:          implement,
 		InParams:           params,
 		ReturnParams:       retParams,
 		FuncOwner:          typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
+		Interface:          typeDef.TypeNamespace + "." + typeDef.TypeName,
+		TypeParams:         typeParams,
 		ExclusiveTo:        exclusiveTo,
 		RequiresActivation: requiresActivation,
+		// static functions and constructors are declared by the class
+		Handles:     g.handles && requiresActivation,
+		RelocatedTo: relocatedTo,
 	}, nil
 }
 
+// paramPackages returns the names of the packages that the parameters of a method of typeDef must not hide:
+// the packages referenced by the generated body, including the package of the owner, that is used by the
+// classes that implement the interface from other packages. Packages may be referenced by their name or
+// by their alias, depending on the importing file.
+func paramPackages(typeDef *winmd.TypeDef, requiredImports []*genImport) map[string]bool {
+	packages := make(map[string]bool)
+	for _, i := range append(requiredImports, &genImport{typeDef.TypeNamespace, typeDef.TypeName}) {
+		packages[typePackage(i.Namespace, i.Name)] = true
+		packages[packageAlias(i.Namespace)] = true
+	}
+	return packages
+}
+
 func (g *generator) shouldImplementMethod(methodName string) bool {
 	return g.methodFilter.Filter(methodName)
 }
 
-func (g *generator) getInParameters(curPackage string, typeDef *winmd.TypeDef, methodDef *types.MethodDef) ([]*genParam, error) {
+func (g *generator) getInParameters(typeDef *winmd.TypeDef, methodDef *types.MethodDef) ([]*genParam, error) {
 
 	params, err := methodDef.ResolveParamList(typeDef.Ctx())
 	if err != nil {
@@ -701,6 +1433,7 @@ func (g *generator) getInParameters(curPackage string, typeDef *winmd.TypeDef, m
 
 	var genParams []*genParam
 	for i, e := range mr.Params {
+		var arraySize *genParam
 		param := getParamByIndex(params, uint16(i+1))
 		if param == nil {
 			_ = level.Error(g.logger).Log("msg", "Parameter with index not found", "index", i+1)
@@ -722,10 +1455,9 @@ func (g *generator) getInParameters(curPackage string, typeDef *winmd.TypeDef, m
 			//   - If the array parameter is an out parameter and carries the BYREF marker, the
 			//     array length is an OUT PARAMETER.
 			sizeIsOutParam := param.Flags.Out() && e.ByRef
-			genParams = append(genParams, &genParam{
-				callerPackage: curPackage,
+			arraySize = &genParam{
 				// Do not change this without also changing the code in the templates
-				varName: cleanReservedWords(param.Name + "Size"),
+				varName: param.Name + "Size",
 				IsOut:   sizeIsOutParam,
 				Type: &genParamType{
 					namespace:    "",
@@ -735,25 +1467,26 @@ func (g *generator) getInParameters(curPackage string, typeDef *winmd.TypeDef, m
 					IsPointer:    false,
 					IsArray:      false,
 				},
-			})
+			}
+			genParams = append(genParams, arraySize)
 		}
 
-		elType, err := g.elementType(typeDef.Ctx(), e)
+		elType, err := g.elementType(typeDef, e)
 		if err != nil {
 			return nil, err
 		}
 		genParams = append(genParams, &genParam{
-			callerPackage: curPackage,
-			varName:       cleanReservedWords(getParamName(params, uint16(i+1))),
-			IsOut:         param.Flags.Out(),
-			Type:          elType,
+			varName:   getParamName(params, uint16(i+1)),
+			arraySize: arraySize,
+			IsOut:     param.Flags.Out(),
+			Type:      elType,
 		})
 	}
 
 	return genParams, nil
 }
 
-func (g *generator) getReturnParameters(curPackage string, typeDef *winmd.TypeDef, methodDef *types.MethodDef) ([]*genParam, error) {
+func (g *generator) getReturnParameters(typeDef *winmd.TypeDef, methodDef *types.MethodDef) ([]*genParam, error) {
 	// the signature contains the parameter
 	// types and return type of the method
 	r := methodDef.Signature.Reader()
@@ -769,17 +1502,16 @@ func (g *generator) getReturnParameters(curPackage string, typeDef *winmd.TypeDe
 		return genParams, nil
 	}
 
-	elType, err := g.elementType(typeDef.Ctx(), methodSignature.Return)
+	elType, err := g.elementType(typeDef, methodSignature.Return)
 	if err != nil {
 		return nil, err
 	}
 
 	genParams = append(genParams, &genParam{
 		// return param always has an index of zero
-		callerPackage: curPackage,
-		varName:       "out",
-		IsOut:         true,
-		Type:          elType,
+		varName: "out",
+		IsOut:   true,
+		Type:    elType,
 	})
 
 	return genParams, nil
@@ -803,7 +1535,32 @@ func getParamByIndex(params []types.Param, i uint16) *types.Param {
 	return nil
 }
 
-func (g *generator) elementType(ctx *types.Context, e types.Element) (*genParamType, error) {
+// typeParams returns the names of the type parameters of the given type, ordered by their position.
+// It returns nil if the type is not parameterized.
+func (g *generator) typeParams(typeDef *winmd.TypeDef) ([]string, error) {
+	if !isParameterizedName(typeDef.TypeName) {
+		return nil, nil
+	}
+
+	params, err := typeDef.GetGenericParams()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(params))
+	for _, p := range params {
+		if int(p.Number) >= len(names) {
+			return nil, fmt.Errorf("invalid generic param %s on type %s", p.Name, typeDef.TypeNamespace+"."+typeDef.TypeName)
+		}
+		names[p.Number] = p.Name
+	}
+	return names, nil
+}
+
+// elementType returns the type of the given element. The typeDef is the type that owns the element,
+// it is used to resolve the generic type parameters and type arguments.
+func (g *generator) elementType(typeDef *winmd.TypeDef, e types.Element) (*genParamType, error) {
+	ctx := typeDef.Ctx()
 	switch e.Type.Kind {
 	case types.ELEMENT_TYPE_BOOLEAN:
 		return &genParamType{
@@ -923,7 +1680,45 @@ func (g *generator) elementType(ctx *types.Context, e types.Element) (*genParamT
 			defaultValue: g.elementDefaultValue(ctx, e),
 		}, nil
 	case types.ELEMENT_TYPE_GENERICINST:
-		fallthrough
+		// return the parameterized type name, along with its type arguments
+		namespace, name, err := ctx.ResolveTypeDefOrRefName(e.Type.TypeDef.Index)
+		if err != nil {
+			return nil, err
+		}
+
+		typeArgs := make([]*genParamType, 0, len(e.Type.TypeDef.Generics))
+		for _, arg := range e.Type.TypeDef.Generics {
+			argType, err := g.typeArgument(typeDef, types.Element{Type: arg})
+			if err != nil {
+				return nil, err
+			}
+			typeArgs = append(typeArgs, argType)
+		}
+
+		genericType := &genParamType{
+			namespace:    namespace,
+			name:         name,
+			IsPointer:    true,
+			IsPrimitive:  false,
+			IsArray:      false,
+			typeArgs:     typeArgs,
+			isDelegate:   g.isDelegate(namespace, name),
+			defaultValue: g.elementDefaultValue(ctx, e),
+		}
+
+		// instances of parameterized interfaces get a named wrapper,
+		// unless they depend on the type parameters of the current type
+		if genericType.isConcrete() {
+			genericTypeDef, err := g.mdStore.TypeDefByName(namespace + "." + name)
+			if err != nil {
+				return nil, err
+			}
+			if genericTypeDef.IsInterface() {
+				return g.instanceType(typeDef, e, genericType)
+			}
+		}
+
+		return genericType, nil
 	case types.ELEMENT_TYPE_CLASS:
 		// return class name
 		namespace, name, err := ctx.ResolveTypeDefOrRefName(e.Type.TypeDef.Index)
@@ -936,6 +1731,7 @@ func (g *generator) elementType(ctx *types.Context, e types.Element) (*genParamT
 			IsPointer:    true,
 			IsPrimitive:  false,
 			IsArray:      false,
+			isDelegate:   g.isDelegate(namespace, name),
 			defaultValue: g.elementDefaultValue(ctx, e),
 		}, nil
 	case types.ELEMENT_TYPE_VALUETYPE:
@@ -978,22 +1774,29 @@ func (g *generator) elementType(ctx *types.Context, e types.Element) (*genParamT
 			defaultValue:       g.elementDefaultValue(ctx, e),
 		}, nil
 	case types.ELEMENT_TYPE_VAR:
-		// Generic types are not fully supported yet,
-		// so we will just pass the raw unsafe.Pointer up to the user.
+		// Generic type parameters are projected as Go type parameters
+		typeParams, err := g.typeParams(typeDef)
+		if err != nil {
+			return nil, err
+		}
+		index := int(e.Type.GenericTypeVar.Index)
+		if index >= len(typeParams) {
+			return nil, fmt.Errorf("type %s has no generic param %d", typeDef.TypeNamespace+"."+typeDef.TypeName, index)
+		}
 		return &genParamType{
-			namespace:    "unsafe",
-			name:         "Pointer",
+			namespace:    "",
+			name:         typeParams[index],
 			IsGeneric:    true,
 			IsPointer:    false,
 			IsPrimitive:  false,
 			IsArray:      false,
-			defaultValue: g.elementDefaultValue(ctx, e),
+			defaultValue: genDefaultValue{"*new(" + typeParams[index] + ")", true},
 		}, nil
 	case types.ELEMENT_TYPE_SZARRAY:
 		//A single-dimensional, zero lower-bound array type modifier
 
 		// e.Type.SZArray.Elem should be non-nil
-		param, err := g.elementType(ctx, *e.Type.SZArray.Elem)
+		param, err := g.elementType(typeDef, *e.Type.SZArray.Elem)
 		if err != nil {
 			return nil, err
 		}
@@ -1018,6 +1821,269 @@ func (g *generator) elementType(ctx *types.Context, e types.Element) (*genParamT
 	}
 }
 
+// floatFields returns the Go names of the floating point types of the fields of a value of the given type, including
+// the fields of its nested structs, and false if any of them is not a float.
+func (g *generator) floatFields(t *genParamType) ([]string, bool, error) {
+	if t.IsArray {
+		return nil, false, nil
+	}
+	if t.IsPrimitive {
+		isFloat := t.name == "float32" || t.name == "float64"
+		return []string{t.name}, isFloat, nil
+	}
+	if t.IsPointer || t.IsGeneric || t.IsEnum || t.namespace == "" || t.namespace == "syscall" || t.namespace == "unsafe" {
+		return nil, false, nil
+	}
+
+	typeDef, err := g.mdStore.TypeDefByName(t.namespace + "." + t.name)
+	if err != nil {
+		return nil, false, err
+	}
+	if !typeDef.IsStruct() {
+		return nil, false, nil
+	}
+	genStruct, err := g.createGenStruct(typeDef)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var floats []string
+	for _, f := range genStruct.Fields {
+		fieldFloats, ok, err := g.floatFields(f.Type)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+		floats = append(floats, fieldFloats...)
+	}
+	return floats, len(floats) > 0, nil
+}
+
+// inFloatRegisters returns true if values of the given type are passed in floating point registers on any of the
+// supported platforms: floats, and on ARM the structs made of up to four floats of the same type. The delegates
+// implemented in Go can not receive them, see winrt.NextDelegateArg.
+func (g *generator) inFloatRegisters(t *genParamType) (bool, error) {
+	floats, ok, err := g.floatFields(t)
+	if err != nil || !ok || len(floats) > 4 {
+		return false, err
+	}
+	for _, f := range floats {
+		if f != floats[0] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// floatRegisterParam returns the first parameter received by a delegate in a floating point register, if any.
+func (g *generator) floatRegisterParam(params []*genParam) (*genParam, error) {
+	for _, p := range params {
+		if p.IsOut {
+			continue
+		}
+		if ok, err := g.inFloatRegisters(p.Type); err != nil {
+			return nil, err
+		} else if ok {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+// isDelegate returns true if the given type is a delegate.
+func (g *generator) isDelegate(namespace, name string) bool {
+	typeDef, err := g.mdStore.TypeDefByName(namespace + "." + name)
+	return err == nil && typeDef.IsDelegate()
+}
+
+// typeArgument returns the type of the given type argument. The types of descendant namespaces are
+// kept, since the code that uses them is declared by their package (see relocatedNamespace). The rest
+// of the types whose package can not be imported from the package of typeDef are replaced by an
+// opaque stand-in type.
+func (g *generator) typeArgument(typeDef *winmd.TypeDef, e types.Element) (*genParamType, error) {
+	argType, err := g.elementType(typeDef, e)
+	if err != nil {
+		return nil, err
+	}
+
+	if argType.IsPrimitive || argType.IsGeneric || canImport(typeDef.TypeNamespace, argType.namespace) ||
+		isDescendant(typeDef.TypeNamespace, argType.namespace) {
+		return argType, nil
+	}
+
+	return g.opaqueType(typeDef, argType)
+}
+
+// opaqueType registers and returns an opaque stand-in for the given type in the package of typeDef.
+// The stand-in shares the signature of the original type, so the IIDs of the parameterized types
+// instantiated with it are still valid.
+func (g *generator) opaqueType(typeDef *winmd.TypeDef, t *genParamType) (*genParamType, error) {
+	fullName := t.namespace + "." + t.name
+	if len(t.typeArgs) > 0 {
+		return nil, fmt.Errorf("type argument %s can not be imported from %s", fullName, typeDef.TypeNamespace)
+	}
+
+	argTypeDef, err := g.mdStore.TypeDefByName(fullName)
+	if err != nil {
+		return nil, err
+	}
+	if argTypeDef.IsStruct() {
+		return nil, fmt.Errorf("struct type argument %s can not be imported from %s", fullName, typeDef.TypeNamespace)
+	}
+
+	sig, err := g.signatures.TypeDef(argTypeDef)
+	if err != nil {
+		return nil, err
+	}
+
+	name := "Opaque" + typeDefGoName(t.name, true)
+	_ = level.Debug(g.logger).Log("msg", "replacing type argument with an opaque type", "type", fullName, "opaque", name)
+	g.opaques[name] = &genOpaque{
+		Name:               name,
+		FullyQualifiedName: fullName,
+		Signature:          sig,
+		UnderlyingEnumType: t.UnderlyingEnumType,
+	}
+
+	return &genParamType{
+		namespace:          typeDef.TypeNamespace,
+		name:               name,
+		IsPointer:          t.IsPointer,
+		IsPrimitive:        false,
+		IsArray:            false,
+		IsEnum:             t.IsEnum,
+		UnderlyingEnumType: t.UnderlyingEnumType,
+		defaultValue:       t.defaultValue,
+	}, nil
+}
+
+// instanceType registers and returns the named wrapper of the given parameterized interface instance
+// in the package of typeDef, or in the package of its type arguments if they belong to a descendant
+// namespace, so the wrapper can reference them.
+func (g *generator) instanceType(typeDef *winmd.TypeDef, e types.Element, genericType *genParamType) (*genParamType, error) {
+	name, displayName, err := g.instanceName(typeDef.Ctx(), e)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := relocatedNamespace(typeDef.TypeNamespace, genericType.typeArgs)
+	if err != nil {
+		return nil, fmt.Errorf("instance %s of %s: %w", displayName, typeDef.TypeNamespace, err)
+	}
+
+	sig, err := g.signatures.Element(typeDef.Ctx(), e)
+	if err != nil {
+		return nil, err
+	}
+
+	// the IID is formatted like the ones we read from the metadata
+	guid := strings.ToLower(strings.Trim(winrt.IIDFromSignature(sig), "{}"))
+
+	handleFuncs, err := g.instanceHandleFuncs(genericType)
+	if err != nil {
+		return nil, fmt.Errorf("instance %s: %w", displayName, err)
+	}
+
+	g.instances[namespace+"."+name] = &genInstance{
+		Name:        name,
+		DisplayName: displayName,
+		GUID:        guid,
+		Signature:   sig,
+		Type:        genericType,
+		HandleFuncs: handleFuncs,
+		namespace:   namespace,
+	}
+
+	return &genParamType{
+		namespace:    namespace,
+		name:         name,
+		IsPointer:    true,
+		IsPrimitive:  false,
+		IsArray:      false,
+		defaultValue: genDefaultValue{"nil", true},
+	}, nil
+}
+
+// instanceHandleFuncs returns the methods of the given collection instance that return one of its type arguments
+// when it is an object, like IVectorView.GetAt or IIterator.Current, with the type parameters replaced by the type
+// arguments. The wrappers of the collections declare them again when generating handles, so the elements are
+// returned owned by the caller too. The results of the other parameterized interfaces are kept as is, since
+// winrt.Await relies on the results of the asynchronous operations.
+func (g *generator) instanceHandleFuncs(genericType *genParamType) ([]*genFunc, error) {
+	if !g.handles || genericType.namespace != "Windows.Foundation.Collections" {
+		return nil, nil
+	}
+
+	typeDef, err := g.mdStore.TypeDefByName(genericType.namespace + "." + genericType.name)
+	if err != nil {
+		return nil, err
+	}
+	typeParams, err := g.typeParams(typeDef)
+	if err != nil {
+		return nil, err
+	}
+	if len(typeParams) != len(genericType.typeArgs) {
+		return nil, fmt.Errorf("%s has %d type arguments, expected %d", genericType.name, len(genericType.typeArgs), len(typeParams))
+	}
+	typeArg := func(p *genParam) *genParam {
+		if !p.Type.IsGeneric || p.Type.IsArray {
+			return p
+		}
+		for i, name := range typeParams {
+			if name == p.Type.name {
+				return &genParam{varName: p.varName, Type: genericType.typeArgs[i], IsOut: p.IsOut}
+			}
+		}
+		return p
+	}
+
+	methods, err := typeDef.ResolveMethodList(typeDef.Ctx())
+	if err != nil {
+		return nil, err
+	}
+
+	var funcs []*genFunc
+methods:
+	for i := range methods {
+		methodDef := &methods[i]
+		retParams, err := g.getReturnParameters(typeDef, methodDef)
+		if err != nil {
+			return nil, err
+		}
+		if len(retParams) != 1 || !retParams[0].Type.IsGeneric {
+			continue
+		}
+		ret := typeArg(retParams[0])
+		if !ret.Type.IsObject() {
+			continue
+		}
+
+		params, err := g.getInParameters(typeDef, methodDef)
+		if err != nil {
+			return nil, err
+		}
+		inParams := make([]*genParam, 0, len(params))
+		for _, p := range params {
+			if p.IsOut {
+				// the out parameters are returned along with the results
+				continue methods
+			}
+			inParams = append(inParams, typeArg(p))
+		}
+
+		funcs = append(funcs, &genFunc{
+			Name:         winmd.GetMethodOverloadName(typeDef.Ctx(), methodDef),
+			MethodName:   methodDef.Name,
+			Implement:    true,
+			InParams:     inParams,
+			ReturnParams: []*genParam{ret},
+			FuncOwner:    typeDefGoName(typeDef.TypeName, typeDef.Flags.Public()),
+			Interface:    typeDef.TypeNamespace + "." + typeDef.TypeName,
+			Handles:      true,
+		})
+	}
+	return funcs, nil
+}
+
 func isSystemType(namespace, name string) (*genParamType, bool) {
 	if namespace != "System" {
 		return nil, false
@@ -1070,7 +2136,8 @@ func (g *generator) elementDefaultValue(ctx *types.Context, e types.Element) gen
 
 		if elementTypeDef.IsEnum() {
 			// return the first enum value
-			fields, err := elementTypeDef.ResolveFieldList(ctx)
+			// the enum may be defined in a different metadata file
+			fields, err := elementTypeDef.ResolveFieldList(elementTypeDef.Ctx())
 			if err != nil {
 				return genDefaultValue{"__ERROR_" + err.Error(), true}
 			}
@@ -1079,7 +2146,7 @@ func (g *generator) elementDefaultValue(ctx *types.Context, e types.Element) gen
 				return genDefaultValue{"__ERROR_" + fmt.Errorf("enum %v has no fields", namespace+"."+name).Error(), true}
 			}
 
-			return genDefaultValue{enumName(elementTypeDef.TypeName, fields[1].Name), false}
+			return genDefaultValue{enumName(elementTypeDef.TypeName, sanitizeIdentifier(fields[1].Name, nil)), false}
 		} else if elementTypeDef.IsStruct() {
 			return genDefaultValue{elementTypeDef.TypeName + "{}", false}
 		}
@@ -1094,167 +2161,58 @@ func (g *generator) elementDefaultValue(ctx *types.Context, e types.Element) gen
 	}
 }
 
-func (g *generator) Signature(typeDef *winmd.TypeDef) (string, error) {
-	// Signature generation defined in
-	// https://docs.microsoft.com/en-us/uwp/winrt-cref/winrt-type-system#guid-generation-for-parameterized-types
-
-	// type_signature => (only relevant types included)
-	//   - interface_signature
-	//   - delegate_signature
-	//   - interface_group_signature
-	//   - runtime_class_signature
-	//   - struct_signature
-	//   - enum_signature
-	//   # these instance signatures cannot be generated until instantiated
-	//   - pinterface_instance_signature
-	//   - pdelegate_instance_signature
-	//
-	// Where:
-	//   - interface_signature => guid
-	//   - delegate_signature => "delegate(" guid ")"
-	//   - interface_group_signature => "ig(" interface_group_name ";" default_interface ")"
-	//   - runtime_class_signature => "rc(" runtime_class_name ";" default_interface ")"
-	//   - struct_signature => "struct(" struct_name ";" args ")"
-	//   - enum_signature => "enum(" enum_name ";" enum_underlying_type ")"
-
-	switch {
-	case typeDef.IsInterface():
-		// interface_signature => guid
-		guid, err := typeDef.GUID()
-		if err != nil {
-			return "", err
-		}
-
-		return fmt.Sprintf("{%s}", guid), nil
-	case typeDef.IsEnum():
-		// enum_signature => "enum(" enum_name ";" enum_underlying_type ")"
-		// the first field should be the underlying integer type of the enum. It must have the following flags:
-		fields, err := typeDef.ResolveFieldList(typeDef.Ctx())
+// instanceName returns the Go name of the wrapper of the given parameterized type instance,
+// along with the WinRT name of the instance, e.g. IVectorViewString and IVectorView<String>.
+func (g *generator) instanceName(ctx *types.Context, e types.Element) (string, string, error) {
+	switch e.Type.Kind {
+	case types.ELEMENT_TYPE_OBJECT:
+		return "Object", "Object", nil
+	case types.ELEMENT_TYPE_CLASS, types.ELEMENT_TYPE_VALUETYPE:
+		_, name, err := ctx.ResolveTypeDefOrRefName(e.Type.TypeDef.Index)
 		if err != nil {
-			return "", err
+			return "", "", err
 		}
-		fieldSig, err := fields[0].Signature.Reader().Field(typeDef.Ctx())
+		name = typeNameToGoName(name, true)
+		return name, name, nil
+	case types.ELEMENT_TYPE_GENERICINST:
+		_, name, err := ctx.ResolveTypeDefOrRefName(e.Type.TypeDef.Index)
 		if err != nil {
-			return "", err
+			return "", "", err
 		}
+		name = typeNameToGoName(name, true)
 
-		enumType := primitiveTypeSignature(fieldSig.Field.Type.Kind)
-		return fmt.Sprintf(`enum(%s;%s)`, typeDef.TypeNamespace+"."+typeDef.TypeName, enumType), nil
-	case typeDef.IsStruct():
-		// struct_signature => "struct(" struct_name ";" args ")"
-		fields, err := typeDef.ResolveFieldList(typeDef.Ctx())
-		if err != nil {
-			return "", err
-		}
-		structArgs := []string{}
-		for _, f := range fields {
-			fSig, err := f.Signature.Reader().Field(typeDef.Ctx())
+		goName := name
+		argNames := make([]string, 0, len(e.Type.TypeDef.Generics))
+		for _, arg := range e.Type.TypeDef.Generics {
+			argGoName, argName, err := g.instanceName(ctx, types.Element{Type: arg})
 			if err != nil {
-				return "", err
-			}
-
-			// Struct fields must be fundamental types, enums, or other structs
-			if fSig.Field.Type.Kind == types.ELEMENT_TYPE_VALUETYPE {
-				// this is an struct or an enum
-				fieldType, err := g.elementType(typeDef.Ctx(), fSig.Field)
-				if err != nil {
-					return "", err
-				}
-
-				t, err := g.mdStore.TypeDefByName(fieldType.namespace + "." + fieldType.name)
-				if err != nil {
-					return "", err
-				}
-
-				sig, err := g.Signature(t)
-				if err != nil {
-					return "", err
-				}
-				structArgs = append(structArgs, sig)
-			} else {
-				// Assume everything else is a fundamental type
-				structArgs = append(structArgs, primitiveTypeSignature(fSig.Field.Type.Kind))
-			}
-		}
-		return fmt.Sprintf(`struct(%s;%s)`, typeDef.TypeNamespace+"."+typeDef.TypeName, strings.Join(structArgs, ";")), nil
-	case typeDef.IsDelegate():
-		//delegate_signature => "delegate(" guid ")"
-		guid, err := typeDef.GUID()
-		if err != nil {
-			return "", err
-		}
-
-		return fmt.Sprintf(`delegate({%s})`, guid), nil
-	case typeDef.IsRuntimeClass():
-		// Static only classes carry the abstract flag.
-		// These cannot be instantiated so no signature needed.
-		if typeDef.Flags.Abstract() {
-			return "", nil
-		}
-
-		// runtime_class_signature => "rc(" runtime_class_name ";" default_interface ")"
-
-		// Runtime classes must specify the DefaultAttribute on exactly one of their InterfaceImpl rows.
-		defaultInterface, err := typeDef.GetAttributeWithType(winmd.AttributeTypeDefaultAttribute)
-		if err != nil {
-			// Some classes (Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcher) do not
-			// define a runtime class. I'm not sure if this is an error in the IDL or the documentation.
-			// But we are not going to fail here. Just default to the first implemented interface
-			ifs, ifserr := typeDef.GetImplementedInterfaces()
-			if ifserr != nil {
-				return "", err
-			}
-
-			if len(ifs) == 0 {
-				return "", err
+				return "", "", err
 			}
-			defaultInterface = []byte(ifs[0].Namespace + "." + ifs[0].Name)
-		}
-
-		td, err := g.mdStore.TypeDefByName(string(defaultInterface))
-		if err != nil {
-			return "", err
+			goName += argGoName
+			argNames = append(argNames, argName)
 		}
+		return goName, name + "<" + strings.Join(argNames, ", ") + ">", nil
+	}
 
-		defaultInterfaceSignature, err := g.Signature(td)
-		if err != nil {
-			return "", err
-		}
-		return fmt.Sprintf(`rc(%s;%s)`, typeDef.TypeNamespace+"."+typeDef.TypeName, defaultInterfaceSignature), nil
-	default:
-		return "", fmt.Errorf("unsupported type: %v", typeDef.TypeName)
+	if name, ok := primitiveTypeNames[e.Type.Kind]; ok {
+		return name, name, nil
 	}
+	return "", "", fmt.Errorf("unsupported type argument: %v", e.Type.Kind)
 }
 
-func primitiveTypeSignature(kind types.ElementTypeKind) string {
-	switch kind {
-	// Fundamental types
-	case types.ELEMENT_TYPE_U1:
-		return winrt.SignatureUInt8
-	case types.ELEMENT_TYPE_U2:
-		return winrt.SignatureUInt16
-	case types.ELEMENT_TYPE_U4:
-		return winrt.SignatureUInt32
-	case types.ELEMENT_TYPE_U8:
-		return winrt.SignatureUInt64
-	case types.ELEMENT_TYPE_I1:
-		return winrt.SignatureInt8
-	case types.ELEMENT_TYPE_I2:
-		return winrt.SignatureInt16
-	case types.ELEMENT_TYPE_I4:
-		return winrt.SignatureInt32
-	case types.ELEMENT_TYPE_I8:
-		return winrt.SignatureInt64
-	case types.ELEMENT_TYPE_R4:
-		return winrt.SignatureFloat32
-	case types.ELEMENT_TYPE_R8:
-		return winrt.SignatureFloat64
-	case types.ELEMENT_TYPE_BOOLEAN:
-		return winrt.SignatureBool
-	case types.ELEMENT_TYPE_CHAR:
-		return winrt.SignatureChar
-	case types.ELEMENT_TYPE_STRING:
-		return winrt.SignatureString
-	}
-	return ""
+// primitiveTypeNames holds the WinRT names of the fundamental types.
+var primitiveTypeNames = map[types.ElementTypeKind]string{
+	types.ELEMENT_TYPE_BOOLEAN: "Boolean",
+	types.ELEMENT_TYPE_CHAR:    "Char16",
+	types.ELEMENT_TYPE_I1:      "Int8",
+	types.ELEMENT_TYPE_U1:      "UInt8",
+	types.ELEMENT_TYPE_I2:      "Int16",
+	types.ELEMENT_TYPE_U2:      "UInt16",
+	types.ELEMENT_TYPE_I4:      "Int32",
+	types.ELEMENT_TYPE_U4:      "UInt32",
+	types.ELEMENT_TYPE_I8:      "Int64",
+	types.ELEMENT_TYPE_U8:      "UInt64",
+	types.ELEMENT_TYPE_R4:      "Single",
+	types.ELEMENT_TYPE_R8:      "Double",
+	types.ELEMENT_TYPE_STRING:  "String",
 }
@@ -0,0 +1,786 @@
+package codegen
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/saltosystems/winrt-go/internal/winmd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGenerator(t *testing.T) *generator {
+	t.Helper()
+
+	mdStore, err := winmd.NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	return &generator{
+		methodFilter: NewMethodFilter(nil),
+		logger:       log.NewNopLogger(),
+		opaques:      make(map[string]*genOpaque),
+		instances:    make(map[string]*genInstance),
+		mdStore:      mdStore,
+		signatures:   &signatureBuilder{mdStore: mdStore},
+	}
+}
+
+func TestCreateGenEnum(t *testing.T) {
+	g := newTestGenerator(t)
+
+	tests := []struct {
+		typeName string
+		flags    bool
+		values   []string
+		flagsLen int
+	}{
+		{
+			typeName: "Windows.Devices.Bluetooth.GenericAttributeProfile.GattCommunicationStatus",
+			flags:    false,
+			values:   []string{"Success", "Unreachable", "ProtocolError", "AccessDenied"},
+		},
+		{
+			typeName: "Windows.Devices.Bluetooth.GenericAttributeProfile.GattCharacteristicProperties",
+			flags:    true,
+			values: []string{
+				"None", "Broadcast", "Read", "WriteWithoutResponse", "Write", "Notify", "Indicate",
+				"AuthenticatedSignedWrites", "ExtendedProperties", "ReliableWrites", "WritableAuxiliaries",
+			},
+			flagsLen: 10, // None is not a flag
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			typeDef, err := g.mdStore.TypeDefByName(tt.typeName)
+			require.NoError(t, err)
+
+			e, err := g.createGenEnum(typeDef)
+			require.NoError(t, err)
+			assert.Equal(t, tt.flags, e.Flags)
+
+			texts := make([]string, 0, len(e.Values))
+			for _, v := range e.Values {
+				texts = append(texts, v.Text)
+			}
+			assert.Equal(t, tt.values, texts)
+			if tt.flags {
+				assert.Len(t, e.FlagValues(), tt.flagsLen)
+			}
+		})
+	}
+}
+
+func TestGenEnumParse(t *testing.T) {
+	g := newTestGenerator(t)
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	generate := func(typeName string) string {
+		typeDef, err := g.mdStore.TypeDefByName(typeName)
+		require.NoError(t, err)
+		e, err := g.createGenEnum(typeDef)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, tmpl.ExecuteTemplate(&buf, "enum.tmpl", e))
+		return buf.String()
+	}
+
+	// numbers are parsed using the size and signedness of the underlying type
+	src := generate("Windows.Devices.Bluetooth.GenericAttributeProfile.GattCharacteristicProperties")
+	assert.Contains(t, src, "n, err := strconv.ParseUint(part, 0, 32)")
+	// an empty set of flags is written as a number so it can be parsed back
+	assert.Contains(t, src, `return "0"`)
+
+	src = generate("Windows.Devices.Bluetooth.GenericAttributeProfile.GattCommunicationStatus")
+	assert.Contains(t, src, "n, err := strconv.ParseInt(s, 0, 32)")
+}
+
+func TestGenEnumBitSize(t *testing.T) {
+	tests := []struct {
+		typ      string
+		unsigned bool
+		bitSize  int
+	}{
+		{typ: "int32", unsigned: false, bitSize: 32},
+		{typ: "uint32", unsigned: true, bitSize: 32},
+		{typ: "uint8", unsigned: true, bitSize: 8},
+		{typ: "int64", unsigned: false, bitSize: 64},
+	}
+	for _, tt := range tests {
+		e := &genEnum{Type: tt.typ}
+		assert.Equal(t, tt.unsigned, e.Unsigned(), tt.typ)
+		assert.Equal(t, tt.bitSize, e.BitSize(), tt.typ)
+	}
+}
+
+func TestGenEnumUniqueValues(t *testing.T) {
+	e := &genEnum{
+		Values: []*genEnumValue{
+			{Name: "A", Value: "0", Text: "A"},
+			{Name: "B", Value: "1", Text: "B"},
+			{Name: "Alias", Value: "1", Text: "Alias"},
+			{Name: "C", Value: "2", Text: "C"},
+		},
+	}
+
+	names := func(values []*genEnumValue) []string {
+		res := make([]string, 0, len(values))
+		for _, v := range values {
+			res = append(res, v.Name)
+		}
+		return res
+	}
+	assert.Equal(t, []string{"A", "B", "C"}, names(e.UniqueValues()))
+	assert.Equal(t, []string{"B", "C"}, names(e.FlagValues()))
+}
+
+func TestCreateGenClassMaxContract(t *testing.T) {
+	g := newTestGenerator(t)
+	g.maxContracts = map[string]uint32{"Windows.Foundation.UniversalApiContract": 0x00040000}
+
+	typeDef, err := g.mdStore.TypeDefByName("Windows.Devices.Bluetooth.BluetoothLEDevice")
+	require.NoError(t, err)
+
+	class, err := g.createGenClass(typeDef)
+	require.NoError(t, err)
+	require.NotNil(t, class.Contract)
+	assert.Equal(t, "Windows.Foundation.UniversalApiContract v1.0", class.Contract.String())
+
+	// IBluetoothLEDevice4 (v5.0) and newer are excluded
+	var names []string
+	for _, itf := range class.ImplInterfaces {
+		names = append(names, itf.Name)
+	}
+	assert.Equal(t, []string{"iBluetoothLEDevice", "iBluetoothLEDevice2", "iBluetoothLEDevice3", "IClosable"}, names)
+}
+
+func TestCreateGenEnumMaxContract(t *testing.T) {
+	g := newTestGenerator(t)
+	g.maxContracts = map[string]uint32{"Windows.Foundation.UniversalApiContract": 0x00030000}
+
+	typeDef, err := g.mdStore.TypeDefByName("Windows.Devices.Bluetooth.BluetoothError")
+	require.NoError(t, err)
+
+	e, err := g.createGenEnum(typeDef)
+	require.NoError(t, err)
+
+	texts := make([]string, 0, len(e.Values))
+	for _, v := range e.Values {
+		texts = append(texts, v.Text)
+	}
+	// TransportNotSupported was introduced in v4.0
+	assert.NotContains(t, texts, "TransportNotSupported")
+	assert.Contains(t, texts, "ConsentRequired")
+}
+
+func TestGenClassMethodOwners(t *testing.T) {
+	g := newTestGenerator(t)
+
+	typeDef, err := g.mdStore.TypeDefByName("Windows.Devices.Bluetooth.BluetoothLEDevice")
+	require.NoError(t, err)
+
+	class, err := g.createGenClass(typeDef)
+	require.NoError(t, err)
+	data := genData{Package: "bluetooth", Classes: []*genClass{class}}
+	data.ComputeImports(typeDef.TypeNamespace)
+	assert.Equal(t, "metadata.ApiInformation", class.ApiInformation())
+
+	owners := make(map[string][]string)
+	for _, o := range class.MethodOwners() {
+		owners[o.FullyQualifiedName] = o.Funcs
+	}
+	assert.Contains(t, owners["Windows.Devices.Bluetooth.IBluetoothLEDevice6"], "GetConnectionParameters")
+	assert.Equal(t, []string{"Close"}, owners["Windows.Foundation.IClosable"])
+	// event helpers are checked using the interface of the event
+	assert.Contains(t, owners["Windows.Devices.Bluetooth.IBluetoothLEDevice"], "OnConnectionStatusChanged")
+	// static functions are checked using their static interface
+	assert.Contains(t, owners["Windows.Devices.Bluetooth.IBluetoothLEDeviceStatics"], "BluetoothLEDeviceFromIdAsync")
+}
+
+func TestGenFuncDeprecated(t *testing.T) {
+	typeName := "Windows.Devices.Bluetooth.IBluetoothLEDevice"
+
+	g := newTestGenerator(t)
+	typeDef, err := g.mdStore.TypeDefByName(typeName)
+	require.NoError(t, err)
+
+	itf, err := g.createGenInterface(typeDef, false)
+	require.NoError(t, err)
+	funcs := make(map[string]*genFunc)
+	for _, f := range itf.Funcs {
+		funcs[f.Name] = f
+	}
+	require.Contains(t, funcs, "GetGattService")
+	assert.True(t, funcs["GetGattService"].Implement)
+	assert.Equal(t, "Use GetGattServicesForUuidAsync instead of GetGattService. For more information, see MSDN.", funcs["GetGattService"].Deprecated)
+	assert.Empty(t, funcs["get_ConnectionStatus"].Deprecated)
+
+	// excluded methods are kept in the vtable, but not implemented
+	g.excludeDeprecated = true
+	itf, err = g.createGenInterface(typeDef, false)
+	require.NoError(t, err)
+	for _, f := range itf.Funcs {
+		funcs[f.Name] = f
+	}
+	assert.False(t, funcs["GetGattService"].Implement)
+	assert.False(t, funcs["get_GattServices"].Implement)
+	assert.True(t, funcs["get_ConnectionStatus"].Implement)
+}
+
+func TestDeprecationDoc(t *testing.T) {
+	assert.Empty(t, deprecationDoc("Foo", nil))
+	assert.Equal(t, "Use Bar instead.", deprecationDoc("Foo", &winmd.Deprecation{Message: "Use Bar\n  instead."}))
+	assert.Equal(t, "Foo is deprecated since Windows.Foundation.UniversalApiContract v4.0.", deprecationDoc("Foo", &winmd.Deprecation{
+		Since: winmd.NewContractVersion("Windows.Foundation.UniversalApiContract", 4, 0),
+	}))
+}
+
+func TestUseDefaultOverloadNames(t *testing.T) {
+	g := newTestGenerator(t)
+	g.defaultOverloadNames = true
+	// the names of the methods that are not implemented are used by the vtable, so they are renamed too
+	g.methodFilter = NewMethodFilter([]string{"!*"})
+
+	names := func(typeName string) []string {
+		typeDef, err := g.mdStore.TypeDefByName(typeName)
+		require.NoError(t, err)
+		funcs, err := g.getGenFuncs(typeDef, false)
+		require.NoError(t, err)
+
+		res := make([]string, 0, len(funcs))
+		for _, f := range funcs {
+			res = append(res, f.Name)
+		}
+		return res
+	}
+
+	// FormatDouble is the default overload of Format
+	formatter := names("Windows.Globalization.NumberFormatting.INumberFormatter")
+	assert.Equal(t, []string{"FormatInt", "FormatUInt", "Format"}, formatter)
+
+	// FindAllAsyncDeviceClass is the default overload of FindAllAsync, but there is another overload named FindAllAsync
+	statics := names("Windows.Devices.Enumeration.IDeviceInformationStatics")
+	assert.Contains(t, statics, "FindAllAsync")
+	assert.Contains(t, statics, "FindAllAsyncDeviceClass")
+}
+
+func TestCreateGenClassDefaultOverloadCollision(t *testing.T) {
+	g := newTestGenerator(t)
+	g.defaultOverloadNames = true
+
+	// GetDrivingRouteWithOptionsAsync (IMapRouteFinderStatics2) is the default overload of GetDrivingRouteAsync,
+	// which is also the overload name of a function of IMapRouteFinderStatics
+	typeDef, err := g.mdStore.TypeDefByName("Windows.Services.Maps.MapRouteFinder")
+	require.NoError(t, err)
+	class, err := g.createGenClass(typeDef)
+	require.NoError(t, err)
+
+	owners := make(map[string][]string)
+	for _, o := range class.MethodOwners() {
+		owners[o.FullyQualifiedName] = o.Funcs
+	}
+	assert.Contains(t, owners["Windows.Services.Maps.IMapRouteFinderStatics"], "MapRouteFinderGetDrivingRouteAsync")
+	assert.Contains(t, owners["Windows.Services.Maps.IMapRouteFinderStatics2"], "MapRouteFinderGetDrivingRouteAsyncIMapRouteFinderStatics2")
+}
+
+func TestDisambiguateAccessors(t *testing.T) {
+	g := newTestGenerator(t)
+
+	names := func(typeName string) map[string]string {
+		typeDef, err := g.mdStore.TypeDefByName(typeName)
+		require.NoError(t, err)
+		funcs, err := g.getGenFuncs(typeDef, false)
+		require.NoError(t, err)
+
+		res := make(map[string]string, len(funcs))
+		for _, f := range funcs {
+			res[f.Name] = funcName(*f)
+		}
+		return res
+	}
+
+	// the GetText and SetText methods keep their names
+	textRange := names("Windows.UI.Text.ITextRange")
+	assert.Equal(t, "GetText", textRange["GetText"])
+	assert.Equal(t, "SetText", textRange["SetText"])
+	assert.Equal(t, "GetTextProperty", textRange["get_Text"])
+	assert.Equal(t, "SetTextProperty", textRange["put_Text"])
+
+	// only the colliding accessor is renamed
+	mediaElement := names("Windows.UI.Xaml.Controls.IMediaElement")
+	assert.Equal(t, "SetSource", mediaElement["SetSource"])
+	assert.Equal(t, "GetSource", mediaElement["get_Source"])
+	assert.Equal(t, "SetSourceProperty", mediaElement["put_Source"])
+}
+
+func TestDisambiguateClassFuncs(t *testing.T) {
+	g := newTestGenerator(t)
+	typeDef, err := g.mdStore.TypeDefByName("Windows.Devices.Bluetooth.BluetoothLEDevice")
+	require.NoError(t, err)
+
+	itf := func(name string, funcs ...string) *genInterface {
+		res := &genInterface{Name: name, FullyQualifiedName: "Windows.Test." + name}
+		for _, f := range funcs {
+			res.Funcs = append(res.Funcs, &genFunc{Name: f, Implement: true})
+		}
+		return res
+	}
+	first := itf("IFirst", "get_Status", "Close")
+	second := itf("ISecond", "GetStatus", "Close", "Signature")
+	third := itf("IThird", "Close")
+
+	g.disambiguateClassFuncs(typeDef, []*genInterface{first, second, third}, nil)
+
+	var names []string
+	for _, i := range []*genInterface{first, second, third} {
+		for _, f := range i.Funcs {
+			names = append(names, f.ClassFuncName())
+		}
+	}
+	assert.Equal(t, []string{
+		"GetStatus", "Close",
+		"GetStatusISecond", "CloseISecond", "SignatureISecond",
+		"CloseIThird",
+	}, names)
+
+	// the interfaces keep the original names
+	assert.Equal(t, "Close", funcName(*third.Funcs[0]))
+}
+
+func TestUniqueSuffix(t *testing.T) {
+	used := map[string]bool{"Property": true, "Property2": true}
+	assert.Equal(t, "Property3", uniqueSuffix("Property", func(s string) bool { return used[s] }))
+	assert.Equal(t, "Event", uniqueSuffix("Event", func(s string) bool { return used[s] }))
+}
+
+func TestPairEvents(t *testing.T) {
+	g := newTestGenerator(t)
+
+	event := func(typeName, addName string) *genEventHelper {
+		typeDef, err := g.mdStore.TypeDefByName(typeName)
+		require.NoError(t, err)
+		iface, err := g.createGenInterface(typeDef, false)
+		require.NoError(t, err)
+		data := genData{Package: typePackage(typeDef.TypeNamespace, typeDef.TypeName), Interfaces: []*genInterface{iface}}
+		data.ComputeImports(typeDef.TypeNamespace)
+
+		for _, f := range iface.Funcs {
+			if f.Name == addName {
+				return f.InterfaceEvent()
+			}
+		}
+		t.Fatalf("%s not found in %s", addName, typeName)
+		return nil
+	}
+
+	paramNames := func(e *genEventHelper) []string {
+		var names []string
+		for _, p := range e.Params {
+			names = append(names, p.GoVarName()+" "+p.GoTypeName())
+		}
+		return names
+	}
+
+	// the type arguments of parameterized delegates are passed to their constructor
+	received := event("Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher", "add_Received")
+	require.NotNil(t, received)
+	assert.Equal(t, "OnReceived", received.Name)
+	assert.Equal(t, "AddReceived", received.AddFunc)
+	assert.Equal(t, "RemoveReceived", received.RemoveFunc)
+	assert.Equal(t, "foundation.NewTypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementReceivedEventArgs]", received.HandlerConstructor())
+	assert.Empty(t, received.HandlerIID())
+	assert.Equal(t, []string{"sender BluetoothLEAdvertisementWatcher", "args BluetoothLEAdvertisementReceivedEventArgs"}, paramNames(received))
+
+	// the rest of the delegates receive their IID
+	loaded := event("Windows.UI.Xaml.IFrameworkElement", "add_Loaded")
+	require.NotNil(t, loaded)
+	assert.Equal(t, "OnLoaded", loaded.Name)
+	assert.Equal(t, "NewRoutedEventHandler", loaded.HandlerConstructor())
+	assert.Equal(t, "&IIDRoutedEventHandler", loaded.HandlerIID())
+	assert.Equal(t, []string{"sender unsafe.Pointer", "e RoutedEventArgs"}, paramNames(loaded))
+
+	// KeyEventHandler receives a KeyRoutedEventArgs, that can not be imported from Windows.UI.Xaml
+	assert.Nil(t, event("Windows.UI.Xaml.IUIElement", "add_KeyDown"))
+}
+
+func TestGenClassQueryInterface(t *testing.T) {
+	g := newTestGenerator(t)
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	generate := func() string {
+		typeDef, err := g.mdStore.TypeDefByName("Windows.Devices.Bluetooth.BluetoothLEDevice")
+		require.NoError(t, err)
+		class, err := g.createGenClass(typeDef)
+		require.NoError(t, err)
+		data := genData{Package: "bluetooth", Classes: []*genClass{class}}
+		data.ComputeImports(typeDef.TypeNamespace)
+
+		var buf bytes.Buffer
+		require.NoError(t, tmpl.ExecuteTemplate(&buf, "class.tmpl", class))
+		formatted, err := format.Source(buf.Bytes())
+		require.NoError(t, err)
+		return string(formatted)
+	}
+
+	// methods return an error if the interface is not implemented
+	src := generate()
+	assert.Contains(t, src, `itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice, "Windows.Devices.Bluetooth.IBluetoothLEDevice")`)
+	assert.NotContains(t, src, "MustQueryInterface")
+	// the restricted error information is read from the thread that made the call
+	assert.Contains(t, src, "runtime.LockOSThread()")
+	// static functions use the cached activation factory
+	assert.Contains(t, src, `inspectable, err := winrt.GetActivationFactory("Windows.Devices.Bluetooth.BluetoothLEDevice", &IIDiBluetoothLEDeviceStatics)`)
+
+	g.mustQueryInterface = true
+	src = generate()
+	assert.Contains(t, src, "itf := impl.MustQueryInterface(&IIDiBluetoothLEDevice)")
+	assert.NotContains(t, src, "winrt.QueryInterface")
+
+	assert.NotContains(t, src, "BluetoothLEDeviceHandle")
+
+	// the methods of the class handle borrow the interfaces cached by the handle, the class methods release theirs
+	g.mustQueryInterface = false
+	g.cacheInterfaces = true
+	src = generate()
+	assert.Contains(t, src, "type BluetoothLEDeviceHandle struct {\n\t*winrt.Handle[*BluetoothLEDevice]\n}")
+	assert.Contains(t, src, "func (impl BluetoothLEDeviceHandle) GetConnectionStatus(")
+	assert.Contains(t, src, "func (impl *BluetoothLEDevice) GetConnectionStatus(")
+	cached := strings.Count(src, `itf, err := impl.QueryInterface(&IIDiBluetoothLEDevice, "Windows.Devices.Bluetooth.IBluetoothLEDevice")`)
+	assert.Positive(t, cached)
+	assert.Equal(t, cached, strings.Count(src, `itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice, "Windows.Devices.Bluetooth.IBluetoothLEDevice")`))
+	assert.NotContains(t, src, "panic(err)")
+	// the IClosable.Close method collides with winrt.Handle.Close, so it is only declared by the class
+	assert.Contains(t, src, "func (impl *BluetoothLEDevice) Close(")
+	assert.NotContains(t, src, "func (impl BluetoothLEDeviceHandle) Close(")
+
+	g.mustQueryInterface = true
+	src = generate()
+	assert.Contains(t, src, "impl.QueryInterface(&IIDiBluetoothLEDevice")
+	assert.Contains(t, src, "panic(err)")
+}
+
+func TestGenClassHandles(t *testing.T) {
+	g := newTestGenerator(t)
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	generate := func(pkg, name string) string {
+		typeDef, err := g.mdStore.TypeDefByName(name)
+		require.NoError(t, err)
+		class, err := g.createGenClass(typeDef)
+		require.NoError(t, err)
+		data := genData{Package: pkg, Classes: []*genClass{class}}
+		data.ComputeImports(typeDef.TypeNamespace)
+
+		var buf bytes.Buffer
+		require.NoError(t, tmpl.ExecuteTemplate(&buf, "class.tmpl", class))
+		formatted, err := format.Source(buf.Bytes())
+		require.NoError(t, err)
+		return string(formatted)
+	}
+
+	// by default the objects are returned as they are
+	src := generate("bluetooth", "Windows.Devices.Bluetooth.BluetoothLEDevice")
+	assert.NotContains(t, src, "winrt.Handle[")
+	assert.NotContains(t, src, "winrt.NewHandle")
+	assert.NotContains(t, src, "winrt.RetainHandle")
+
+	g.handles = true
+	src = generate("bluetooth", "Windows.Devices.Bluetooth.BluetoothLEDevice")
+	// methods and static functions return owned handles
+	assert.Contains(t, src, "GetConnectionParameters() (*winrt.Handle[*BluetoothLEConnectionParameters], error)")
+	assert.Contains(t, src, "ret0, err := v.GetConnectionParameters()")
+	assert.Contains(t, src, "return winrt.NewHandle(ret0), nil")
+	assert.Contains(t, src, "func BluetoothLEDeviceFromBluetoothAddressAsync(bluetoothAddress uint64) (*winrt.Handle[*IAsyncOperationBluetoothLEDevice], error)")
+	// values are returned as they are
+	assert.Contains(t, src, "GetBluetoothAddress() (uint64, error)")
+	// event helpers pass handles holding their own references
+	assert.Contains(t, src, "callback func(sender *winrt.Handle[*BluetoothLEDevice], args unsafe.Pointer)")
+	assert.Contains(t, src, "callback(winrt.RetainHandle(sender), args)")
+
+	// constructors return owned handles
+	src = generate("streams", "Windows.Storage.Streams.DataWriter")
+	assert.Contains(t, src, "func NewDataWriter() (*winrt.Handle[*DataWriter], error)")
+	assert.Contains(t, src, "return winrt.NewHandle((*DataWriter)(unsafe.Pointer(inspectable))), nil")
+}
+
+func TestGUIDLiteral(t *testing.T) {
+	literal, err := guidLiteral("b5ee2f7b-4ad8-4642-ac48-80a0b500e887")
+	require.NoError(t, err)
+	assert.Equal(t, "ole.GUID{Data1: 0xB5EE2F7B, Data2: 0x4AD8, Data3: 0x4642, Data4: [8]byte{0xAC, 0x48, 0x80, 0xA0, 0xB5, 0x00, 0xE8, 0x87}}", literal)
+
+	_, err = guidLiteral("not a GUID")
+	assert.Error(t, err)
+}
+
+func TestGenInterfaceGenericArgs(t *testing.T) {
+	g := newTestGenerator(t)
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	typeDef, err := g.mdStore.TypeDefByName("Windows.Foundation.Collections.IVector`1")
+	require.NoError(t, err)
+	itf, err := g.createGenInterface(typeDef, false)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.ExecuteTemplate(&buf, "interface.tmpl", itf))
+	src := buf.String()
+
+	// generic arrays are marshaled element by element
+	assert.Contains(t, src, "itemsABI := winrt.NewOutArray[T](itemsSize)")
+	assert.Contains(t, src, "itemsABI, err := winrt.NewInArray(items)")
+	assert.Contains(t, src, "itemsPtr := itemsABI.Pointer()")
+	assert.Contains(t, src, "items := itemsABI.Values()")
+	// generic values may take several slots
+	assert.Contains(t, src, "valueSlots := valueABI.ABI()")
+	assert.Contains(t, src, "callArgs = append(callArgs, valueSlots...)")
+	assert.Contains(t, src, "syscall.SyscallN(v.VTable().SetAt, callArgs...)")
+}
+
+func TestGenInterfaceValueArgs(t *testing.T) {
+	g := newTestGenerator(t)
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	generate := func(name string) string {
+		typeDef, err := g.mdStore.TypeDefByName(name)
+		require.NoError(t, err)
+		itf, err := g.createGenInterface(typeDef, false)
+		require.NoError(t, err)
+		data := genData{Package: "bluetooth", Interfaces: []*genInterface{itf}}
+		data.ComputeImports(typeDef.TypeNamespace)
+
+		var buf bytes.Buffer
+		require.NoError(t, tmpl.ExecuteTemplate(&buf, "interface.tmpl", itf))
+		formatted, err := format.Source(buf.Bytes())
+		require.NoError(t, err)
+		return string(formatted)
+	}
+
+	// the 8-byte EventRegistrationToken struct is passed by value on x64, and by reference on none of the platforms
+	src := generate("Windows.Devices.Bluetooth.IBluetoothLEDevice")
+	assert.Contains(t, src, "tokenABI, err := winrt.NewInValue(token)")
+	assert.Regexp(t, `callArgs = append\(callArgs, tokenSlots\.\.\.\)\s+// in foundation\.EventRegistrationToken\n`, src)
+	assert.NotContains(t, src, "uintptr(unsafe.Pointer(&token))")
+
+	// 64-bit integers span two slots on 32-bit platforms
+	src = generate("Windows.Devices.Bluetooth.IBluetoothLEDeviceStatics")
+	assert.Contains(t, src, "bluetoothAddressABI, err := winrt.NewInValue(bluetoothAddress)")
+	assert.Regexp(t, `callArgs = append\(callArgs, bluetoothAddressSlots\.\.\.\)\s+// in uint64\n`, src)
+	assert.NotContains(t, src, "uintptr(bluetoothAddress)")
+
+	// floats are passed by their bit pattern
+	src = generate("Windows.Media.Playback.IMediaPlaybackSession")
+	assert.Contains(t, src, "valueABI, err := winrt.NewInValue(value)")
+	assert.Regexp(t, `callArgs = append\(callArgs, valueSlots\.\.\.\)\s+// in float64\n`, src)
+	assert.NotRegexp(t, `uintptr\(value\),\s*// in float64`, src)
+}
+
+func TestGenDelegateRefs(t *testing.T) {
+	g := newTestGenerator(t)
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	typeDef, err := g.mdStore.TypeDefByName("Windows.Foundation.AsyncActionCompletedHandler")
+	require.NoError(t, err)
+	d, err := g.createGenDelegate(typeDef)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.ExecuteTemplate(&buf, "delegate.tmpl", d))
+
+	// the reference count is recorded by the delegate, so it is updated when referenced or released by Go callers
+	assert.Equal(t, 2, strings.Count(buf.String(), "delegate.SetRefs(unsafe.Pointer(r), r.refs)"))
+}
+
+func TestInFloatRegisters(t *testing.T) {
+	g := newTestGenerator(t)
+
+	tests := []struct {
+		name string
+		t    *genParamType
+		want bool
+	}{
+		{"float32", &genParamType{name: "float32", IsPrimitive: true}, true},
+		{"float64", &genParamType{name: "float64", IsPrimitive: true}, true},
+		{"int32", &genParamType{name: "int32", IsPrimitive: true}, false},
+		{"GUID", &genParamType{namespace: "syscall", name: "GUID"}, false},
+		// structs made of up to four floats of the same type are passed in floating point registers on ARM
+		{"Point", &genParamType{namespace: "Windows.Foundation", name: "Point"}, true},
+		{"Rect", &genParamType{namespace: "Windows.Foundation", name: "Rect"}, true},
+		{"Matrix4x4", &genParamType{namespace: "Windows.Foundation.Numerics", name: "Matrix4x4"}, false},
+		{"TimeSpan", &genParamType{namespace: "Windows.Foundation", name: "TimeSpan"}, false},
+		{"array", &genParamType{name: "float64", IsPrimitive: true, IsArray: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := g.inFloatRegisters(tt.t)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// the delegates implemented in Go can not receive them, the return values are written through a pointer
+	p, err := g.floatRegisterParam([]*genParam{
+		{varName: "sender", Type: &genParamType{namespace: "Windows.Foundation", name: "IClosable", IsPointer: true}},
+		{varName: "result", Type: &genParamType{name: "float64", IsPrimitive: true}, IsOut: true},
+		{varName: "position", Type: &genParamType{namespace: "Windows.Foundation", name: "Point"}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, p)
+	assert.Equal(t, "position", p.varName)
+}
+
+func TestTypeArgumentOpaque(t *testing.T) {
+	tests := []struct {
+		itf, instance, argNamespace, argName string
+		opaque                               bool
+	}{
+		// the storage package may import the capture package, so its file is replaced
+		{
+			"Windows.Media.Capture.ICameraCaptureUI", "Windows.Media.Capture.IAsyncOperationStorageFile",
+			"Windows.Media.Capture", "OpaqueStorageFile", true,
+		},
+		// the generic attribute profile package imports the bluetooth package, so the instance is declared by
+		// the generic attribute profile package, which uses its result as is
+		{
+			"Windows.Devices.Bluetooth.IBluetoothLEDevice3", "Windows.Devices.Bluetooth.GenericAttributeProfile.IAsyncOperationGattDeviceServicesResult",
+			"Windows.Devices.Bluetooth.GenericAttributeProfile", "GattDeviceServicesResult", false,
+		},
+		// the results declared by the same package are used as is
+		{
+			"Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceService3", "Windows.Devices.Bluetooth.GenericAttributeProfile.IAsyncOperationGattCharacteristicsResult",
+			"Windows.Devices.Bluetooth.GenericAttributeProfile", "GattCharacteristicsResult", false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.itf, func(t *testing.T) {
+			g := newTestGenerator(t)
+			typeDef, err := g.mdStore.TypeDefByName(tt.itf)
+			require.NoError(t, err)
+			_, err = g.createGenInterface(typeDef, false)
+			require.NoError(t, err)
+
+			instance, ok := g.instances[tt.instance]
+			require.True(t, ok, "instances: %v", g.instances)
+			require.Len(t, instance.Type.typeArgs, 1)
+			arg := instance.Type.typeArgs[0]
+			assert.Equal(t, tt.argNamespace, arg.namespace)
+			assert.Equal(t, tt.argName, arg.name)
+			_, opaque := g.opaques[tt.argName]
+			assert.Equal(t, tt.opaque, opaque)
+		})
+	}
+}
+
+func TestRelocatedFuncs(t *testing.T) {
+	g := newTestGenerator(t)
+	typeDef, err := g.mdStore.TypeDefByName("Windows.Devices.Bluetooth.BluetoothLEDevice")
+	require.NoError(t, err)
+	require.NoError(t, g.loadCodeGenData(typeDef))
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+	files := make(map[string]string, len(g.genDataFiles))
+	var names []string
+	for _, f := range g.genDataFiles {
+		f.Data.ComputeImports(f.Namespace)
+		var buf bytes.Buffer
+		require.NoError(t, tmpl.ExecuteTemplate(&buf, "file.tmpl", f.Data))
+		formatted, err := format.Source(buf.Bytes())
+		require.NoError(t, err, f.Filename)
+		files[f.Filename] = string(formatted)
+		names = append(names, f.Filename)
+	}
+
+	// the generic attribute profile package imports the bluetooth package, so the methods that use its types are
+	// declared by it, and so is the instance of their result, which uses its type directly
+	src, ok := files["windows/devices/bluetooth/bluetoothledevice.go"]
+	require.True(t, ok, "files: %v", names)
+	// only the vtable of the interface keeps them
+	assert.NotContains(t, src, "GetGattServicesAsync(")
+	assert.NotContains(t, src, "Opaque")
+	assert.NotContains(t, files, "windows/devices/bluetooth/iasyncoperationgattdeviceservicesresult.go")
+
+	src, ok = files["windows/devices/bluetooth/genericattributeprofile/bluetooth_bluetoothledevice.go"]
+	require.True(t, ok, "files: %v", names)
+	assert.Contains(t, src, "func BluetoothLEDeviceGetGattServicesAsync(impl *bluetooth.BluetoothLEDevice) (*IAsyncOperationGattDeviceServicesResult, error)")
+	assert.Contains(t, src, "func BluetoothLEDeviceGetGattServicesWithCacheModeAsync(impl *bluetooth.BluetoothLEDevice, cacheMode bluetooth.BluetoothCacheMode) (*IAsyncOperationGattDeviceServicesResult, error)")
+	assert.NotContains(t, src, "GetConnectionParameters")
+
+	src, ok = files["windows/devices/bluetooth/genericattributeprofile/iasyncoperationgattdeviceservicesresult.go"]
+	require.True(t, ok, "files: %v", names)
+	assert.Contains(t, src, "package genericattributeprofile")
+	assert.Contains(t, src, "foundation.IAsyncOperation[*GattDeviceServicesResult]")
+}
+
+func TestInstanceHandleFuncs(t *testing.T) {
+	g := newTestGenerator(t)
+	g.handles = true
+
+	collection := func(name string, args ...*genParamType) *genParamType {
+		return &genParamType{namespace: "Windows.Foundation.Collections", name: name, IsPointer: true, typeArgs: args}
+	}
+	str := &genParamType{name: "string", IsPrimitive: true}
+	service := &genParamType{namespace: "Windows.Devices.Bluetooth.GenericAttributeProfile", name: "GattDeviceService", IsPointer: true}
+
+	names := func(t *testing.T, genericType *genParamType) []string {
+		t.Helper()
+		funcs, err := g.instanceHandleFuncs(genericType)
+		require.NoError(t, err)
+		names := make([]string, 0, len(funcs))
+		for _, f := range funcs {
+			names = append(names, funcName(*f))
+			require.Len(t, f.ReturnParams, 1)
+			assert.Equal(t, service, f.ReturnParams[0].Type)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"GetAt"}, names(t, collection("IVectorView`1", service)))
+	assert.Equal(t, []string{"GetAt"}, names(t, collection("IVector`1", service)))
+	assert.Equal(t, []string{"GetCurrent"}, names(t, collection("IIterator`1", service)))
+	assert.Equal(t, []string{"Lookup"}, names(t, collection("IMapView`2", str, service)))
+	// values are returned as they are
+	assert.Empty(t, names(t, collection("IVectorView`1", str)))
+	assert.Empty(t, names(t, collection("IMapView`2", service, str)))
+	// winrt.Await relies on the results of the asynchronous operations
+	assert.Empty(t, names(t, &genParamType{namespace: "Windows.Foundation", name: "IAsyncOperation`1", IsPointer: true, typeArgs: []*genParamType{service}}))
+
+	g.handles = false
+	assert.Empty(t, names(t, collection("IVectorView`1", service)))
+}
+
+func TestGenInstanceHandles(t *testing.T) {
+	g := newTestGenerator(t)
+	g.handles = true
+	typeDef, err := g.mdStore.TypeDefByName("Windows.Devices.Bluetooth.GenericAttributeProfile.GattDeviceServicesResult")
+	require.NoError(t, err)
+	_, err = g.createGenClass(typeDef)
+	require.NoError(t, err)
+
+	instance, ok := g.instances["Windows.Devices.Bluetooth.GenericAttributeProfile.IVectorViewGattDeviceService"]
+	require.True(t, ok)
+	data := genData{Package: "genericattributeprofile", Instances: []*genInstance{instance}}
+	data.ComputeImports(typeDef.TypeNamespace)
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.ExecuteTemplate(&buf, "instance.tmpl", instance))
+	formatted, err := format.Source(buf.Bytes())
+	require.NoError(t, err)
+	src := string(formatted)
+
+	assert.Contains(t, src, "collections.IVectorView[*GattDeviceService]")
+	assert.Contains(t, src, "func (v *IVectorViewGattDeviceService) GetAt(index uint32) (*winrt.Handle[*GattDeviceService], error)")
+	assert.Contains(t, src, "ret, err := v.IVectorView.GetAt(index)")
+	assert.Contains(t, src, "return winrt.NewHandle(ret), nil")
+}
@@ -2,14 +2,35 @@ package codegen
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 // Config is the configuration for the code generation.
 type Config struct {
-	Debug         bool
-	Class         string
-	ValidateOnly  bool
+	Debug        bool
+	Class        string
+	ValidateOnly bool
+	// ExcludeDeprecated and ExcludeExperimental exclude the types and members marked with the
+	// DeprecatedAttribute and the ExperimentalAttribute.
+	ExcludeDeprecated   bool
+	ExcludeExperimental bool
+	// DefaultOverloadNames names the default overload of each method after the method, instead of
+	// using its overload name.
+	DefaultOverloadNames bool
+	// MustQueryInterface makes the methods of the generated classes panic when the object does not
+	// implement the interface of the method, instead of returning an error.
+	MustQueryInterface bool
+	// CacheInterfaces generates a handle type for each class, whose methods cache the interfaces they
+	// query from the object, instead of querying them on every call.
+	CacheInterfaces bool
+	// Handles makes the methods, static functions and constructors of the generated classes return the
+	// objects owned by the caller wrapped in a winrt.Handle, like the collections they return do with their
+	// elements, and their event helpers pass handles to the callbacks, so the objects are released by closing
+	// the handles.
+	Handles       bool
 	methodFilters []string
+	maxContracts  map[string]uint32
 }
 
 // NewConfig returns a new Config with default values.
@@ -17,6 +38,35 @@ func NewConfig() *Config {
 	return &Config{}
 }
 
+// AddMaxContract sets the maximum version of an API contract, using the 'Contract=major[.minor]' format.
+// Types and members introduced in a newer version of the contract are excluded from the generated code.
+func (cfg *Config) AddMaxContract(maxContract string) error {
+	parts := strings.SplitN(maxContract, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("invalid max contract %q, expected 'Contract=major[.minor]'", maxContract)
+	}
+
+	versionParts := strings.SplitN(parts[1], ".", 2)
+	major, err := strconv.ParseUint(versionParts[0], 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid major version in max contract %q: %w", maxContract, err)
+	}
+	var minor uint64
+	if len(versionParts) == 2 {
+		minor, err = strconv.ParseUint(versionParts[1], 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid minor version in max contract %q: %w", maxContract, err)
+		}
+	}
+
+	if cfg.maxContracts == nil {
+		cfg.maxContracts = make(map[string]uint32)
+	}
+	// the major version is stored in the high 16 bits and the minor in the low 16 bits
+	cfg.maxContracts[parts[0]] = uint32(major)<<16 | uint32(minor)
+	return nil
+}
+
 // AddMethodFilter adds a method to the list of methodFilters to generate.
 func (cfg *Config) AddMethodFilter(methodFilter string) {
 	cfg.methodFilters = append(cfg.methodFilters, methodFilter)
@@ -0,0 +1,23 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigAddMaxContract(t *testing.T) {
+	cfg := NewConfig()
+	require.NoError(t, cfg.AddMaxContract("Windows.Foundation.UniversalApiContract=10"))
+	require.NoError(t, cfg.AddMaxContract("Windows.Foundation.FoundationContract=4.1"))
+
+	assert.Equal(t, map[string]uint32{
+		"Windows.Foundation.UniversalApiContract": 0x000a0000,
+		"Windows.Foundation.FoundationContract":   0x00040001,
+	}, cfg.maxContracts)
+
+	for _, invalid := range []string{"", "Windows.Foundation.UniversalApiContract", "=10", "Contract=x", "Contract=1.x", "Contract=65536"} {
+		assert.Error(t, cfg.AddMaxContract(invalid), invalid)
+	}
+}
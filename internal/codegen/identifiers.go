@@ -0,0 +1,137 @@
+package codegen
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// goKeywords holds the reserved keywords of the Go language.
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true,
+	"default": true, "defer": true, "else": true, "fallthrough": true, "for": true,
+	"func": true, "go": true, "goto": true, "if": true, "import": true,
+	"interface": true, "map": true, "package": true, "range": true, "return": true,
+	"select": true, "struct": true, "switch": true, "type": true, "var": true,
+}
+
+// goPredeclared holds the predeclared identifiers of the Go universe block. They can be redeclared,
+// but doing so hides them from the rest of the function, where the generated code may use them.
+var goPredeclared = map[string]bool{
+	// types
+	"any": true, "bool": true, "byte": true, "comparable": true, "complex64": true,
+	"complex128": true, "error": true, "float32": true, "float64": true, "int": true,
+	"int8": true, "int16": true, "int32": true, "int64": true, "rune": true,
+	"string": true, "uint": true, "uint8": true, "uint16": true, "uint32": true,
+	"uint64": true, "uintptr": true,
+	// constants and zero value
+	"true": true, "false": true, "iota": true, "nil": true,
+	// functions
+	"append": true, "cap": true, "clear": true, "close": true, "complex": true,
+	"copy": true, "delete": true, "imag": true, "len": true, "make": true,
+	"max": true, "min": true, "new": true, "panic": true, "print": true,
+	"println": true, "real": true, "recover": true,
+}
+
+// templateLocals holds the identifiers declared or referenced by the generated function bodies:
+// the local variables and receivers used by the templates, and the packages they import.
+var templateLocals = map[string]bool{
+	// funcimpl.tmpl and class.tmpl
+	"v": true, "hr": true, "err": true, "itf": true, "impl": true, "inspectable": true, "out": true,
+	"callArgs": true, "release": true,
+	// delegate.tmpl
+	"instance": true, "instancePtr": true, "abiArgs": true, "resultPtr": true, "result": true,
+	"callback": true, "ok": true,
+	// imported packages
+	"ole": true, "syscall": true, "unsafe": true, "winrt": true, "kernel32": true, "delegate": true,
+	"sync": true, "time": true, "fmt": true, "strconv": true, "strings": true, "runtime": true,
+}
+
+// derivedLocalSuffixes holds the suffixes of the local variables the templates derive from the name
+// of a parameter, like valueABI or valueHStr.
+var derivedLocalSuffixes = []string{"ABI", "HStr", "Ptr", "Slots"}
+
+// isReservedIdentifier returns true if the given name can not be used as the name of a parameter
+// or a local variable of the generated code.
+func isReservedIdentifier(name string) bool {
+	// the raw arguments of the delegates' Invoke method
+	if strings.HasPrefix(name, "rawArgs") {
+		return true
+	}
+	// the results of the methods of the classes that return handles
+	if n := strings.TrimPrefix(name, "ret"); n != name && n != "" && strings.Trim(n, "0123456789") == "" {
+		return true
+	}
+	return goKeywords[name] || goPredeclared[name] || templateLocals[name]
+}
+
+// sanitizeIdentifier returns a valid Go identifier for the given name. The name is prefixed
+// with an 'm' while it is not valid or it is reserved, as reported by the given function.
+// Other characters not allowed in identifiers are replaced by an underscore.
+func sanitizeIdentifier(name string, reserved func(string) bool) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name)
+
+	for name == "" || name == "_" || unicode.IsDigit([]rune(name)[0]) || (reserved != nil && reserved(name)) {
+		first, size := utf8.DecodeRuneInString(name)
+		if size == 0 {
+			name = "m"
+			continue
+		}
+		name = "m" + string(unicode.ToUpper(first)) + name[size:]
+	}
+	return name
+}
+
+// sanitizeParamNames renames the parameters of a function that would clash with Go keywords,
+// predeclared identifiers, the identifiers used in the generated body or the given package names.
+// Parameters are also renamed to avoid duplicates introduced by the renaming.
+func sanitizeParamNames(params []*genParam, packages map[string]bool) {
+	used := make(map[string]bool, len(params))
+	for _, p := range params {
+		used[p.varName] = true
+	}
+
+	for _, p := range params {
+		original := p.varName
+		name := sanitizeIdentifier(original, func(name string) bool {
+			return isReservedIdentifier(name) || packages[name] || (name != original && used[name]) ||
+				clashesWithDerivedLocal(name, used)
+		})
+		if name == original {
+			continue
+		}
+		p.varName = name
+		used[name] = true
+
+		// the templates expect the size of an array to be named after the array
+		if p.arraySize != nil {
+			p.arraySize.varName = name + "Size"
+			used[p.arraySize.varName] = true
+		}
+	}
+}
+
+// clashesWithDerivedLocal returns true if the given name is a local variable derived from the name
+// of another parameter, or if a local variable derived from the name is used by another parameter.
+func clashesWithDerivedLocal(name string, used map[string]bool) bool {
+	for _, suffix := range derivedLocalSuffixes {
+		if base := strings.TrimSuffix(name, suffix); base != name && used[base] {
+			return true
+		}
+		if used[name+suffix] {
+			return true
+		}
+	}
+	return false
+}
+
+// isReservedFieldName returns true if the given name can not be used as the name of a struct field.
+// Fields are exported, so they can only clash with the methods of the generated structs.
+func isReservedFieldName(name string) bool {
+	return goKeywords[name] || typeNameToGoName(name, true) == "Signature"
+}
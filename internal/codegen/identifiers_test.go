@@ -0,0 +1,372 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	gotypes "go/types"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/imports"
+)
+
+func TestSanitizeIdentifier(t *testing.T) {
+	tests := map[string]string{
+		"value":  "value",
+		"type":   "mType",
+		"func":   "mFunc",
+		"range":  "mRange",
+		"select": "mSelect",
+		"map":    "mMap",
+		"string": "mString",
+		"nil":    "mNil",
+		"v":      "mV",
+		"hr":     "mHr",
+		"err":    "mErr",
+		"itf":    "mItf",
+		"ole":    "mOle",
+		"unsafe": "mUnsafe",
+		"out":    "mOut",
+		"1st":    "m1st",
+		"a-b":    "a_b",
+		"":       "m",
+		"_":      "m_",
+	}
+	for name, expected := range tests {
+		assert.Equal(t, expected, sanitizeIdentifier(name, isReservedIdentifier), name)
+	}
+
+	// fields are exported, keywords are only an issue because of the existing naming
+	assert.Equal(t, "mType", sanitizeIdentifier("type", isReservedFieldName))
+	assert.Equal(t, "mSignature", sanitizeIdentifier("Signature", isReservedFieldName))
+	assert.Equal(t, "Value", sanitizeIdentifier("Value", isReservedFieldName))
+	assert.Equal(t, "err", sanitizeIdentifier("err", isReservedFieldName))
+}
+
+func TestSanitizeParamNames(t *testing.T) {
+	size := &genParam{varName: "vSize"}
+	params := []*genParam{
+		{varName: "range"},
+		{varName: "mRange"},
+		size,
+		{varName: "v", arraySize: size},
+		{varName: "foundation"},
+		{varName: "value"},
+	}
+
+	sanitizeParamNames(params, map[string]bool{"foundation": true})
+
+	var names []string
+	for _, p := range params {
+		names = append(names, p.varName)
+	}
+	assert.Equal(t, []string{"mMRange", "mRange", "mVSize", "mV", "mFoundation", "value"}, names)
+
+	// the local variables derived from the name of a parameter can not clash with other parameters
+	params = []*genParam{
+		{varName: "value"},
+		{varName: "valueABI"},
+		{varName: "valueHStr"},
+		{varName: "mPtr"},
+		{varName: "m"},
+	}
+	sanitizeParamNames(params, nil)
+
+	names = nil
+	for _, p := range params {
+		names = append(names, p.varName)
+	}
+	assert.Equal(t, []string{"mValue", "mMValueABI", "mMValueHStr", "mMPtr", "mMM"}, names)
+}
+
+// knownIssues holds the interfaces and delegates the generator does not support yet, and the methods
+// using signatures the templates do not support, with the reason. Any other failure is reported by
+// TestGenerateAllMethods, as well as the issues that are fixed.
+var knownIssues = map[string]string{
+	"Windows.AI.MachineLearning.ITensorStringStatics.CreateFromArray":                                   "array of strings parameter data",
+	"Windows.AI.MachineLearning.ITensorStringStatics2.CreateFromShapeArrayAndDataArray":                 "array of strings parameter data",
+	"Windows.ApplicationModel.Contacts.IContactMatchReason":                                             "struct type argument Windows.Data.Text.TextSegment can not be imported from Windows.ApplicationModel.Contacts",
+	"Windows.ApplicationModel.Contacts.IContactPanel":                                                   "struct type argument Windows.UI.Color can not be imported from Windows.ApplicationModel.Contacts",
+	"Windows.ApplicationModel.IAppInfo4.get_SupportedFileExtensions":                                    "returned array",
+	"Windows.Devices.Display.Core.IDisplayDevice2":                                                      "struct type argument Windows.Graphics.RectInt32 can not be imported from Windows.Devices.Display.Core",
+	"Windows.Devices.Display.Core.IDisplayPath":                                                         "struct type argument Windows.Graphics.SizeInt32 can not be imported from Windows.Devices.Display.Core",
+	"Windows.Devices.Display.Core.IDisplayView":                                                         "struct type argument Windows.Graphics.SizeInt32 can not be imported from Windows.Devices.Display.Core",
+	"Windows.Devices.Display.IDisplayMonitor.GetDescriptor":                                             "returned array",
+	"Windows.Devices.Enumeration.IDeviceInformationStatics":                                             "type Windows.Devices.Enumeration.DeviceInformationCollection has no custom attribute Windows.Foundation.Metadata.DefaultAttribute",
+	"Windows.Devices.Enumeration.IDeviceInformationStatics2":                                            "type Windows.Devices.Enumeration.DeviceInformationCollection has no custom attribute Windows.Foundation.Metadata.DefaultAttribute",
+	"Windows.Devices.Enumeration.Pnp.IPnpObjectStatics":                                                 "type Windows.Devices.Enumeration.Pnp.PnpObjectCollection has no custom attribute Windows.Foundation.Metadata.DefaultAttribute",
+	"Windows.Devices.ILowLevelDevicesAggregateProviderFactory":                                          "method Create: the types of Windows.Devices.Adc.Provider and Windows.Devices.Pwm.Provider can not be used from the same package",
+	"Windows.Devices.Lights.ILampArray.GetIndicesForKey":                                                "returned array",
+	"Windows.Devices.Lights.ILampArray.GetIndicesForPurposes":                                           "returned array",
+	"Windows.Devices.Lights.ILampInfo":                                                                  "struct type argument Windows.UI.Color can not be imported from Windows.Devices.Lights",
+	"Windows.Devices.PointOfService.IMagneticStripeReader.get_SupportedCardTypes":                       "returned array",
+	"Windows.Devices.Sms.ISmsBinaryMessage.GetData":                                                     "returned array",
+	"Windows.Foundation.Diagnostics.ILoggingFields.AddStringArray":                                      "array of strings parameter value",
+	"Windows.Foundation.Diagnostics.ILoggingFields.AddStringArrayWithFormat":                            "array of strings parameter value",
+	"Windows.Foundation.Diagnostics.ILoggingFields.AddStringArrayWithFormatAndTags":                     "array of strings parameter value",
+	"Windows.Foundation.IGuidHelperStatics":                                                             "unexpected element type 0x2c9",
+	"Windows.Foundation.IPropertyValue.GetStringArray":                                                  "array of strings parameter value",
+	"Windows.Foundation.IPropertyValueStatics.CreateStringArray":                                        "array of strings parameter value",
+	"Windows.Foundation.IReferenceArray`1.get_Value":                                                    "returned array",
+	"Windows.Gaming.Input.Preview.ILegacyGipGameControllerProvider.GetExtendedDeviceInfo":               "returned array",
+	"Windows.Gaming.Input.Preview.ILegacyGipGameControllerProvider.GetHeadsetOperation":                 "returned array",
+	"Windows.Graphics.Display.IDisplayServicesStatics.FindAll":                                          "returned array",
+	"Windows.Graphics.Holographic.IHolographicCameraPose":                                               "struct type argument Windows.Perception.Spatial.SpatialBoundingFrustum can not be imported from Windows.Graphics.Holographic",
+	"Windows.Graphics.Holographic.IHolographicCameraViewportParameters.get_HiddenAreaMesh":              "returned array",
+	"Windows.Graphics.Holographic.IHolographicCameraViewportParameters.get_VisibleAreaMesh":             "returned array",
+	"Windows.Graphics.Imaging.IBitmapPropertiesView":                                                    "type Windows.Graphics.Imaging.BitmapPropertySet has no custom attribute Windows.Foundation.Metadata.DefaultAttribute",
+	"Windows.Graphics.Imaging.IPixelDataProvider.DetachPixelData":                                       "returned array",
+	"Windows.Media.Devices.IDigitalWindowControl.get_SupportedModes":                                    "returned array",
+	"Windows.Media.IVideoFrame2":                                                                        "struct type argument Windows.Graphics.Imaging.BitmapBounds can not be imported from Windows.Media",
+	"Windows.Media.Protection.PlayReady.INDClosedCaptionDataReceivedEventArgs.get_ClosedCaptionData":    "returned array",
+	"Windows.Media.Protection.PlayReady.INDCustomData.get_CustomData":                                   "returned array",
+	"Windows.Media.Protection.PlayReady.INDCustomData.get_CustomDataTypeID":                             "returned array",
+	"Windows.Media.Protection.PlayReady.INDLicenseFetchDescriptor.get_ContentID":                        "returned array",
+	"Windows.Media.Protection.PlayReady.INDSendResult.get_Response":                                     "returned array",
+	"Windows.Media.Protection.PlayReady.INDTransmitterProperties.get_ClientID":                          "returned array",
+	"Windows.Media.Protection.PlayReady.INDTransmitterProperties.get_ModelDigest":                       "returned array",
+	"Windows.Media.Protection.PlayReady.INDTransmitterProperties.get_SupportedFeatures":                 "returned array",
+	"Windows.Media.Protection.PlayReady.IPlayReadyContentHeader.GetSerializedHeader":                    "returned array",
+	"Windows.Media.Protection.PlayReady.IPlayReadyContentHeader2.get_KeyIdStrings":                      "returned array",
+	"Windows.Media.Protection.PlayReady.IPlayReadyContentHeader2.get_KeyIds":                            "returned array",
+	"Windows.Media.Protection.PlayReady.IPlayReadyContentHeaderFactory2.CreateInstanceFromComponents2":  "array of strings parameter contentKeyIdStrings",
+	"Windows.Media.Protection.PlayReady.IPlayReadyITADataGenerator.GenerateData":                        "returned array",
+	"Windows.Media.Protection.PlayReady.IPlayReadyMeteringReportServiceRequest.get_MeteringCertificate": "returned array",
+	"Windows.Media.Protection.PlayReady.IPlayReadySecureStopServiceRequest.get_PublisherCertificate":    "returned array",
+	"Windows.Media.Protection.PlayReady.IPlayReadySoapMessage.GetMessageBody":                           "returned array",
+	"Windows.Networking.NetworkOperators.IHotspotAuthenticationContext.get_WirelessNetworkId":           "returned array",
+	"Windows.Networking.NetworkOperators.IUssdMessage.GetPayload":                                       "returned array",
+	"Windows.Perception.People.IEyesPose":                                                               "struct type argument Windows.Perception.Spatial.SpatialRay can not be imported from Windows.Perception.People",
+	"Windows.Perception.Spatial.ISpatialCoordinateSystem":                                               "struct type argument Windows.Foundation.Numerics.Matrix4x4 can not be imported from Windows.Perception.Spatial",
+	"Windows.Perception.Spatial.ISpatialStageFrameOfReference.TryGetMovementBounds":                     "returned array",
+	"Windows.Security.Cryptography.Certificates.ICertificate.GetHashValue":                              "returned array",
+	"Windows.Security.Cryptography.Certificates.ICertificate.GetHashValueWithAlgorithm":                 "returned array",
+	"Windows.Security.Cryptography.Certificates.ICertificate.get_SerialNumber":                          "returned array",
+	"Windows.Security.Cryptography.Certificates.ICertificateExtension.get_Value":                        "returned array",
+	"Windows.Security.Cryptography.Certificates.ICertificateQuery.get_Thumbprint":                       "returned array",
+	"Windows.Security.Cryptography.Certificates.ICertificateRequestProperties3.get_CurveParameters":     "returned array",
+	"Windows.Security.Cryptography.Certificates.ICmsAttachedSignature.get_Content":                      "returned array",
+	"Windows.Storage.Provider.IStorageProviderQuotaUI":                                                  "struct type argument Windows.UI.Color can not be imported from Windows.Storage.Provider",
+	"Windows.Storage.Search.IStorageFileQueryResult2":                                                   "struct type argument Windows.Data.Text.TextSegment can not be imported from Windows.Storage.Search",
+	"Windows.UI.Composition.IVector2NaturalMotionAnimation":                                             "struct type argument Windows.Foundation.Numerics.Vector2 can not be imported from Windows.UI.Composition",
+	"Windows.UI.Composition.IVector3NaturalMotionAnimation":                                             "struct type argument Windows.Foundation.Numerics.Vector3 can not be imported from Windows.UI.Composition",
+	"Windows.UI.Composition.Interactions.IInteractionTracker":                                           "struct type argument Windows.Foundation.Numerics.Vector3 can not be imported from Windows.UI.Composition.Interactions",
+	"Windows.UI.Composition.Interactions.IInteractionTrackerInertiaStateEnteredArgs":                    "struct type argument Windows.Foundation.Numerics.Vector3 can not be imported from Windows.UI.Composition.Interactions",
+	"Windows.UI.Input.Spatial.ISpatialInteractionSourceLocation":                                        "struct type argument Windows.Foundation.Numerics.Vector3 can not be imported from Windows.UI.Input.Spatial",
+	"Windows.UI.Input.Spatial.ISpatialInteractionSourceLocation2":                                       "struct type argument Windows.Foundation.Numerics.Quaternion can not be imported from Windows.UI.Input.Spatial",
+	"Windows.UI.Input.Spatial.ISpatialInteractionSourceLocation3":                                       "struct type argument Windows.Foundation.Numerics.Vector3 can not be imported from Windows.UI.Input.Spatial",
+	"Windows.UI.Input.Spatial.ISpatialInteractionSourceProperties":                                      "struct type argument Windows.Foundation.Numerics.Vector3 can not be imported from Windows.UI.Input.Spatial",
+	"Windows.UI.StartScreen.ITileMixedRealityModel":                                                     "struct type argument Windows.Perception.Spatial.SpatialBoundingBox can not be imported from Windows.UI.StartScreen",
+	"Windows.UI.Xaml.Automation.Provider.IDragProvider.GetGrabbedItems":                                 "returned array",
+	"Windows.UI.Xaml.Automation.Provider.IDragProvider.get_DropEffects":                                 "returned array",
+	"Windows.UI.Xaml.Automation.Provider.IDropTargetProvider.get_DropEffects":                           "returned array",
+	"Windows.UI.Xaml.Automation.Provider.IMultipleViewProvider.GetSupportedViews":                       "returned array",
+	"Windows.UI.Xaml.Automation.Provider.ISelectionProvider.GetSelection":                               "returned array",
+	"Windows.UI.Xaml.Automation.Provider.ISpreadsheetItemProvider.GetAnnotationObjects":                 "returned array",
+	"Windows.UI.Xaml.Automation.Provider.ISpreadsheetItemProvider.GetAnnotationTypes":                   "returned array",
+	"Windows.UI.Xaml.Automation.Provider.ITableItemProvider.GetColumnHeaderItems":                       "returned array",
+	"Windows.UI.Xaml.Automation.Provider.ITableItemProvider.GetRowHeaderItems":                          "returned array",
+	"Windows.UI.Xaml.Automation.Provider.ITableProvider.GetColumnHeaders":                               "returned array",
+	"Windows.UI.Xaml.Automation.Provider.ITableProvider.GetRowHeaders":                                  "returned array",
+	"Windows.UI.Xaml.Automation.Provider.ITextProvider.GetSelection":                                    "returned array",
+	"Windows.UI.Xaml.Automation.Provider.ITextProvider.GetVisibleRanges":                                "returned array",
+	"Windows.UI.Xaml.Automation.Provider.ITextRangeProvider.GetChildren":                                "returned array",
+	"Windows.UI.Xaml.Controls.IListViewBase":                                                            "struct type argument Windows.UI.Xaml.Data.LoadMoreItemsResult can not be imported from Windows.UI.Xaml.Controls",
+	"Windows.UI.Xaml.Controls.IWebView.InvokeScript":                                                    "array of strings parameter arguments",
+	"Windows.UI.Xaml.Markup.IXamlMetadataProvider.GetXmlnsDefinitions":                                  "returned array",
+	"Windows.Web.AtomPub.IAtomPubClient":                                                                "struct type argument Windows.Web.Syndication.RetrievalProgress can not be imported from Windows.Web.AtomPub",
+}
+
+// TestGenerateAllMethods generates every method of every interface in the embedded metadata,
+// and type-checks the generated code to make sure the names of the parameters do not break it.
+// It takes a long time, so it only runs when WINRT_GO_GEN_ALL_METHODS is set (see the go-test-all-methods target).
+func TestGenerateAllMethods(t *testing.T) {
+	if os.Getenv("WINRT_GO_GEN_ALL_METHODS") == "" {
+		t.Skip("generating all the methods is slow, set WINRT_GO_GEN_ALL_METHODS to run it")
+	}
+
+	// the generated code only builds on windows, its dependencies are type-checked from source
+	ctxt := build.Default
+	defer func() { build.Default = ctxt }()
+	build.Default.GOOS = "windows"
+	build.Default.CgoEnabled = false
+
+	fset := token.NewFileSet()
+	conf := gotypes.Config{
+		Importer: &generatedCodeImporter{
+			src:      importer.ForCompiler(fset, "source", nil),
+			packages: make(map[string]*gotypes.Package),
+		},
+	}
+
+	tmpl, err := getTemplates()
+	require.NoError(t, err)
+
+	g := newTestGenerator(t)
+
+	// the rest of the types are not generated, so the types of the same package are undefined in the
+	// type-checked code, and the packages of other namespaces can not be imported
+	typeNames := make(map[string]map[string]bool)
+	packages := make(map[string]bool)
+	typeDefs := g.mdStore.TypeDefs()
+	for _, typeDef := range typeDefs {
+		folder := typeToFolder(typeDef.TypeNamespace, typeDef.TypeName)
+		packages["github.com/saltosystems/winrt-go/"+folder] = true
+		if typeNames[folder] == nil {
+			typeNames[folder] = make(map[string]bool)
+		}
+		names := typeNames[folder]
+
+		name := typeDefGoName(typeDef.TypeName, typeDef.Flags.Public())
+		for _, prefix := range []string{"", "GUID", "IID", "Signature"} {
+			names[prefix+name] = true
+		}
+		if typeDef.IsDelegate() {
+			// the constructors of the delegates are used by the event helpers
+			names["New"+name] = true
+		}
+		if typeDef.IsEnum() {
+			// enum values are used as default values
+			fields, err := typeDef.ResolveFieldList(typeDef.Ctx())
+			require.NoError(t, err)
+			for _, f := range fields {
+				names[enumName(typeDef.TypeName, sanitizeIdentifier(f.Name, nil))] = true
+			}
+		}
+	}
+
+	generated := 0
+	seen := make(map[string]bool, len(knownIssues))
+	checkKnownIssue := func(name, reason string) {
+		seen[name] = true
+		if known, ok := knownIssues[name]; ok {
+			assert.Equal(t, known, reason, name)
+			return
+		}
+		t.Errorf("%s is not supported: %s", name, reason)
+	}
+	for _, typeDef := range typeDefs {
+		// every method belongs to an interface, delegates are also checked because of their Invoke method
+		if typeDef.Flags&0x4000 == 0 || !(typeDef.IsInterface() || typeDef.IsDelegate()) {
+			continue
+		}
+		fullName := typeDef.TypeNamespace + "." + typeDef.TypeName
+		folder := typeToFolder(typeDef.TypeNamespace, typeDef.TypeName)
+
+		g.opaques = make(map[string]*genOpaque)
+		g.instances = make(map[string]*genInstance)
+		data := genData{Package: typePackage(typeDef.TypeNamespace, typeDef.TypeName)}
+
+		// some types are not supported by the generator yet
+		var funcs []*genFunc
+		switch {
+		case typeDef.IsInterface():
+			iface, err := g.createGenInterface(typeDef, false)
+			if err != nil {
+				checkKnownIssue(fullName, err.Error())
+				continue
+			}
+			data.Interfaces = append(data.Interfaces, iface)
+			funcs = iface.Funcs
+		default:
+			delegate, err := g.createGenDelegate(typeDef)
+			if err != nil {
+				checkKnownIssue(fullName, err.Error())
+				continue
+			}
+			data.Delegates = append(data.Delegates, delegate)
+		}
+
+		for _, f := range funcs {
+			if reason := unsupportedFuncReason(f); f.Implement && reason != "" {
+				checkKnownIssue(fullName+"."+f.Name, reason)
+				f.Implement = false
+			}
+		}
+		for _, o := range g.opaques {
+			data.Opaques = append(data.Opaques, o)
+		}
+		for _, i := range g.instances {
+			data.Instances = append(data.Instances, i)
+		}
+		data.ComputeImports(typeDef.TypeNamespace)
+
+		var buf bytes.Buffer
+		require.NoError(t, tmpl.ExecuteTemplate(&buf, "file.tmpl", data), fullName)
+
+		filename := typeFilename(typeDef.TypeName) + ".go"
+		src, err := imports.Process(filename, buf.Bytes(), nil)
+		require.NoError(t, err, fullName)
+
+		file, err := parser.ParseFile(fset, filename, src, 0)
+		require.NoError(t, err, fullName)
+
+		var typeErrors []string
+		conf.Error = func(err error) {
+			msg := err.(gotypes.Error).Msg
+			if path := strings.TrimPrefix(msg, "could not import "); path != msg && packages[strings.Fields(path)[0]] {
+				return
+			}
+			if name := strings.TrimPrefix(msg, "undefined: "); name != msg && typeNames[folder][name] {
+				return
+			}
+			typeErrors = append(typeErrors, err.Error())
+		}
+		_, _ = conf.Check(data.Package, fset, []*ast.File{file}, nil)
+		assert.Empty(t, typeErrors, fullName)
+
+		generated++
+	}
+	assert.NotZero(t, generated)
+	for name := range knownIssues {
+		assert.True(t, seen[name], "%s is supported, remove it from the known issues", name)
+	}
+}
+
+// unsupportedFuncReason returns why a function can not be generated, because it uses parameters the
+// templates do not support yet: arrays of strings and returned arrays, that do not have a size parameter.
+// It returns an empty string if the function is supported.
+func unsupportedFuncReason(f *genFunc) string {
+	for _, p := range f.InParams {
+		if p.Type.IsArray && p.Type.name == "string" {
+			return "array of strings parameter " + p.varName
+		}
+	}
+	for _, p := range f.ReturnParams {
+		if p.Type.IsArray {
+			return "returned array"
+		}
+	}
+	return ""
+}
+
+// generatedCodeImporter type-checks the dependencies of the generated code from source. The generated
+// packages are not imported, so the types they define are ignored by the type checker.
+type generatedCodeImporter struct {
+	src      gotypes.Importer
+	packages map[string]*gotypes.Package
+}
+
+func (i *generatedCodeImporter) Import(path string) (*gotypes.Package, error) {
+	if strings.HasPrefix(path, "github.com/saltosystems/winrt-go/windows/") {
+		return nil, fmt.Errorf("generated package %s is not imported", path)
+	}
+	// the source importer looks for the files of the package on every import
+	if pkg, ok := i.packages[path]; ok {
+		return pkg, nil
+	}
+	pkg, err := i.src.Import(path)
+	if err != nil {
+		return nil, err
+	}
+	i.packages[path] = pkg
+	return pkg, nil
+}
@@ -0,0 +1,127 @@
+package codegen
+
+import (
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fileImportNames holds the names of the packages imported by the file template, and the packages of
+// the standard library used by the generated code.
+var fileImportNames = map[string]bool{
+	"syscall": true, "unsafe": true, "ole": true, "winrt": true, "kernel32": true, "delegate": true,
+	"sync": true,
+}
+
+// genScope holds the names used by a generated file to reference the packages it imports.
+// Packages are referenced by their name, unless several of them share the same name or the
+// name is already used by the file. Those packages are imported using an alias derived from
+// their full namespace, e.g. Windows.Devices.Input is imported as devicesinput.
+type genScope struct {
+	namespace string
+	// names holds the name used to reference each imported namespace.
+	names map[string]string
+}
+
+// newGenScope returns the scope of a file of the given namespace and package, that requires the given imports.
+func newGenScope(namespace, pkg string, imports []*genImport) *genScope {
+	s := &genScope{namespace: namespace, names: make(map[string]string)}
+
+	// packages are processed sorted by namespace, so the aliases do not depend on the order of the imports
+	var namespaces []string
+	count := map[string]int{pkg: 1}
+	for _, i := range imports {
+		if i.Namespace == namespace {
+			continue
+		}
+		if _, ok := s.names[i.Namespace]; ok {
+			continue
+		}
+		name := typePackage(i.Namespace, i.Name)
+		s.names[i.Namespace] = name
+		namespaces = append(namespaces, i.Namespace)
+		count[name]++
+	}
+	sort.Strings(namespaces)
+
+	// the names of the packages that keep their name can not be used as alias
+	used := map[string]bool{pkg: true}
+	for name := range fileImportNames {
+		used[name] = true
+	}
+	var aliased []string
+	for _, ns := range namespaces {
+		name := s.names[ns]
+		if isBuiltinNamespace(ns) || (count[name] == 1 && !fileImportNames[name]) {
+			used[name] = true
+			continue
+		}
+		aliased = append(aliased, ns)
+	}
+
+	for _, ns := range aliased {
+		alias := packageAlias(ns)
+		// different namespaces may still produce the same alias, e.g. A.BC and AB.C
+		for n := 2; used[alias]; n++ {
+			alias = packageAlias(ns) + strconv.Itoa(n)
+		}
+		used[alias] = true
+		s.names[ns] = alias
+	}
+	return s
+}
+
+// qualifier returns the prefix required to reference a type of the given namespace from the file.
+// Types that belong to the namespace of the file are not qualified.
+func (s *genScope) qualifier(namespace, name string) string {
+	if s != nil {
+		if namespace == s.namespace {
+			return ""
+		}
+		if pkg, ok := s.names[namespace]; ok {
+			return pkg + "."
+		}
+	}
+	return typePackage(namespace, name) + "."
+}
+
+// importSpec returns the import spec of the given import, named after the package name used by the file.
+func (s *genScope) importSpec(i *genImport) string {
+	if isBuiltinNamespace(i.Namespace) {
+		return strconv.Quote(i.Namespace)
+	}
+
+	folder := typeToFolder(i.Namespace, i.Name)
+	spec := strconv.Quote("github.com/saltosystems/winrt-go/" + folder)
+	pkg := typePackage(i.Namespace, i.Name)
+	if name, ok := s.names[i.Namespace]; ok {
+		pkg = name
+	}
+	if pkg != path.Base(folder) {
+		return pkg + " " + spec
+	}
+	return spec
+}
+
+// isBuiltinNamespace returns true if the namespace is a package of the Go standard library,
+// used by the generated code, instead of a WinRT namespace.
+func isBuiltinNamespace(ns string) bool {
+	return !strings.Contains(ns, ".") && ns != "Windows"
+}
+
+// packageAlias returns the alias used to import the package of the given namespace when its name is
+// ambiguous. The alias is derived from the full namespace, leaving out the common Windows root.
+func packageAlias(ns string) string {
+	alias := strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(ns, "Windows."), ".", ""))
+	if goKeywords[alias] {
+		return alias + "pkg"
+	}
+	return alias
+}
+
+// apiInformationName returns the name of the ApiInformation class, qualified with its package if
+// it is used outside of its namespace.
+func (s *genScope) apiInformationName() string {
+	return s.qualifier(apiInformationImport.Namespace, apiInformationImport.Name) + apiInformationImport.Name
+}
@@ -0,0 +1,128 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackageAlias(t *testing.T) {
+	assert.Equal(t, "devicesinput", packageAlias("Windows.Devices.Input"))
+	assert.Equal(t, "uixamlmediaimaging", packageAlias("Windows.UI.Xaml.Media.Imaging"))
+	assert.Equal(t, "mediaimport", packageAlias("Windows.Media.Import"))
+	assert.Equal(t, "windows", packageAlias("Windows"))
+}
+
+func TestGenScope(t *testing.T) {
+	imports := []*genImport{
+		{"Windows.Foundation", "IAsyncOperation"},
+		{"Windows.UI.Core", "CoreWindow"},
+		{"Windows.ApplicationModel.Core", "CoreApplicationView"},
+		{"Windows.Devices.Input", "PointerDeviceType"},
+		{"Windows.UI.Core", "CoreDispatcher"},
+		{"Windows.Media.Import", "PhotoImportItem"},
+		{"Windows.UI.Input", "PointerPoint"},
+		{"unsafe", "Pointer"},
+	}
+	s := newGenScope("Windows.UI.Input", "input", imports)
+
+	// unambiguous packages keep their name
+	assert.Equal(t, "foundation.", s.qualifier("Windows.Foundation", "IAsyncOperation"))
+	assert.Equal(t, `"github.com/saltosystems/winrt-go/windows/foundation"`, s.importSpec(imports[0]))
+	assert.Equal(t, `importpkg "github.com/saltosystems/winrt-go/windows/media/import"`, s.importSpec(imports[5]))
+	assert.Equal(t, `"unsafe"`, s.importSpec(imports[7]))
+
+	// packages sharing the same name are aliased after their namespace
+	assert.Equal(t, "uicore.", s.qualifier("Windows.UI.Core", "CoreWindow"))
+	assert.Equal(t, `uicore "github.com/saltosystems/winrt-go/windows/ui/core"`, s.importSpec(imports[1]))
+	assert.Equal(t, "applicationmodelcore.", s.qualifier("Windows.ApplicationModel.Core", "CoreApplicationView"))
+
+	// as well as the ones named like the current package
+	assert.Equal(t, "devicesinput.", s.qualifier("Windows.Devices.Input", "PointerDeviceType"))
+	assert.Equal(t, "", s.qualifier("Windows.UI.Input", "PointerPoint"))
+
+	assert.Equal(t, "metadata.ApiInformation", s.apiInformationName())
+	assert.Equal(t, "ApiInformation", newGenScope("Windows.Foundation.Metadata", "metadata", nil).apiInformationName())
+}
+
+func TestGenScopeAliasCollision(t *testing.T) {
+	imports := []*genImport{
+		{"Windows.A.BC", "T"},
+		{"Windows.AB.C", "T"},
+		{"Windows.X.C", "T"},
+		{"Windows.Y.BC", "T"},
+	}
+	s := newGenScope("Windows.Z", "z", imports)
+	assert.Equal(t, "abc.", s.qualifier("Windows.A.BC", "T"))
+	assert.Equal(t, "abc2.", s.qualifier("Windows.AB.C", "T"))
+	assert.Equal(t, "xc.", s.qualifier("Windows.X.C", "T"))
+	assert.Equal(t, "ybc.", s.qualifier("Windows.Y.BC", "T"))
+}
+
+func TestComputeImportsAliases(t *testing.T) {
+	iface := &genInterface{
+		Funcs: []*genFunc{{
+			InParams: []*genParam{
+				{varName: "window", Type: &genParamType{namespace: "Windows.UI.Core", name: "CoreWindow", IsPointer: true}},
+				{varName: "view", Type: &genParamType{namespace: "Windows.ApplicationModel.Core", name: "CoreApplicationView", IsPointer: true}},
+			},
+			RequiresImports: []*genImport{
+				{"Windows.UI.Core", "CoreWindow"},
+				{"Windows.ApplicationModel.Core", "CoreApplicationView"},
+			},
+		}},
+	}
+	data := genData{Package: "test", Interfaces: []*genInterface{iface}}
+	data.ComputeImports("Windows.Test")
+
+	assert.ElementsMatch(t, []string{
+		`uicore "github.com/saltosystems/winrt-go/windows/ui/core"`,
+		`applicationmodelcore "github.com/saltosystems/winrt-go/windows/applicationmodel/core"`,
+	}, data.Imports)
+	assert.Equal(t, "uicore.CoreWindow", iface.Funcs[0].InParams[0].GoTypeName())
+	assert.Equal(t, "applicationmodelcore.CoreApplicationView", iface.Funcs[0].InParams[1].GoTypeName())
+}
+
+func TestRelocatedNamespace(t *testing.T) {
+	const bluetooth = "Windows.Devices.Bluetooth"
+	typ := func(namespace string, args ...*genParamType) *genParamType {
+		return &genParamType{namespace: namespace, name: "T", typeArgs: args}
+	}
+
+	tests := []struct {
+		name   string
+		types  []*genParamType
+		target string
+		err    bool
+	}{
+		{"no types", nil, bluetooth, false},
+		{"own and imported types", []*genParamType{typ(bluetooth), typ("Windows.Foundation")}, bluetooth, false},
+		{"descendant type", []*genParamType{typ(bluetooth + ".GenericAttributeProfile")}, bluetooth + ".GenericAttributeProfile", false},
+		{
+			"descendant type argument",
+			[]*genParamType{typ("Windows.Foundation", typ(bluetooth+".GenericAttributeProfile"))},
+			bluetooth + ".GenericAttributeProfile", false,
+		},
+		{
+			"nested descendant types",
+			[]*genParamType{typ(bluetooth + ".A"), typ(bluetooth + ".A.B"), typ(bluetooth + ".A")},
+			bluetooth + ".A.B", false,
+		},
+		{
+			"sibling descendant types",
+			[]*genParamType{typ(bluetooth + ".Advertisement"), typ(bluetooth + ".GenericAttributeProfile")},
+			"", true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := relocatedNamespace(bluetooth, tt.types)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.target, target)
+		})
+	}
+}
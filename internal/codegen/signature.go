@@ -0,0 +1,206 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/internal/winmd"
+	"github.com/tdakkota/win32metadata/types"
+)
+
+// signatureBuilder computes the signatures of the types defined in the metadata.
+// Signature generation is defined in
+// https://docs.microsoft.com/en-us/uwp/winrt-cref/winrt-type-system#guid-generation-for-parameterized-types
+//
+// type_signature =>
+//   - fundamental_type_signature
+//   - object_signature => "cinterface(IInspectable)"
+//   - guid_signature => "g16"
+//   - interface_signature => guid
+//   - delegate_signature => "delegate(" guid ")"
+//   - interface_group_signature => "ig(" interface_group_name ";" default_interface ")"
+//   - runtime_class_signature => "rc(" runtime_class_name ";" default_interface ")"
+//   - struct_signature => "struct(" struct_name ";" args ")"
+//   - enum_signature => "enum(" enum_name ";" enum_underlying_type ")"
+//   - pinterface_instance_signature => "pinterface(" piid_guid ";" args ")"
+//   - pdelegate_instance_signature => "pinterface(" piid_guid ";" args ")"
+type signatureBuilder struct {
+	mdStore *winmd.Store
+}
+
+// TypeDef returns the signature of the given type. Parameterized types are treated as if
+// they were not parameterized, their signature is the same as their GUID.
+func (b *signatureBuilder) TypeDef(typeDef *winmd.TypeDef) (string, error) {
+	switch {
+	case typeDef.IsInterface():
+		guid, err := typeDef.GUID()
+		if err != nil {
+			return "", err
+		}
+
+		return winrt.InterfaceSignature(guid), nil
+	case typeDef.IsEnum():
+		// the first field should be the underlying integer type of the enum. It must have the following flags:
+		fields, err := typeDef.ResolveFieldList(typeDef.Ctx())
+		if err != nil {
+			return "", err
+		}
+		fieldSig, err := fields[0].Signature.Reader().Field(typeDef.Ctx())
+		if err != nil {
+			return "", err
+		}
+
+		enumType := primitiveTypeSignature(fieldSig.Field.Type.Kind)
+		return winrt.EnumSignature(typeDef.TypeNamespace+"."+typeDef.TypeName, enumType), nil
+	case typeDef.IsStruct():
+		fields, err := typeDef.ResolveFieldList(typeDef.Ctx())
+		if err != nil {
+			return "", err
+		}
+		structArgs := []string{}
+		for _, f := range fields {
+			fSig, err := f.Signature.Reader().Field(typeDef.Ctx())
+			if err != nil {
+				return "", err
+			}
+
+			// Struct fields must be fundamental types, enums, or other structs
+			sig, err := b.Element(typeDef.Ctx(), fSig.Field)
+			if err != nil {
+				return "", err
+			}
+			structArgs = append(structArgs, sig)
+		}
+		return winrt.StructSignature(typeDef.TypeNamespace+"."+typeDef.TypeName, structArgs...), nil
+	case typeDef.IsDelegate():
+		guid, err := typeDef.GUID()
+		if err != nil {
+			return "", err
+		}
+
+		return winrt.DelegateSignature(guid), nil
+	case typeDef.IsRuntimeClass():
+		// Static only classes carry the abstract flag.
+		// These cannot be instantiated so no signature needed.
+		if typeDef.Flags.Abstract() {
+			return "", nil
+		}
+
+		// Runtime classes must specify the DefaultAttribute on exactly one of their InterfaceImpl rows.
+		defaultInterface, err := typeDef.GetAttributeWithType(winmd.AttributeTypeDefaultAttribute)
+		if err != nil {
+			// Some classes (Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcher) do not
+			// define a runtime class. I'm not sure if this is an error in the IDL or the documentation.
+			// But we are not going to fail here. Just default to the first implemented interface
+			ifs, ifserr := typeDef.GetImplementedInterfaces()
+			if ifserr != nil {
+				return "", err
+			}
+
+			if len(ifs) == 0 {
+				return "", err
+			}
+			defaultInterface = []byte(ifs[0].Namespace + "." + ifs[0].Name)
+		}
+
+		td, err := b.mdStore.TypeDefByName(string(defaultInterface))
+		if err != nil {
+			return "", err
+		}
+
+		defaultInterfaceSignature, err := b.TypeDef(td)
+		if err != nil {
+			return "", err
+		}
+		return winrt.RuntimeClassSignature(typeDef.TypeNamespace+"."+typeDef.TypeName, defaultInterfaceSignature), nil
+	default:
+		return "", fmt.Errorf("unsupported type: %v", typeDef.TypeName)
+	}
+}
+
+// Element returns the signature of the given element, including instances of parameterized types.
+// The element can not reference any generic parameter, since those do not have a signature
+// until they are instantiated.
+func (b *signatureBuilder) Element(ctx *types.Context, e types.Element) (string, error) {
+	switch e.Type.Kind {
+	case types.ELEMENT_TYPE_OBJECT:
+		return winrt.SignatureObject, nil
+	case types.ELEMENT_TYPE_GENERICINST:
+		namespace, name, err := ctx.ResolveTypeDefOrRefName(e.Type.TypeDef.Index)
+		if err != nil {
+			return "", err
+		}
+		genericTypeDef, err := b.mdStore.TypeDefByName(namespace + "." + name)
+		if err != nil {
+			return "", err
+		}
+		baseGUID, err := genericTypeDef.GUID()
+		if err != nil {
+			return "", err
+		}
+
+		argSignatures := make([]string, 0, len(e.Type.TypeDef.Generics))
+		for _, arg := range e.Type.TypeDef.Generics {
+			sig, err := b.Element(ctx, types.Element{Type: arg})
+			if err != nil {
+				return "", err
+			}
+			argSignatures = append(argSignatures, sig)
+		}
+		return winrt.ParameterizedSignature(baseGUID, argSignatures...), nil
+	case types.ELEMENT_TYPE_CLASS, types.ELEMENT_TYPE_VALUETYPE:
+		namespace, name, err := ctx.ResolveTypeDefOrRefName(e.Type.TypeDef.Index)
+		if err != nil {
+			return "", err
+		}
+		if namespace == "System" && name == "Guid" {
+			return winrt.SignatureGUID, nil
+		}
+
+		elementTypeDef, err := b.mdStore.TypeDefByName(namespace + "." + name)
+		if err != nil {
+			return "", err
+		}
+		return b.TypeDef(elementTypeDef)
+	case types.ELEMENT_TYPE_VAR:
+		return "", fmt.Errorf("generic parameter %d does not have a signature", e.Type.GenericTypeVar.Index)
+	}
+
+	if sig := primitiveTypeSignature(e.Type.Kind); sig != "" {
+		return sig, nil
+	}
+	return "", fmt.Errorf("unsupported element type in signature: %v", e.Type.Kind)
+}
+
+func primitiveTypeSignature(kind types.ElementTypeKind) string {
+	switch kind {
+	// Fundamental types
+	case types.ELEMENT_TYPE_U1:
+		return winrt.SignatureUInt8
+	case types.ELEMENT_TYPE_U2:
+		return winrt.SignatureUInt16
+	case types.ELEMENT_TYPE_U4:
+		return winrt.SignatureUInt32
+	case types.ELEMENT_TYPE_U8:
+		return winrt.SignatureUInt64
+	case types.ELEMENT_TYPE_I1:
+		return winrt.SignatureInt8
+	case types.ELEMENT_TYPE_I2:
+		return winrt.SignatureInt16
+	case types.ELEMENT_TYPE_I4:
+		return winrt.SignatureInt32
+	case types.ELEMENT_TYPE_I8:
+		return winrt.SignatureInt64
+	case types.ELEMENT_TYPE_R4:
+		return winrt.SignatureFloat32
+	case types.ELEMENT_TYPE_R8:
+		return winrt.SignatureFloat64
+	case types.ELEMENT_TYPE_BOOLEAN:
+		return winrt.SignatureBool
+	case types.ELEMENT_TYPE_CHAR:
+		return winrt.SignatureChar
+	case types.ELEMENT_TYPE_STRING:
+		return winrt.SignatureString
+	}
+	return ""
+}
@@ -0,0 +1,151 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/internal/winmd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tdakkota/win32metadata/types"
+)
+
+const (
+	signatureBluetoothLEDevice            = "rc(Windows.Devices.Bluetooth.BluetoothLEDevice;{b5ee2f7b-4ad8-4642-ac48-80a0b500e887})"
+	signatureGattClientNotificationResult = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattClientNotificationResult;{506d5599-0112-419a-8e3b-ae21afabd2c2})"
+	signatureWatcher                      = "rc(Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcher;{a6ac336f-f3d3-4297-8d6c-c81ea6623f40})"
+	signatureReceivedEventArgs            = "rc(Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementReceivedEventArgs;{27987ddf-e596-41be-8d43-9e6731d4a913})"
+	signatureStoppedEventArgs             = "rc(Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcherStoppedEventArgs;{dd40f84d-e7b9-43e3-9c04-0685d085fd8c})"
+)
+
+func TestElementSignature(t *testing.T) {
+	mdStore, err := winmd.NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+	b := &signatureBuilder{mdStore: mdStore}
+
+	tests := []struct {
+		name       string
+		typeName   string
+		methodName string
+		param      int // -1 for the return value
+		signature  string
+		iid        string // may be empty if not known
+	}{
+		{
+			name:       "TypedEventHandler<BluetoothLEAdvertisementWatcher, BluetoothLEAdvertisementReceivedEventArgs>",
+			typeName:   "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher",
+			methodName: "add_Received",
+			param:      0,
+			signature:  "pinterface({9de1c534-6ae1-11e0-84e1-18a905bcc53f};" + signatureWatcher + ";" + signatureReceivedEventArgs + ")",
+			iid:        "{90EB4ECA-D465-5EA0-A61C-033C8C5ECEF2}",
+		},
+		{
+			name:       "TypedEventHandler<BluetoothLEAdvertisementWatcher, BluetoothLEAdvertisementWatcherStoppedEventArgs>",
+			typeName:   "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher",
+			methodName: "add_Stopped",
+			param:      0,
+			signature:  "pinterface({9de1c534-6ae1-11e0-84e1-18a905bcc53f};" + signatureWatcher + ";" + signatureStoppedEventArgs + ")",
+			iid:        "{9936A4DB-DC99-55C3-9E9B-BF4854BD9EAB}",
+		},
+		{
+			name:       "IVectorView<String>",
+			typeName:   "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties",
+			methodName: "get_Genres",
+			param:      -1,
+			signature:  "pinterface({bbe1fa4c-b0e3-4583-baef-1f1b2e483e56};string)",
+			iid:        "{2F13C006-A03A-5F69-B090-75A43E33423E}",
+		},
+		{
+			name:       "IAsyncOperation<Boolean>",
+			typeName:   "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession",
+			methodName: "TryPlayAsync",
+			param:      -1,
+			signature:  "pinterface({9fc2b0bb-e446-44e2-aa61-9cab8f636af2};b1)",
+			iid:        "{CDB5EFB3-5788-509D-9BE1-71CCB8A3362A}",
+		},
+		{
+			name:       "IReference<Boolean>",
+			typeName:   "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo",
+			methodName: "get_IsShuffleActive",
+			param:      -1,
+			signature:  "pinterface({61c17706-2d65-11e0-9ae8-d48564015472};b1)",
+			iid:        "{3C00FD60-2950-5939-A21A-2D12C5A01B8A}",
+		},
+		{
+			name:       "nested IAsyncOperation<IVectorView<GattClientNotificationResult>>",
+			typeName:   "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic",
+			methodName: "NotifyValueAsync",
+			param:      -1,
+			signature:  "pinterface({9fc2b0bb-e446-44e2-aa61-9cab8f636af2};pinterface({bbe1fa4c-b0e3-4583-baef-1f1b2e483e56};" + signatureGattClientNotificationResult + "))",
+		},
+		{
+			name:       "Object argument",
+			typeName:   "Windows.Devices.Bluetooth.IBluetoothLEDevice",
+			methodName: "add_ConnectionStatusChanged",
+			param:      0,
+			signature:  "pinterface({9de1c534-6ae1-11e0-84e1-18a905bcc53f};" + signatureBluetoothLEDevice + ";cinterface(IInspectable))",
+		},
+		{
+			name:       "Guid argument",
+			typeName:   "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement",
+			methodName: "get_ServiceUuids",
+			param:      -1,
+			signature:  "pinterface({913337e9-11a1-4345-a3a2-4e7f956e222d};g16)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, e := methodElement(t, mdStore, tt.typeName, tt.methodName, tt.param)
+
+			sig, err := b.Element(ctx, e)
+			require.NoError(t, err)
+			assert.Equal(t, tt.signature, sig)
+
+			if tt.iid != "" {
+				assert.Equal(t, tt.iid, winrt.IIDFromSignature(sig))
+			}
+		})
+	}
+}
+
+func TestElementSignatureGenericParam(t *testing.T) {
+	mdStore, err := winmd.NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+	b := &signatureBuilder{mdStore: mdStore}
+
+	// IVector<T>.GetAt returns T, which does not have a signature
+	ctx, e := methodElement(t, mdStore, "Windows.Foundation.Collections.IVector`1", "GetAt", -1)
+	_, err = b.Element(ctx, e)
+	assert.Error(t, err)
+}
+
+// methodElement returns the element of the given parameter of a method, or its return value if param is -1.
+func methodElement(t *testing.T, mdStore *winmd.Store, typeName, methodName string, param int) (*types.Context, types.Element) {
+	t.Helper()
+
+	typeDef, err := mdStore.TypeDefByName(typeName)
+	require.NoError(t, err)
+
+	methods, err := typeDef.ResolveMethodList(typeDef.Ctx())
+	require.NoError(t, err)
+
+	for _, m := range methods {
+		if m.Name != methodName {
+			continue
+		}
+
+		sig, err := m.Signature.Reader().Method(typeDef.Ctx())
+		require.NoError(t, err)
+
+		if param < 0 {
+			return typeDef.Ctx(), sig.Return
+		}
+		require.Less(t, param, len(sig.Params))
+		return typeDef.Ctx(), sig.Params[param]
+	}
+
+	t.Fatalf("method %s not found in %s", methodName, typeName)
+	return nil, types.Element{}
+}
@@ -2,15 +2,19 @@ package codegen
 
 import (
 	"embed"
+	"fmt"
 	"strings"
 	"text/template"
 
+	"github.com/go-ole/go-ole"
 	"github.com/saltosystems/winrt-go/internal/winmd"
 )
 
 type genDataFile struct {
 	Filename string
-	Data     genData
+	// Namespace is the namespace of the package of the file.
+	Namespace string
+	Data      genData
 }
 
 type genData struct {
@@ -21,9 +25,15 @@ type genData struct {
 	Interfaces []*genInterface
 	Structs    []*genStruct
 	Delegates  []*genDelegate
+	Opaques    []*genOpaque
+	Instances  []*genInstance
+
+	// scope holds the names used by the file to reference the packages it imports.
+	scope *genScope
 }
 
-func (g *genData) ComputeImports(typeDef *winmd.TypeDef) {
+// ComputeImports computes the imports of the file, which belongs to the package of the given namespace.
+func (g *genData) ComputeImports(namespace string) {
 	// gather all imports
 	imports := make([]*genImport, 0)
 	if g.Classes != nil {
@@ -37,18 +47,107 @@ func (g *genData) ComputeImports(typeDef *winmd.TypeDef) {
 		}
 	}
 
+	for _, d := range g.Delegates {
+		for _, p := range d.InParams {
+			imports = append(imports, p.Type.requiredImports()...)
+		}
+		if d.ReturnParam != nil {
+			imports = append(imports, d.ReturnParam.Type.requiredImports()...)
+		}
+	}
+	for _, i := range g.Instances {
+		imports = append(imports, i.Type.requiredImports()...)
+	}
+
+	g.scope = newGenScope(namespace, g.Package, imports)
+
+	// syscall and unsafe are always imported by the file template
+	seen := map[string]bool{`"syscall"`: true, `"unsafe"`: true}
 	for _, i := range imports {
-		if typeDef.TypeNamespace != i.Namespace {
-			g.Imports = append(g.Imports, i.ToGoImport())
+		if namespace == i.Namespace {
+			continue
+		}
+		goImport := g.scope.importSpec(i)
+		if !seen[goImport] {
+			seen[goImport] = true
+			g.Imports = append(g.Imports, goImport)
 		}
 	}
+
+	g.setScope()
+}
+
+// setScope sets the scope of the file to all the generated elements that reference other packages.
+func (g *genData) setScope() {
+	setFuncs := func(funcs []*genFunc) {
+		for _, f := range funcs {
+			f.scope = g.scope
+			for _, p := range f.InParams {
+				p.scope = g.scope
+			}
+			for _, p := range f.ReturnParams {
+				p.scope = g.scope
+			}
+			if f.event != nil {
+				f.event.scope = g.scope
+				for _, p := range f.event.Params {
+					p.scope = g.scope
+				}
+			}
+		}
+	}
+	for _, i := range g.Interfaces {
+		i.scope = g.scope
+		setFuncs(i.Funcs)
+	}
+	for _, c := range g.Classes {
+		c.scope = g.scope
+		for _, i := range c.ImplInterfaces {
+			setFuncs(i.Funcs)
+		}
+		for _, i := range c.ExclusiveInterfaces {
+			setFuncs(i.Funcs)
+		}
+	}
+	for _, s := range g.Structs {
+		for _, f := range s.Fields {
+			f.scope = g.scope
+		}
+	}
+	for _, d := range g.Delegates {
+		for _, p := range d.InParams {
+			p.scope = g.scope
+		}
+		if d.ReturnParam != nil {
+			d.ReturnParam.scope = g.scope
+		}
+	}
+	for _, i := range g.Instances {
+		i.scope = g.scope
+		setFuncs(i.HandleFuncs)
+	}
 }
 
 type genInterface struct {
-	Name      string
-	GUID      string
-	Signature string
-	Funcs     []*genFunc
+	Name               string
+	FullyQualifiedName string
+	IsPublic           bool
+	GUID               string
+	Signature          string
+	TypeParams         []string // only set for parameterized interfaces
+	Funcs              []*genFunc
+
+	// Contract is the version of the API contract that introduced the type, it may be nil.
+	Contract *winmd.ContractVersion
+	// Deprecated holds the deprecation message of the type, or an empty string if it is not deprecated.
+	Deprecated string
+
+	scope *genScope
+}
+
+// ApiInformation returns the qualified name of the ApiInformation class, used by the presence checks.
+func (g *genInterface) ApiInformation() string {
+	return g.scope.apiInformationName()
 }
 
 func (g *genInterface) GetRequiredImports() []*genImport {
@@ -56,9 +155,55 @@ func (g *genInterface) GetRequiredImports() []*genImport {
 	for _, f := range g.Funcs {
 		imports = append(imports, f.RequiresImports...)
 	}
+	if g.IsPublic {
+		// public interfaces include presence checks
+		imports = append(imports, apiInformationImport)
+	}
 	return imports
 }
 
+// relocated returns a copy of the interface that only implements the functions relocated to the given namespace,
+// or nil if there is none. The copy is declared by the package of the namespace, so the classes implement the
+// relocated functions using the copy. Events are not relocated, since their add and remove functions are not
+// declared by the same package.
+func (g *genInterface) relocated(namespace string) *genInterface {
+	c := *g
+	c.Funcs = make([]*genFunc, 0, len(g.Funcs))
+	found := false
+	for _, f := range g.Funcs {
+		fc := *f
+		fc.event = nil
+		if f.Implement && f.RelocatedTo == namespace {
+			found = true
+			if fc.InheritedFrom.Namespace != "" {
+				fc.InheritedFrom.Namespace = namespace
+			}
+		} else {
+			fc.Implement = false
+			fc.RequiresImports = nil
+		}
+		c.Funcs = append(c.Funcs, &fc)
+	}
+	if !found {
+		return nil
+	}
+	return &c
+}
+
+// ImplementedFuncNames returns the Go names of the generated methods of the interface.
+func (g *genInterface) ImplementedFuncNames() []string {
+	names := make([]string, 0, len(g.Funcs))
+	for _, f := range g.Funcs {
+		if f.Implement {
+			names = append(names, funcName(*f))
+		}
+		if e := f.InterfaceEvent(); e != nil {
+			names = append(names, e.Name)
+		}
+	}
+	return names
+}
+
 type genClass struct {
 	Name                string
 	Signature           string
@@ -68,6 +213,60 @@ type genClass struct {
 	ExclusiveInterfaces []*genInterface
 	HasEmptyConstructor bool
 	IsAbstract          bool
+	// MustQueryInterface makes the methods of the class panic if the object does not implement their interface.
+	MustQueryInterface bool
+	// CacheInterfaces generates a handle for the class, whose methods cache the interfaces they query from the object.
+	CacheInterfaces bool
+	// Handles makes the methods of the class return the objects owned by the caller wrapped in a winrt.Handle.
+	Handles bool
+	// Relocated is set for the copies of the class declared by other packages, which only hold the methods
+	// relocated to them. Their methods are declared as functions that receive the object.
+	Relocated bool
+
+	// Contract is the version of the API contract that introduced the type, it may be nil.
+	Contract *winmd.ContractVersion
+	// Deprecated holds the deprecation message of the type, or an empty string if it is not deprecated.
+	Deprecated string
+
+	scope *genScope
+}
+
+// ApiInformation returns the qualified name of the ApiInformation class, used by the presence checks.
+func (g *genClass) ApiInformation() string {
+	return g.scope.apiInformationName()
+}
+
+// Qualifier returns the prefix required to reference the class from the file.
+func (g *genClass) Qualifier() string {
+	namespace := g.FullyQualifiedName[:strings.LastIndex(g.FullyQualifiedName, ".")]
+	return g.scope.qualifier(namespace, g.Name)
+}
+
+// relocated returns a copy of the class that only implements the methods and static functions relocated to the
+// given namespace, or nil if there is none.
+func (g *genClass) relocated(namespace string) *genClass {
+	c := *g
+	c.Relocated = true
+	c.CacheInterfaces = false
+	c.ImplInterfaces, c.ExclusiveInterfaces = nil, nil
+	c.RequiresImports = []*genImport{{g.FullyQualifiedName[:strings.LastIndex(g.FullyQualifiedName, ".")], g.Name}}
+	for _, itf := range g.ImplInterfaces {
+		if r := itf.relocated(namespace); r != nil {
+			c.ImplInterfaces = append(c.ImplInterfaces, r)
+			for _, f := range r.Funcs {
+				c.RequiresImports = append(c.RequiresImports, f.RequiresImports...)
+			}
+		}
+	}
+	for _, itf := range g.ExclusiveInterfaces {
+		if r := itf.relocated(namespace); r != nil {
+			c.ExclusiveInterfaces = append(c.ExclusiveInterfaces, r)
+		}
+	}
+	if c.ImplInterfaces == nil && c.ExclusiveInterfaces == nil {
+		return nil
+	}
+	return &c
 }
 
 func (g *genClass) GetRequiredImports() []*genImport {
@@ -80,16 +279,97 @@ func (g *genClass) GetRequiredImports() []*genImport {
 			imports = append(imports, i.GetRequiredImports()...)
 		}
 	}
+	if !g.IsAbstract && !g.Relocated {
+		// classes that can be instantiated include presence checks
+		imports = append(imports, apiInformationImport)
+	}
 
 	return imports
 }
 
+// genMethodOwner holds the generated methods declared by a WinRT type.
+type genMethodOwner struct {
+	// FullyQualifiedName is the name of the WinRT type that declares the methods.
+	FullyQualifiedName string
+	Funcs              []string
+}
+
+// MethodOwners returns the generated methods and static functions of the class, grouped by the
+// interface that declares them. These methods are available when their interface is present.
+func (g *genClass) MethodOwners() []genMethodOwner {
+	owners := make([]genMethodOwner, 0, len(g.ImplInterfaces))
+	add := func(itf *genInterface, static bool) {
+		var names []string
+		for _, f := range itf.Funcs {
+			if f.Implement && f.RequiresActivation == static {
+				names = append(names, f.ClassFuncName())
+			}
+			if e := f.ClassEvent(g.Name); e != nil && !static {
+				names = append(names, e.Name)
+			}
+		}
+		if len(names) > 0 {
+			owners = append(owners, genMethodOwner{FullyQualifiedName: itf.FullyQualifiedName, Funcs: names})
+		}
+	}
+
+	for _, itf := range g.ImplInterfaces {
+		add(itf, false)
+	}
+	// static and activation interfaces are not implemented by the class, but are declared as package functions
+	for _, itf := range g.ExclusiveInterfaces {
+		add(itf, true)
+	}
+	return owners
+}
+
+// genClassMethod is a method of a class, declared by the class or, when the interfaces are cached, by its handle.
+type genClassMethod struct {
+	*genFunc
+	Class *genClass
+	// Cached makes the method query its interface using the handle, that caches it.
+	Cached bool
+}
+
+// Func returns the function of the interface that declares the method.
+func (m *genClassMethod) Func() *genFunc {
+	return m.genFunc
+}
+
+// handleMethodNames are the names of the methods and fields of the class handles, those of winrt.Handle.
+var handleMethodNames = map[string]bool{"Close": true, "Get": true, "Handle": true, "QueryInterface": true}
+
+// Method returns the given function as a method of the class.
+func (g *genClass) Method(f *genFunc) *genClassMethod {
+	return &genClassMethod{genFunc: f, Class: g}
+}
+
+// HandleMethods returns the methods of the handle of the class, generated along with the class when the interfaces
+// are cached. It includes the methods of the class, except those named like the ones of winrt.Handle.
+func (g *genClass) HandleMethods() []*genClassMethod {
+	var methods []*genClassMethod
+	for _, itf := range g.ImplInterfaces {
+		for _, f := range itf.Funcs {
+			if f.Implement && !handleMethodNames[f.ClassFuncName()] {
+				methods = append(methods, &genClassMethod{genFunc: f, Class: g, Cached: true})
+			}
+		}
+	}
+	return methods
+}
+
 type genDelegate struct {
 	Name        string
 	GUID        string
 	Signature   string
+	TypeParams  []string // only set for parameterized delegates
 	InParams    []*genParam
 	ReturnParam *genParam // this may be nil
+
+	// Contract is the version of the API contract that introduced the type, it may be nil.
+	Contract *winmd.ContractVersion
+	// Deprecated holds the deprecation message of the type, or an empty string if it is not deprecated.
+	Deprecated string
 }
 
 type genEnum struct {
@@ -97,42 +377,259 @@ type genEnum struct {
 	Type      string
 	Signature string
 	Values    []*genEnumValue
+
+	// Flags is true when the enum carries the System.FlagsAttribute, so its values can be combined.
+	Flags bool
+
+	// Contract is the version of the API contract that introduced the type, it may be nil.
+	Contract *winmd.ContractVersion
+	// Deprecated holds the deprecation message of the type, or an empty string if it is not deprecated.
+	Deprecated string
 }
+
+// UniqueValues returns the enum values removing aliases: when several names share the same
+// value, only the first one is kept.
+func (e *genEnum) UniqueValues() []*genEnumValue {
+	seen := make(map[string]bool, len(e.Values))
+	values := make([]*genEnumValue, 0, len(e.Values))
+	for _, v := range e.Values {
+		if seen[v.Value] {
+			continue
+		}
+		seen[v.Value] = true
+		values = append(values, v)
+	}
+	return values
+}
+
+// FlagValues returns the unique non-zero values of a flags enum.
+func (e *genEnum) FlagValues() []*genEnumValue {
+	values := make([]*genEnumValue, 0, len(e.Values))
+	for _, v := range e.UniqueValues() {
+		if v.Value == "0" {
+			continue
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+// Unsigned returns true if the underlying type of the enum is an unsigned integer.
+func (e *genEnum) Unsigned() bool {
+	return strings.HasPrefix(e.Type, "uint")
+}
+
+// BitSize returns the size in bits of the underlying type of the enum.
+func (e *genEnum) BitSize() int {
+	switch strings.TrimPrefix(e.Type, "u") {
+	case "int8":
+		return 8
+	case "int16":
+		return 16
+	case "int64":
+		return 64
+	default:
+		return 32
+	}
+}
+
 type genEnumValue struct {
 	Name  string
 	Value string
+
+	// Text is the name of the value as defined in the metadata.
+	Text string
+
+	// Deprecated holds the deprecation message of the value, or an empty string if it is not deprecated.
+	Deprecated string
 }
 
 type genFunc struct {
 	Name            string
+	MethodName      string // the name of the method, Name holds the overload name
+	DefaultOverload bool   // true if the method is the default overload for its arity
+	Deprecated      string // the deprecation message, empty if the function is not deprecated
 	RequiresImports []*genImport
 	Implement       bool
 	FuncOwner       string
-	InParams        []*genParam
-	ReturnParams    []*genParam // this may be empty
+	// Interface is the fully qualified name of the WinRT interface that declares the function.
+	Interface    string
+	InParams     []*genParam
+	ReturnParams []*genParam // this may be empty
+
+	// TypeParams holds the type parameters of the owner, when it is a parameterized interface.
+	TypeParams []string
 
 	// ExclusiveTo is the name of the class that this function is exclusive to.
-	// The funcion will be called statically using the RoGetActivationFactory function.
+	// The funcion will be called statically using the activation factory of the class, cached by winrt.GetActivationFactory.
 	ExclusiveTo        string
 	RequiresActivation bool
+	// Handles makes the function return the objects owned by the caller wrapped in a winrt.Handle.
+	Handles bool
 
+	// InheritedFrom is the interface that declares the function, when it is implemented by a class.
 	InheritedFrom winmd.QualifiedID
+
+	// RelocatedTo is the namespace of the package that declares the function instead of the package of its
+	// interface, because it uses types that can not be imported from there, or empty. See relocatedNamespace.
+	RelocatedTo string
+
+	// nameSuffix is appended to the Go name of the function to avoid collisions with other methods.
+	nameSuffix string
+	// classSuffix is appended to the name of the method of the class that implements the function,
+	// when another interface implemented by the class declares a method with the same name.
+	classSuffix string
+
+	// event is the event whose handlers are added by the function, if it is an add accessor.
+	event *genEvent
+
+	scope *genScope
 }
 
-type genImport struct {
-	Namespace, Name string
+// Results returns the values returned by the function: the out parameters followed by the return parameters.
+func (g *genFunc) Results() []*genParam {
+	var results []*genParam
+	for _, p := range g.InParams {
+		if p.IsOut {
+			results = append(results, p)
+		}
+	}
+	return append(results, g.ReturnParams...)
 }
 
-func (i genImport) ToGoImport() string {
-	if !strings.Contains(i.Namespace, ".") && i.Namespace != "Windows" {
-		// This is probably a built-in package
-		return i.Namespace
+// HasObjectResults returns true if any of the values returned by the function is an object.
+func (g *genFunc) HasObjectResults() bool {
+	for _, p := range g.Results() {
+		if p.Type.IsObject() {
+			return true
+		}
+	}
+	return false
+}
+
+// SlotParams returns the input parameters whose ABI representation depends on the platform, see InSlots.
+// Their values may span several stack slots, like 64-bit values on 32-bit platforms, so the arguments of
+// the call are collected into a slice.
+func (g *genFunc) SlotParams() []*genParam {
+	var params []*genParam
+	for _, p := range g.InParams {
+		if p.InSlots() {
+			params = append(params, p)
+		}
+	}
+	return params
+}
+
+// SingleSlotArgs returns the number of arguments of the call that use a single slot, including the receiver.
+func (g *genFunc) SingleSlotArgs() int {
+	return 1 + len(g.InParams) + len(g.ReturnParams) - len(g.SlotParams())
+}
+
+// InterfaceEvent returns the helper of the event whose handlers are added by the function, declared
+// as a method of its interface, or nil if there is no such event.
+func (g *genFunc) InterfaceEvent() *genEventHelper {
+	if g.event == nil || !g.Implement || !g.event.remove.Implement {
+		return nil
+	}
+	return &genEventHelper{
+		genEvent:     g.event,
+		Receiver:     "v",
+		ReceiverType: "*" + g.FuncOwner + typeArgs(g.TypeParams),
+		Name:         eventFuncName(funcName(*g)),
+		AddFunc:      funcName(*g),
+		RemoveFunc:   funcName(*g.event.remove),
+	}
+}
+
+// ClassEvent returns the helper of the event whose handlers are added by the function, declared
+// as a method of the given class that implements it, or nil if there is no such event.
+func (g *genFunc) ClassEvent(class string) *genEventHelper {
+	if g.event == nil || !g.Implement || !g.event.remove.Implement {
+		return nil
+	}
+	return &genEventHelper{
+		genEvent:     g.event,
+		Receiver:     "impl",
+		ReceiverType: "*" + class,
+		Name:         eventFuncName(g.ClassFuncName()),
+		AddFunc:      g.ClassFuncName(),
+		RemoveFunc:   g.event.remove.ClassFuncName(),
+		Handles:      g.event.handles,
 	}
+}
 
-	folder := typeToFolder(i.Namespace, i.Name)
-	return "github.com/saltosystems/winrt-go/" + folder
+// eventFuncName returns the name of the helper of an event, given the name of its add accessor.
+func eventFuncName(addFuncName string) string {
+	return "On" + strings.TrimPrefix(addFuncName, "Add")
 }
 
+// genEvent holds an event of an interface, whose handlers are added and removed by a pair of accessors.
+type genEvent struct {
+	remove *genFunc
+	// handler is the delegate type of the handlers of the event.
+	handler *genParamType
+	// Params holds the parameters of the handlers, passed to the callback of the helper.
+	Params []*genParam
+	// handles makes the helpers of the classes pass the objects to the callback wrapped in a winrt.Handle.
+	handles bool
+
+	scope *genScope
+}
+
+// HandlerType returns the name of the delegate type of the handlers of the event.
+func (e *genEvent) HandlerType() string {
+	return e.handler.goTypeName(e.scope)
+}
+
+// HandlerConstructor returns the function that creates a handler of the event, including its type
+// arguments: foundation.NewTypedEventHandler[*A, *B].
+func (e *genEvent) HandlerConstructor() string {
+	qualifier := e.scope.qualifier(e.handler.namespace, e.handler.name)
+	name := typeNameToGoName(e.handler.name, true)
+	// the type arguments follow the qualified name of the delegate
+	return qualifier + "New" + name + strings.TrimPrefix(e.HandlerType(), qualifier+name)
+}
+
+// HandlerIID returns the IID passed to the constructor of the handler, or an empty string if the delegate
+// is parameterized, since its constructor computes the IID from the type arguments.
+func (e *genEvent) HandlerIID() string {
+	if len(e.handler.typeArgs) > 0 {
+		return ""
+	}
+	qualifier := e.scope.qualifier(e.handler.namespace, e.handler.name)
+	return "&" + qualifier + "IID" + typeNameToGoName(e.handler.name, true)
+}
+
+// genEventHelper holds the names used to declare the helper of an event as a method of an interface or a class.
+type genEventHelper struct {
+	*genEvent
+	Receiver     string
+	ReceiverType string
+	// Name is the name of the helper, AddFunc and RemoveFunc the names of the methods it calls.
+	Name       string
+	AddFunc    string
+	RemoveFunc string
+	// Handles makes the helper pass the objects to the callback wrapped in a winrt.Handle.
+	Handles bool
+}
+
+// ClassFuncName returns the name of the method of the class that implements the function.
+func (g *genFunc) ClassFuncName() string {
+	return funcName(*g) + g.classSuffix
+}
+
+// InheritedFromQualifier returns the prefix required to reference the interface that declares the function.
+func (g *genFunc) InheritedFromQualifier() string {
+	return g.scope.qualifier(g.InheritedFrom.Namespace, g.InheritedFrom.Name)
+}
+
+type genImport struct {
+	Namespace, Name string
+}
+
+// apiInformationImport is the import required by the presence checks.
+var apiInformationImport = &genImport{"Windows.Foundation.Metadata", "ApiInformation"}
+
 // some of the variables are not public to avoid using them
 // by mistake in the code.
 type genDefaultValue struct {
@@ -153,38 +650,135 @@ type genParamType struct {
 	IsEnum             bool
 	UnderlyingEnumType string
 
+	// typeArgs holds the type arguments of an instance of a parameterized type.
+	typeArgs []*genParamType
+	// isDelegate is true for delegates, that are reference counted by the Go code that implements them.
+	isDelegate bool
+
 	defaultValue genDefaultValue
 }
 
+// IsObject returns true if the type is a reference counted object, like a class or an interface, that can be
+// owned by a winrt.Handle. Delegates are not, since they are implemented in Go.
+func (t *genParamType) IsObject() bool {
+	return t.IsPointer && !t.IsArray && !t.IsGeneric && !t.isDelegate
+}
+
+// goTypeName returns the name of the type, qualified with its package if it does
+// not belong to the namespace of the scope. Pointer and array modifiers are not included.
+func (t *genParamType) goTypeName(scope *genScope) string {
+	if t.IsPrimitive || t.IsGeneric {
+		return t.name
+	}
+
+	name := scope.qualifier(t.namespace, t.name) + typeNameToGoName(t.name, true) // assume all are public
+
+	if len(t.typeArgs) > 0 {
+		args := make([]string, 0, len(t.typeArgs))
+		for _, a := range t.typeArgs {
+			arg := a.goTypeName(scope)
+			if a.IsPointer {
+				arg = "*" + arg
+			}
+			args = append(args, arg)
+		}
+		name += "[" + strings.Join(args, ", ") + "]"
+	}
+
+	return name
+}
+
+// isConcrete returns true if the type does not depend on any generic type parameter.
+func (t *genParamType) isConcrete() bool {
+	if t.IsGeneric {
+		return false
+	}
+	for _, a := range t.typeArgs {
+		if !a.isConcrete() {
+			return false
+		}
+	}
+	return true
+}
+
+// requiredImports returns the imports required to reference this type, including the ones
+// required by its type arguments.
+// namespaces returns the namespaces of the type and its type arguments.
+func (t *genParamType) namespaces() []string {
+	namespaces := []string{t.namespace}
+	for _, a := range t.typeArgs {
+		namespaces = append(namespaces, a.namespaces()...)
+	}
+	return namespaces
+}
+
+func (t *genParamType) requiredImports() []*genImport {
+	var imports []*genImport
+	if !t.IsPrimitive && !t.IsGeneric {
+		imports = append(imports, &genImport{t.namespace, t.name})
+	}
+	for _, a := range t.typeArgs {
+		imports = append(imports, a.requiredImports()...)
+	}
+	return imports
+}
+
 // some of the variables are not public to avoid using them
 // by mistake in the code.
 type genParam struct {
-	callerPackage string
+	// scope is the scope of the file that references the parameter, set when computing its imports.
+	scope *genScope
 
 	varName string
+	// arraySize is the parameter that holds the size of this array parameter, if any.
+	arraySize *genParam
 
 	Type *genParamType
 
 	IsOut bool
 }
 
-func (g *genParam) GoVarName() string {
-	return typeNameToGoName(g.varName, true) // assume all are public
+// Result returns the parameter as a value returned by a function, which is an owned handle if handles is true
+// and the parameter is an object.
+func (g *genParam) Result(handles bool) *genResult {
+	return &genResult{genParam: g, Handle: handles && g.Type.IsObject()}
 }
 
-func (g *genParam) GoTypeName() string {
-	if g.Type.IsPrimitive {
-		return g.Type.name
-	}
-
-	name := typeNameToGoName(g.Type.name, true) // assume all are public
+// genResult is a value returned by a function, or passed to the callback of an event helper.
+type genResult struct {
+	*genParam
+	// Handle is true if the object is wrapped in a winrt.Handle owned by the receiver of the value.
+	Handle bool
+}
 
-	pkg := typePackage(g.Type.namespace, g.Type.name)
-	if g.callerPackage != pkg {
-		name = pkg + "." + name
+// InSlots returns true if the parameter is an input value converted by winrt.InValue, which follows the
+// calling convention of the platform: generic values, structs, that may be passed by value or by reference,
+// 64-bit integers, that span two slots on 32-bit platforms, and floats, that are passed by their bit pattern.
+func (g *genParam) InSlots() bool {
+	if g.IsOut || g.Type.IsArray {
+		return false
+	}
+	t := g.Type
+	switch {
+	case t.IsGeneric:
+		return true
+	case t.IsPrimitive:
+		switch t.name {
+		case "int64", "uint64", "float32", "float64":
+			return true
+		}
+		return false
 	}
+	// the rest of the values are structs
+	return !t.IsPointer && !t.IsEnum
+}
 
-	return name
+func (g *genParam) GoVarName() string {
+	return typeNameToGoName(g.varName, true) // assume all are public
+}
+
+func (g *genParam) GoTypeName() string {
+	return g.Type.goTypeName(g.scope)
 }
 
 func (g *genParam) GoDefaultValue() string {
@@ -192,18 +786,54 @@ func (g *genParam) GoDefaultValue() string {
 		return g.Type.defaultValue.value
 	}
 
-	pkg := typePackage(g.Type.namespace, g.Type.name)
-	if g.callerPackage != pkg {
-		return pkg + "." + g.Type.defaultValue.value
-	}
-
-	return g.Type.defaultValue.value
+	return g.scope.qualifier(g.Type.namespace, g.Type.name) + g.Type.defaultValue.value
 }
 
 type genStruct struct {
 	Name      string
 	Signature string
 	Fields    []*genParam
+
+	// Contract is the version of the API contract that introduced the type, it may be nil.
+	Contract *winmd.ContractVersion
+	// Deprecated holds the deprecation message of the type, or an empty string if it is not deprecated.
+	Deprecated string
+}
+
+// genOpaque is a stand-in for a type that can not be used as type argument
+// because importing its package would introduce an import cycle.
+type genOpaque struct {
+	Name               string
+	FullyQualifiedName string
+	Signature          string
+	UnderlyingEnumType string // only set for enums
+}
+
+// genInstance is a named wrapper of an instance of a parameterized interface.
+type genInstance struct {
+	Name        string
+	DisplayName string // the WinRT name of the instance, e.g. IVectorView<String>
+	GUID        string
+	Signature   string
+
+	// Type is the instance of the generic type embedded by the wrapper.
+	Type *genParamType
+	// HandleFuncs are the methods of the generic type that return its object type arguments, declared again by
+	// the wrapper to return them owned by the caller wrapped in a winrt.Handle.
+	HandleFuncs []*genFunc
+	// namespace is the namespace of the package that declares the wrapper.
+	namespace string
+	scope     *genScope
+}
+
+// EmbeddedType returns the name of the generic type instance embedded by the wrapper.
+func (g *genInstance) EmbeddedType() string {
+	return g.Type.goTypeName(g.scope)
+}
+
+// EmbeddedName returns the name of the field of the embedded generic type.
+func (g *genInstance) EmbeddedName() string {
+	return typeNameToGoName(g.Type.name, true)
 }
 
 //go:embed templates/*
@@ -224,9 +854,56 @@ func funcs() template.FuncMap {
 		"toLower": func(s string) string {
 			return strings.ToLower(s[:1]) + s[1:]
 		},
+		"typeParams":  typeParams,
+		"typeArgs":    typeArgs,
+		"guidLiteral": guidLiteral,
 	}
 }
 
+// guidLiteral returns the ole.GUID composite literal of the given GUID, so the generated code does not
+// parse it on every call.
+func guidLiteral(guid string) (string, error) {
+	g := ole.NewGUID(guid)
+	if g == nil {
+		return "", fmt.Errorf("invalid GUID %q", guid)
+	}
+
+	data4 := make([]string, len(g.Data4))
+	for i, b := range g.Data4 {
+		data4[i] = fmt.Sprintf("0x%02X", b)
+	}
+	return fmt.Sprintf("ole.GUID{Data1: 0x%08X, Data2: 0x%04X, Data3: 0x%04X, Data4: [8]byte{%s}}",
+		g.Data1, g.Data2, g.Data3, strings.Join(data4, ", ")), nil
+}
+
+// typeParams returns the type parameter list used when declaring a parameterized type.
+func typeParams(params []string) string {
+	if len(params) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(params, ", ") + " any]"
+}
+
+// typeArgs returns the type argument list used when referencing a parameterized type
+// within its own declaration.
+func typeArgs(params []string) string {
+	if len(params) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+// accessorPrefix returns the prefix of the name of a property or event accessor, or an empty string
+// if the given method name is not an accessor.
+func accessorPrefix(name string) string {
+	for prefix := range accessorSuffixes {
+		if strings.HasPrefix(name, prefix) {
+			return prefix
+		}
+	}
+	return ""
+}
+
 // funcName is used to generate the name of a function.
 func funcName(m genFunc) string {
 	// There are some special prefixes applied to methods that we need to replace
@@ -236,7 +913,7 @@ func funcName(m genFunc) string {
 		"add_", "Add",
 		"remove_", "Remove",
 	)
-	name := replacer.Replace(m.Name)
+	name := replacer.Replace(m.Name) + m.nameSuffix
 
 	// Add a prefix to static methods to include the owner class of the method.
 	// This is necessary to avoid conflicts with method names within the same package.
@@ -257,7 +934,58 @@ func typeToFolder(ns, name string) string {
 
 func typePackage(ns, name string) string {
 	sns := strings.Split(ns, ".")
-	return strings.ToLower(sns[len(sns)-1])
+	pkg := strings.ToLower(sns[len(sns)-1])
+	if goKeywords[pkg] {
+		// e.g. Windows.Media.Import, the folder keeps the name of the namespace
+		return pkg + "pkg"
+	}
+	return pkg
+}
+
+// canImport returns true if the package of the given namespace can be imported from the package of
+// curNamespace without introducing an import cycle. Packages may import their ancestors and the
+// foundation packages, which only import the Windows.Foundation.Metadata package.
+func canImport(curNamespace, namespace string) bool {
+	switch {
+	case namespace == curNamespace:
+		return true
+	case !strings.Contains(namespace, "."):
+		// This is probably a built-in package
+		return true
+	case strings.HasPrefix(curNamespace, namespace+"."):
+		return true
+	case isDescendant(curNamespace, namespace):
+		return false
+	}
+	return namespace == "Windows.Foundation" || namespace == "Windows.Foundation.Collections"
+}
+
+// isDescendant returns true if the given namespace is nested in curNamespace, e.g. Windows.Devices.Bluetooth.Advertisement
+// in Windows.Devices.Bluetooth.
+func isDescendant(curNamespace, namespace string) bool {
+	return strings.HasPrefix(namespace, curNamespace+".")
+}
+
+// relocatedNamespace returns the namespace of the package that declares the code of the package of curNamespace that
+// uses the given types. The packages of the descendant namespaces import their ancestors, so they can not be imported
+// from the package of curNamespace: the code that uses their types is declared by the package of the deepest of them
+// instead, which can import the packages of the rest. It returns curNamespace if none of the types belongs to a
+// descendant namespace, and fails if they belong to descendant namespaces that are not nested in one another.
+func relocatedNamespace(curNamespace string, types []*genParamType) (string, error) {
+	target := curNamespace
+	for _, t := range types {
+		for _, ns := range t.namespaces() {
+			switch {
+			case !isDescendant(curNamespace, ns) || ns == target || isDescendant(ns, target):
+				// the package of ns can be imported from the package of target
+			case target == curNamespace || isDescendant(target, ns):
+				target = ns
+			default:
+				return "", fmt.Errorf("the types of %s and %s can not be used from the same package", target, ns)
+			}
+		}
+	}
+	return target, nil
 }
 
 func enumName(typeName string, enumName string) string {
@@ -288,15 +1016,6 @@ func typeFilename(typeName string) string {
 	return strings.ToLower(goname)
 }
 
-// removes Go reserved words from param names
-func cleanReservedWords(name string) string {
-	switch name {
-	case "type":
-		return "mType"
-	}
-	return name
-}
-
 func typeNameToGoName(typeName string, public bool) string {
 	name := typeName
 
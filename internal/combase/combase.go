@@ -0,0 +1,35 @@
+//go:build windows
+
+package combase
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	libCombase = windows.NewLazySystemDLL("combase.dll")
+
+	procGetRestrictedErrorInfo = libCombase.NewProc("GetRestrictedErrorInfo")
+)
+
+// GetRestrictedErrorInfo returns the restricted error information object set by the last WinRT method
+// that failed on the calling thread, or nil if there is none. The error information is cleared, and
+// the caller owns the returned reference.
+// https://learn.microsoft.com/en-us/windows/win32/api/roerrorapi/nf-roerrorapi-getrestrictederrorinfo
+func GetRestrictedErrorInfo() *ole.IUnknown {
+	// not available before Windows 8
+	if procGetRestrictedErrorInfo.Find() != nil {
+		return nil
+	}
+
+	var info *ole.IUnknown
+	hr, _, _ := syscall.SyscallN(procGetRestrictedErrorInfo.Addr(), uintptr(unsafe.Pointer(&info)))
+	if hr != 0 {
+		return nil
+	}
+	return info
+}
@@ -3,11 +3,14 @@
 package delegate
 
 import (
+	"fmt"
+	"runtime/debug"
 	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go/internal/leak"
 )
 
 // Only a limited number of callbacks may be created in a single Go process,
@@ -42,7 +45,13 @@ var instances = make(map[uintptr]Delegate)
 // RegisterCallbacks adds the given pointer and the Delegate it points to to our instances.
 // This is required to redirect received callbacks to the correct object instance.
 // The function returns the callbacks to use when creating a new delegate instance.
+// In debug builds, the instance is tracked by the leak detector until it is no longer referenced.
 func RegisterCallbacks(ptr unsafe.Pointer, inst Delegate) *Callbacks {
+	if leak.Enabled {
+		// the reference count is updated by the delegate using SetRefs
+		leak.Default.Track(uintptr(ptr), leak.KindDelegate, fmt.Sprintf("%T", inst), string(debug.Stack()), nil)
+	}
+
 	mutex.Lock()
 	defer mutex.Unlock()
 	instances[uintptr(ptr)] = inst
@@ -55,6 +64,15 @@ func RegisterCallbacks(ptr unsafe.Pointer, inst Delegate) *Callbacks {
 	}
 }
 
+// SetRefs records the reference count of a delegate in debug builds. It must be called by the delegate on
+// every change, as it may be referenced and released by both Go and COM callers, and the delegate is no
+// longer reported as alive once the count drops to zero.
+func SetRefs(ptr unsafe.Pointer, refs uintptr) {
+	if leak.Enabled {
+		leak.Default.SetRefs(uintptr(ptr), int32(refs))
+	}
+}
+
 func getInstance(ptr unsafe.Pointer) (Delegate, bool) {
 	mutex.RLock() // locks writing, allows concurrent read
 	defer mutex.RUnlock()
@@ -0,0 +1,6 @@
+// Package handlestest holds code generated with the -handles option, so it is built and vetted along with the
+// rest of the module. The generated packages are stored in the windows folder of this package.
+package handlestest
+
+//go:generate go run github.com/saltosystems/winrt-go/cmd/winrt-go-gen -debug -handles -class Windows.Devices.Bluetooth.GenericAttributeProfile.GattDeviceServicesResult -method-filter get_Services -method-filter !*
+//go:generate go run github.com/saltosystems/winrt-go/cmd/winrt-go-gen -debug -handles -class Windows.Devices.Bluetooth.GenericAttributeProfile.GattDeviceService -method-filter get_Uuid -method-filter !*
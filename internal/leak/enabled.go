@@ -0,0 +1,6 @@
+//go:build winrtdebug
+
+package leak
+
+// Enabled reports whether the objects are tracked, which requires the winrtdebug build tag.
+const Enabled = true
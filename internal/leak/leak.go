@@ -0,0 +1,138 @@
+// Package leak tracks the projected objects owned by Go code and the delegates implemented in Go, so the
+// ones that are never released can be reported along with the stack that created them. Objects are only
+// tracked by builds using the winrtdebug tag.
+package leak
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Kinds of tracked objects.
+const (
+	KindObject   = "object"
+	KindDelegate = "delegate"
+)
+
+// Default is the registry of the objects tracked by the process.
+var Default = NewRegistry()
+
+// Object describes a tracked object that is still alive.
+type Object struct {
+	// Kind is either KindObject, for projected objects, or KindDelegate.
+	Kind string
+	// Type is the Go type of the object.
+	Type string
+	// Ptr is the address that identifies the object in the registry.
+	Ptr uintptr
+	// Refs is the reference count of the object when it was reported.
+	Refs int32
+	// Stack is the stack that created the object.
+	Stack string
+}
+
+// entry is a tracked object.
+type entry struct {
+	id  uint64
+	obj Object
+	// refs returns the current reference count of the object, if it is not recorded in the entry.
+	refs func() int32
+}
+
+// Registry holds the tracked objects, identified by their address.
+type Registry struct {
+	mu      sync.Mutex
+	next    uint64
+	entries map[uintptr]*entry
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{entries: make(map[uintptr]*entry)}
+}
+
+// Track adds an object to the registry, replacing any object tracked with the same address. If the refs
+// function is nil, the object starts with a single reference, and its count is updated using SetRefs.
+// Otherwise, the function is called to get the reference count of the object when it is reported. It is
+// called without holding the lock of the registry, so it may be called after the object is untracked:
+// it must return zero once the object is released, and those objects are not reported.
+func (r *Registry) Track(ptr uintptr, kind, typ, stack string, refs func() int32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.next++
+	r.entries[ptr] = &entry{
+		id:   r.next,
+		obj:  Object{Kind: kind, Type: typ, Ptr: ptr, Refs: 1, Stack: stack},
+		refs: refs,
+	}
+}
+
+// SetRefs records the reference count of a tracked object. Objects whose count drops to zero are untracked.
+func (r *Registry) SetRefs(ptr uintptr, refs int32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.entries[ptr]
+	if !ok {
+		return
+	}
+	if refs <= 0 {
+		delete(r.entries, ptr)
+		return
+	}
+	e.obj.Refs = refs
+}
+
+// Untrack removes an object from the registry.
+func (r *Registry) Untrack(ptr uintptr) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.entries, ptr)
+}
+
+// Live returns the tracked objects, ordered by creation.
+func (r *Registry) Live() []Object {
+	// the entries are copied, so the reference counts are read without holding the lock: reading them
+	// may call AddRef and Release on delegates implemented in Go, that update the registry
+	r.mu.Lock()
+	entries := make([]entry, 0, len(r.entries))
+	for _, e := range r.entries {
+		entries = append(entries, *e)
+	}
+	r.mu.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].id < entries[j].id
+	})
+
+	objects := make([]Object, 0, len(entries))
+	for _, e := range entries {
+		obj := e.obj
+		if e.refs != nil {
+			if obj.Refs = e.refs(); obj.Refs <= 0 {
+				// released after being copied
+				continue
+			}
+		}
+		objects = append(objects, obj)
+	}
+	return objects
+}
+
+// Report writes the tracked objects to the given writer, ordered by creation.
+func (r *Registry) Report(w io.Writer) error {
+	objects := r.Live()
+	if _, err := fmt.Fprintf(w, "winrt: %d live objects\n", len(objects)); err != nil {
+		return err
+	}
+	for _, obj := range objects {
+		if _, err := fmt.Fprintf(w, "\n%s %s at 0x%x, %d refs, created at:\n%s\n", obj.Kind, obj.Type, obj.Ptr, obj.Refs, strings.TrimRight(obj.Stack, "\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
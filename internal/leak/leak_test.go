@@ -0,0 +1,99 @@
+package leak
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	assert.Empty(t, r.Live())
+
+	objRefs := int32(3)
+	r.Track(0x2000, KindObject, "*bluetooth.BluetoothLEDevice", "object stack\n", func() int32 {
+		return objRefs
+	})
+	r.Track(0x1000, KindDelegate, "*foundation.TypedEventHandler", "delegate stack\n", nil)
+
+	// objects are ordered by creation
+	live := r.Live()
+	require.Len(t, live, 2)
+	assert.Equal(t, Object{Kind: KindObject, Type: "*bluetooth.BluetoothLEDevice", Ptr: 0x2000, Refs: 3, Stack: "object stack\n"}, live[0])
+	assert.Equal(t, Object{Kind: KindDelegate, Type: "*foundation.TypedEventHandler", Ptr: 0x1000, Refs: 1, Stack: "delegate stack\n"}, live[1])
+
+	// the reference counts are either recorded or read when reported
+	objRefs = 1
+	r.SetRefs(0x1000, 2)
+	live = r.Live()
+	require.Len(t, live, 2)
+	assert.EqualValues(t, 1, live[0].Refs)
+	assert.EqualValues(t, 2, live[1].Refs)
+
+	// delegates are untracked once they are no longer referenced
+	r.SetRefs(0x1000, 0)
+	live = r.Live()
+	require.Len(t, live, 1)
+	assert.Equal(t, uintptr(0x2000), live[0].Ptr)
+
+	// untracked objects are ignored
+	r.SetRefs(0x1000, 1)
+	r.Untrack(0x3000)
+	assert.Len(t, r.Live(), 1)
+
+	r.Untrack(0x2000)
+	assert.Empty(t, r.Live())
+}
+
+func TestRegistryLiveRefs(t *testing.T) {
+	r := NewRegistry()
+	// reading the reference count of an object may update the registry, like the AddRef and Release
+	// methods of the delegates
+	r.Track(0x1000, KindDelegate, "*A", "", nil)
+	r.Track(0x2000, KindObject, "*B", "", func() int32 {
+		r.SetRefs(0x1000, 2)
+		r.SetRefs(0x1000, 1)
+		return 1
+	})
+	// objects released after being copied are not reported
+	r.Track(0x3000, KindObject, "*C", "", func() int32 {
+		return 0
+	})
+
+	live := r.Live()
+	require.Len(t, live, 2)
+	assert.Equal(t, "*A", live[0].Type)
+	assert.Equal(t, "*B", live[1].Type)
+}
+
+func TestRegistryReuse(t *testing.T) {
+	// the address of a released object may be reused by a new one
+	r := NewRegistry()
+	r.Track(0x1000, KindDelegate, "*A", "", nil)
+	r.Track(0x2000, KindDelegate, "*B", "", nil)
+	r.SetRefs(0x1000, 0)
+	r.Track(0x1000, KindDelegate, "*C", "", nil)
+
+	live := r.Live()
+	require.Len(t, live, 2)
+	assert.Equal(t, "*B", live[0].Type)
+	assert.Equal(t, "*C", live[1].Type)
+	assert.EqualValues(t, 1, live[1].Refs)
+}
+
+func TestRegistryReport(t *testing.T) {
+	r := NewRegistry()
+	var buf bytes.Buffer
+	require.NoError(t, r.Report(&buf))
+	assert.Equal(t, "winrt: 0 live objects\n", buf.String())
+
+	r.Track(0x1000, KindDelegate, "*foundation.TypedEventHandler", "goroutine 1 [running]:\nmain.main()\n", nil)
+	r.SetRefs(0x1000, 2)
+	buf.Reset()
+	require.NoError(t, r.Report(&buf))
+	assert.Equal(t, "winrt: 1 live objects\n\n"+
+		"delegate *foundation.TypedEventHandler at 0x1000, 2 refs, created at:\n"+
+		"goroutine 1 [running]:\nmain.main()\n", buf.String())
+}
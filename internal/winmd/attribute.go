@@ -0,0 +1,481 @@
+package winmd
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/tdakkota/win32metadata/md"
+	"github.com/tdakkota/win32metadata/types"
+)
+
+// Serialization types used by the named arguments of custom attributes and boxed values (II.23.3).
+const (
+	serializationTypeSystemType = 0x50
+	serializationTypeTaggedObj  = 0x51
+	serializationTypeField      = 0x53
+	serializationTypeProperty   = 0x54
+	serializationTypeEnum       = 0x55
+)
+
+// Attribute is a decoded custom attribute.
+type Attribute struct {
+	// Type is the full name of the attribute type.
+	Type      string
+	FixedArgs []AttributeArg
+	NamedArgs []AttributeNamedArg
+}
+
+// AttributeArg is an argument of a custom attribute.
+type AttributeArg struct {
+	// Kind is the type of the argument. Enums are reported as ELEMENT_TYPE_VALUETYPE,
+	// System.Type values as ELEMENT_TYPE_CLASS and arrays as ELEMENT_TYPE_SZARRAY.
+	Kind types.ElementTypeKind
+	// TypeName is the full name of the enum type of enum arguments.
+	TypeName string
+	// Value holds the value of the argument:
+	//   - bool, uint16 (char), int8 to uint64, float32 and float64 for primitive types.
+	//   - string for strings and System.Type values, or nil if they are null.
+	//   - the value of the underlying integer type for enums.
+	//   - []AttributeArg for arrays, or nil if they are null.
+	Value interface{}
+}
+
+// AttributeNamedArg is a named argument of a custom attribute, which sets a field or a property of the attribute.
+type AttributeNamedArg struct {
+	AttributeArg
+	Name    string
+	IsField bool
+}
+
+// String returns the value of a string or System.Type argument.
+func (a AttributeArg) String() (string, bool) {
+	s, ok := a.Value.(string)
+	return s, ok
+}
+
+// Uint32 returns the value of an uint32 argument, or of an enum argument whose underlying type is uint32.
+func (a AttributeArg) Uint32() (uint32, bool) {
+	v, ok := a.Value.(uint32)
+	return v, ok
+}
+
+// EnumTypeResolver returns the underlying integer type of the enum with the given full name.
+type EnumTypeResolver func(name string) (types.ElementTypeKind, bool)
+
+// DecodeAttributeValue decodes the value blob of a custom attribute, as defined in ECMA-335 (II.23.3).
+// The params are the parameters of the constructor of the attribute. Enums are decoded using the underlying
+// type returned by the resolver, which may be nil. Int32 is used for enums that can not be resolved.
+func DecodeAttributeValue(ctx *types.Context, params []types.Element, blob []byte, enumType EnumTypeResolver) ([]AttributeArg, []AttributeNamedArg, error) {
+	d := &attributeDecoder{ctx: ctx, blob: blob, enumType: enumType}
+
+	prolog, err := d.uint16()
+	if err != nil {
+		return nil, nil, err
+	}
+	if prolog != 0x0001 {
+		return nil, nil, fmt.Errorf("invalid custom attribute prolog %#04x", prolog)
+	}
+
+	fixedArgs := make([]AttributeArg, 0, len(params))
+	for i, p := range params {
+		arg, err := d.fixedArg(p.Type)
+		if err != nil {
+			return nil, nil, fmt.Errorf("fixed argument %d: %w", i, err)
+		}
+		fixedArgs = append(fixedArgs, arg)
+	}
+
+	numNamed, err := d.uint16()
+	if err != nil {
+		return nil, nil, err
+	}
+	namedArgs := make([]AttributeNamedArg, 0, numNamed)
+	for i := 0; i < int(numNamed); i++ {
+		arg, err := d.namedArg()
+		if err != nil {
+			return nil, nil, fmt.Errorf("named argument %d: %w", i, err)
+		}
+		namedArgs = append(namedArgs, arg)
+	}
+
+	if d.offset != len(d.blob) {
+		return nil, nil, fmt.Errorf("custom attribute has %d trailing bytes", len(d.blob)-d.offset)
+	}
+	return fixedArgs, namedArgs, nil
+}
+
+type attributeDecoder struct {
+	ctx      *types.Context
+	enumType EnumTypeResolver
+	blob     []byte
+	offset   int
+}
+
+func (d *attributeDecoder) next(n int) ([]byte, error) {
+	if n < 0 || d.offset+n > len(d.blob) {
+		return nil, fmt.Errorf("unexpected end of custom attribute blob")
+	}
+	b := d.blob[d.offset : d.offset+n]
+	d.offset += n
+	return b, nil
+}
+
+func (d *attributeDecoder) uint8() (uint8, error) {
+	b, err := d.next(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *attributeDecoder) uint16() (uint16, error) {
+	b, err := d.next(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func (d *attributeDecoder) uint32() (uint32, error) {
+	b, err := d.next(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (d *attributeDecoder) uint64() (uint64, error) {
+	b, err := d.next(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+// compressedUint reads an unsigned integer compressed as defined in II.23.2.
+func (d *attributeDecoder) compressedUint() (uint32, error) {
+	first, err := d.uint8()
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case first&0x80 == 0:
+		return uint32(first), nil
+	case first&0xc0 == 0x80:
+		b, err := d.next(1)
+		if err != nil {
+			return 0, err
+		}
+		return uint32(first&0x3f)<<8 | uint32(b[0]), nil
+	case first&0xe0 == 0xc0:
+		b, err := d.next(3)
+		if err != nil {
+			return 0, err
+		}
+		return uint32(first&0x1f)<<24 | uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2]), nil
+	}
+	return 0, fmt.Errorf("invalid compressed integer %#02x", first)
+}
+
+// serString reads a SerString: a compressed length followed by UTF-8 bytes, or 0xFF for null strings.
+func (d *attributeDecoder) serString() (interface{}, error) {
+	if d.offset < len(d.blob) && d.blob[d.offset] == 0xff {
+		d.offset++
+		return nil, nil
+	}
+
+	size, err := d.compressedUint()
+	if err != nil {
+		return nil, err
+	}
+	b, err := d.next(int(size))
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// primitive reads a value of a primitive type or a string.
+func (d *attributeDecoder) primitive(kind types.ElementTypeKind) (interface{}, error) {
+	switch kind {
+	case types.ELEMENT_TYPE_BOOLEAN:
+		v, err := d.uint8()
+		return v != 0, err
+	case types.ELEMENT_TYPE_CHAR, types.ELEMENT_TYPE_U2:
+		return d.uint16()
+	case types.ELEMENT_TYPE_I1:
+		v, err := d.uint8()
+		return int8(v), err
+	case types.ELEMENT_TYPE_U1:
+		return d.uint8()
+	case types.ELEMENT_TYPE_I2:
+		v, err := d.uint16()
+		return int16(v), err
+	case types.ELEMENT_TYPE_I4:
+		v, err := d.uint32()
+		return int32(v), err
+	case types.ELEMENT_TYPE_U4:
+		return d.uint32()
+	case types.ELEMENT_TYPE_I8:
+		v, err := d.uint64()
+		return int64(v), err
+	case types.ELEMENT_TYPE_U8:
+		return d.uint64()
+	case types.ELEMENT_TYPE_R4:
+		v, err := d.uint32()
+		return math.Float32frombits(v), err
+	case types.ELEMENT_TYPE_R8:
+		v, err := d.uint64()
+		return math.Float64frombits(v), err
+	case types.ELEMENT_TYPE_STRING:
+		return d.serString()
+	}
+	return nil, fmt.Errorf("unsupported custom attribute argument type %v", kind)
+}
+
+// enum reads the value of an enum, using its underlying type.
+func (d *attributeDecoder) enum(name string) (AttributeArg, error) {
+	kind := types.ELEMENT_TYPE_I4
+	if d.enumType != nil {
+		if k, ok := d.enumType(name); ok {
+			kind = k
+		}
+	}
+
+	v, err := d.primitive(kind)
+	return AttributeArg{Kind: types.ELEMENT_TYPE_VALUETYPE, TypeName: name, Value: v}, err
+}
+
+// array reads an array whose elements are read by the given function.
+func (d *attributeDecoder) array(elem func() (AttributeArg, error)) (AttributeArg, error) {
+	arg := AttributeArg{Kind: types.ELEMENT_TYPE_SZARRAY}
+
+	count, err := d.uint32()
+	if err != nil {
+		return arg, err
+	}
+	if count == 0xffffffff {
+		return arg, nil // null array
+	}
+
+	values := make([]AttributeArg, 0, count)
+	for i := uint32(0); i < count; i++ {
+		v, err := elem()
+		if err != nil {
+			return arg, err
+		}
+		values = append(values, v)
+	}
+	arg.Value = values
+	return arg, nil
+}
+
+// fixedArg reads an argument whose type is given by the signature of the constructor.
+func (d *attributeDecoder) fixedArg(t types.ElementType) (AttributeArg, error) {
+	switch t.Kind {
+	case types.ELEMENT_TYPE_CLASS, types.ELEMENT_TYPE_VALUETYPE:
+		namespace, name, err := d.ctx.ResolveTypeDefOrRefName(t.TypeDef.Index)
+		if err != nil {
+			return AttributeArg{}, err
+		}
+
+		if t.Kind == types.ELEMENT_TYPE_VALUETYPE {
+			return d.enum(namespace + "." + name)
+		}
+		if namespace != "System" || name != "Type" {
+			return AttributeArg{}, fmt.Errorf("unsupported custom attribute argument class %s.%s", namespace, name)
+		}
+		v, err := d.serString()
+		return AttributeArg{Kind: types.ELEMENT_TYPE_CLASS, Value: v}, err
+	case types.ELEMENT_TYPE_OBJECT:
+		return d.boxed()
+	case types.ELEMENT_TYPE_SZARRAY:
+		if t.SZArray.Elem == nil {
+			return AttributeArg{}, fmt.Errorf("array without element type")
+		}
+		return d.array(func() (AttributeArg, error) {
+			return d.fixedArg(t.SZArray.Elem.Type)
+		})
+	}
+
+	v, err := d.primitive(t.Kind)
+	return AttributeArg{Kind: t.Kind, Value: v}, err
+}
+
+// fieldOrPropType reads the type of a named argument or a boxed value, and returns a function to read its value.
+func (d *attributeDecoder) fieldOrPropType() (func() (AttributeArg, error), error) {
+	b, err := d.uint8()
+	if err != nil {
+		return nil, err
+	}
+
+	switch b {
+	case serializationTypeSystemType:
+		return func() (AttributeArg, error) {
+			v, err := d.serString()
+			return AttributeArg{Kind: types.ELEMENT_TYPE_CLASS, Value: v}, err
+		}, nil
+	case serializationTypeTaggedObj:
+		return d.boxed, nil
+	case serializationTypeEnum:
+		name, err := d.serString()
+		if err != nil {
+			return nil, err
+		}
+		enumName, ok := name.(string)
+		if !ok {
+			return nil, fmt.Errorf("enum argument without type name")
+		}
+		return func() (AttributeArg, error) {
+			return d.enum(enumName)
+		}, nil
+	case byte(types.ELEMENT_TYPE_SZARRAY):
+		elem, err := d.fieldOrPropType()
+		if err != nil {
+			return nil, err
+		}
+		return func() (AttributeArg, error) {
+			return d.array(elem)
+		}, nil
+	}
+
+	kind := types.ElementTypeKind(b)
+	if kind < types.ELEMENT_TYPE_BOOLEAN || kind > types.ELEMENT_TYPE_STRING {
+		return nil, fmt.Errorf("invalid custom attribute argument type %#02x", b)
+	}
+	return func() (AttributeArg, error) {
+		v, err := d.primitive(kind)
+		return AttributeArg{Kind: kind, Value: v}, err
+	}, nil
+}
+
+// boxed reads a value of type System.Object, which is preceded by its type.
+func (d *attributeDecoder) boxed() (AttributeArg, error) {
+	read, err := d.fieldOrPropType()
+	if err != nil {
+		return AttributeArg{}, err
+	}
+	return read()
+}
+
+func (d *attributeDecoder) namedArg() (AttributeNamedArg, error) {
+	kind, err := d.uint8()
+	if err != nil {
+		return AttributeNamedArg{}, err
+	}
+	if kind != serializationTypeField && kind != serializationTypeProperty {
+		return AttributeNamedArg{}, fmt.Errorf("invalid named argument kind %#02x", kind)
+	}
+
+	read, err := d.fieldOrPropType()
+	if err != nil {
+		return AttributeNamedArg{}, err
+	}
+	name, err := d.serString()
+	if err != nil {
+		return AttributeNamedArg{}, err
+	}
+	argName, ok := name.(string)
+	if !ok {
+		return AttributeNamedArg{}, fmt.Errorf("named argument without name")
+	}
+
+	arg, err := read()
+	if err != nil {
+		return AttributeNamedArg{}, err
+	}
+	return AttributeNamedArg{AttributeArg: arg, Name: argName, IsField: kind == serializationTypeField}, nil
+}
+
+// decodeAttribute decodes a row of the CustomAttribute table.
+func decodeAttribute(ctx *types.Context, cAttr types.CustomAttribute, enumType EnumTypeResolver) (*Attribute, error) {
+	attrType, ctor, err := attributeConstructor(ctx, cAttr)
+	if err != nil {
+		return nil, err
+	}
+
+	fixedArgs, namedArgs, err := DecodeAttributeValue(ctx, ctor.Params, cAttr.Value, enumType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid custom attribute %s: %w", attrType, err)
+	}
+
+	return &Attribute{
+		Type:      attrType,
+		FixedArgs: fixedArgs,
+		NamedArgs: namedArgs,
+	}, nil
+}
+
+// attributeConstructor returns the full name of the type of the attribute and the signature of its constructor.
+func attributeConstructor(ctx *types.Context, cAttr types.CustomAttribute) (string, types.MethodSignature, error) {
+	table, _ := cAttr.Type.Table()
+	row, ok := cAttr.Type.Row(ctx)
+	if !ok {
+		return "", types.MethodSignature{}, fmt.Errorf("invalid custom attribute constructor")
+	}
+
+	switch table {
+	case md.MemberRef:
+		var memberRef types.MemberRef
+		if err := memberRef.FromRow(row); err != nil {
+			return "", types.MethodSignature{}, err
+		}
+		sig, err := memberRef.Signature.Reader().Method(ctx)
+		if err != nil {
+			return "", types.MethodSignature{}, err
+		}
+
+		var namespace, name string
+		switch classTable, _ := memberRef.Class.Table(); classTable {
+		case md.TypeRef:
+			var typeRef types.TypeRef
+			classRow, ok := memberRef.Class.Row(ctx)
+			if !ok {
+				return "", types.MethodSignature{}, fmt.Errorf("invalid custom attribute class")
+			}
+			if err := typeRef.FromRow(classRow); err != nil {
+				return "", types.MethodSignature{}, err
+			}
+			namespace, name = typeRef.TypeNamespace, typeRef.TypeName
+		case md.TypeDef:
+			var typeDef types.TypeDef
+			classRow, ok := memberRef.Class.Row(ctx)
+			if !ok {
+				return "", types.MethodSignature{}, fmt.Errorf("invalid custom attribute class")
+			}
+			if err := typeDef.FromRow(classRow); err != nil {
+				return "", types.MethodSignature{}, err
+			}
+			namespace, name = typeDef.TypeNamespace, typeDef.TypeName
+		default:
+			return "", types.MethodSignature{}, fmt.Errorf("unsupported custom attribute class table %v", classTable)
+		}
+		return namespace + "." + name, sig, nil
+	case md.MethodDef:
+		var methodDef types.MethodDef
+		if err := methodDef.FromRow(row); err != nil {
+			return "", types.MethodSignature{}, err
+		}
+		sig, err := methodDef.Signature.Reader().Method(ctx)
+		if err != nil {
+			return "", types.MethodSignature{}, err
+		}
+
+		// the attribute type is the type that owns the constructor
+		methodIndex := cAttr.Type.TableIndex()
+		typeDefTable := ctx.Table(md.TypeDef)
+		for i := uint32(0); i < typeDefTable.RowCount(); i++ {
+			var typeDef types.TypeDef
+			if err := typeDef.FromRow(typeDefTable.Row(i)); err != nil {
+				return "", types.MethodSignature{}, err
+			}
+			if methodIndex >= typeDef.MethodList.Start() && methodIndex < typeDef.MethodList.End() {
+				return typeDef.TypeNamespace + "." + typeDef.TypeName, sig, nil
+			}
+		}
+		return "", types.MethodSignature{}, fmt.Errorf("owner of custom attribute constructor %s not found", methodDef.Name)
+	}
+	return "", types.MethodSignature{}, fmt.Errorf("unsupported custom attribute constructor table %v", table)
+}
@@ -0,0 +1,169 @@
+package winmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tdakkota/win32metadata/md"
+	"github.com/tdakkota/win32metadata/types"
+)
+
+func primitiveParams(kinds ...types.ElementTypeKind) []types.Element {
+	params := make([]types.Element, 0, len(kinds))
+	for _, k := range kinds {
+		params = append(params, types.Element{Type: types.ElementType{Kind: k}})
+	}
+	return params
+}
+
+func TestDecodeAttributeValueFixedArgs(t *testing.T) {
+	blob := []byte{
+		0x01, 0x00, // prolog
+		0x01,                   // bool
+		0xff,                   // int8
+		0x05, 0x00, 0x00, 0x00, // uint32
+		0x03, 'a', 'b', 'c', // string
+		0xff,       // null string
+		0x00, 0x00, // no named args
+	}
+
+	fixed, named, err := DecodeAttributeValue(nil, primitiveParams(
+		types.ELEMENT_TYPE_BOOLEAN,
+		types.ELEMENT_TYPE_I1,
+		types.ELEMENT_TYPE_U4,
+		types.ELEMENT_TYPE_STRING,
+		types.ELEMENT_TYPE_STRING,
+	), blob, nil)
+	require.NoError(t, err)
+	assert.Empty(t, named)
+
+	require.Len(t, fixed, 5)
+	assert.Equal(t, true, fixed[0].Value)
+	assert.Equal(t, int8(-1), fixed[1].Value)
+	assert.Equal(t, uint32(5), fixed[2].Value)
+	assert.Equal(t, "abc", fixed[3].Value)
+	assert.Nil(t, fixed[4].Value)
+}
+
+func TestDecodeAttributeValueCompressedLength(t *testing.T) {
+	long := strings.Repeat("x", 300) // 300 = 0x012c, needs a two byte length
+
+	blob := append([]byte{0x01, 0x00, 0x81, 0x2c}, long...)
+	blob = append(blob, 0x00, 0x00)
+
+	fixed, _, err := DecodeAttributeValue(nil, primitiveParams(types.ELEMENT_TYPE_STRING), blob, nil)
+	require.NoError(t, err)
+	require.Len(t, fixed, 1)
+	assert.Equal(t, long, fixed[0].Value)
+}
+
+func TestDecodeAttributeValueNamedArgs(t *testing.T) {
+	blob := []byte{
+		0x01, 0x00, // prolog
+		0x03, 0x00, // 3 named args
+		// property of type System.Type
+		0x54, 0x50, 0x04, 'N', 'a', 'm', 'e', 0x03, 'A', '.', 'B',
+		// field of an enum type
+		0x53, 0x55, 0x03, 'A', '.', 'E', 0x01, 'F', 0xff, 0xff, 0xff, 0xff,
+		// property of a boxed uint16
+		0x54, 0x51, 0x01, 'P', 0x07, 0x2a, 0x00,
+	}
+
+	enumType := func(name string) (types.ElementTypeKind, bool) {
+		if name == "A.E" {
+			return types.ELEMENT_TYPE_U4, true
+		}
+		return 0, false
+	}
+
+	fixed, named, err := DecodeAttributeValue(nil, nil, blob, enumType)
+	require.NoError(t, err)
+	assert.Empty(t, fixed)
+
+	require.Len(t, named, 3)
+	assert.Equal(t, AttributeNamedArg{
+		AttributeArg: AttributeArg{Kind: types.ELEMENT_TYPE_CLASS, Value: "A.B"},
+		Name:         "Name",
+	}, named[0])
+	assert.Equal(t, AttributeNamedArg{
+		AttributeArg: AttributeArg{Kind: types.ELEMENT_TYPE_VALUETYPE, TypeName: "A.E", Value: uint32(0xffffffff)},
+		Name:         "F",
+		IsField:      true,
+	}, named[1])
+	assert.Equal(t, AttributeNamedArg{
+		AttributeArg: AttributeArg{Kind: types.ELEMENT_TYPE_U2, Value: uint16(42)},
+		Name:         "P",
+	}, named[2])
+}
+
+func TestDecodeAttributeValueInvalid(t *testing.T) {
+	tests := map[string][]byte{
+		"empty":          {},
+		"invalid prolog": {0x02, 0x00, 0x00, 0x00},
+		"truncated":      {0x01, 0x00, 0x05, 0x00},
+		"trailing bytes": {0x01, 0x00, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	}
+	for name, blob := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := DecodeAttributeValue(nil, primitiveParams(types.ELEMENT_TYPE_U4), blob, nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestDecodeAllAttributes decodes every custom attribute in the embedded metadata.
+func TestDecodeAllAttributes(t *testing.T) {
+	mdStore, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	for name, ctx := range mdStore.contexts {
+		t.Run(name, func(t *testing.T) {
+			cAttrTable := ctx.Table(md.CustomAttribute)
+			require.NotZero(t, cAttrTable.RowCount())
+
+			for i := uint32(0); i < cAttrTable.RowCount(); i++ {
+				cAttr, err := readCustomAttribute(ctx, i)
+				require.NoError(t, err)
+
+				attr, err := mdStore.DecodeAttribute(ctx, cAttr)
+				require.NoError(t, err, "attribute %d", i)
+				require.NotEmpty(t, attr.Type)
+			}
+		})
+	}
+}
+
+func TestTypeDefGetAttributes(t *testing.T) {
+	mdStore, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	typeDef, err := mdStore.TypeDefByName("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcher")
+	require.NoError(t, err)
+
+	// [activatable(0x0a000000, "Windows.Foundation.UniversalApiContract")]
+	// [activatable(IBluetoothLEAdvertisementWatcherFactory, 0x0a000000, "Windows.Foundation.UniversalApiContract")]
+	attrs, err := typeDef.GetAttributes(AttributeTypeActivatableAttribute)
+	require.NoError(t, err)
+	require.Len(t, attrs, 2)
+
+	var factories []string
+	for _, attr := range attrs {
+		if attr.FixedArgs[0].Kind == types.ELEMENT_TYPE_CLASS {
+			class, ok := attr.FixedArgs[0].String()
+			require.True(t, ok)
+			factories = append(factories, class)
+		}
+	}
+	assert.Equal(t, []string{"Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcherFactory"}, factories)
+
+	// [marshaling_behavior(agile)]: the enum is defined in a different file
+	attrs, err = typeDef.GetAttributes("Windows.Foundation.Metadata.MarshalingBehaviorAttribute")
+	require.NoError(t, err)
+	require.Len(t, attrs, 1)
+	require.Len(t, attrs[0].FixedArgs, 1)
+	assert.Equal(t, "Windows.Foundation.Metadata.MarshalingType", attrs[0].FixedArgs[0].TypeName)
+	assert.Equal(t, int32(2), attrs[0].FixedArgs[0].Value) // Agile
+}
@@ -0,0 +1,77 @@
+package winmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/tdakkota/win32metadata/md"
+	"github.com/tdakkota/win32metadata/types"
+)
+
+// readBlob reads the blob referenced by the given column of a table row.
+// The parser reads two byte blob lengths as little endian instead of big endian (ECMA-335 II.24.2.4),
+// and fails to read short blobs stored at the end of the heap, so blobs that may be long, like the values
+// of custom attributes, must be read with this function.
+func readBlob(ctx *types.Context, table md.TableType, row, column uint32) (types.Blob, error) {
+	idx, err := ctx.Uint64(table, row, column)
+	if err != nil {
+		return nil, err
+	}
+
+	heap, err := ctx.Metadata.StreamByName("#Blob")
+	if err != nil {
+		return nil, err
+	}
+	if idx >= uint64(heap.Size()) {
+		return nil, fmt.Errorf("blob index %d is out of bounds (%d)", idx, heap.Size())
+	}
+
+	// the length is a compressed unsigned integer of one, two or four bytes
+	header := make([]byte, 4)
+	n, err := heap.ReadAt(header, int64(idx))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	header = header[:n]
+
+	var size, lenSize int
+	switch {
+	case len(header) >= 1 && header[0]&0x80 == 0:
+		size, lenSize = int(header[0]), 1
+	case len(header) >= 2 && header[0]&0xc0 == 0x80:
+		size, lenSize = int(header[0]&0x3f)<<8|int(header[1]), 2
+	case len(header) >= 4 && header[0]&0xe0 == 0xc0:
+		size, lenSize = int(header[0]&0x1f)<<24|int(header[1])<<16|int(header[2])<<8|int(header[3]), 4
+	default:
+		return nil, fmt.Errorf("invalid blob length at index %d", idx)
+	}
+
+	blob := make([]byte, size)
+	if _, err := heap.ReadAt(blob, int64(idx)+int64(lenSize)); err != nil {
+		return nil, err
+	}
+	return blob, nil
+}
+
+// readCustomAttribute reads a row of the CustomAttribute table.
+func readCustomAttribute(ctx *types.Context, row uint32) (types.CustomAttribute, error) {
+	var cAttr types.CustomAttribute
+
+	parent, err := ctx.Uint64(md.CustomAttribute, row, 0)
+	if err != nil {
+		return cAttr, fmt.Errorf("decode field Parent: %w", err)
+	}
+	attrType, err := ctx.Uint64(md.CustomAttribute, row, 1)
+	if err != nil {
+		return cAttr, fmt.Errorf("decode field Type: %w", err)
+	}
+	value, err := readBlob(ctx, md.CustomAttribute, row, 2)
+	if err != nil {
+		return cAttr, fmt.Errorf("decode field Value: %w", err)
+	}
+
+	cAttr.Parent = types.HasCustomAttribute(parent)
+	cAttr.Type = types.CustomAttributeType(attrType)
+	cAttr.Value = value
+	return cAttr, nil
+}
@@ -0,0 +1,91 @@
+package winmd
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"unicode/utf16"
+
+	"github.com/tdakkota/win32metadata/types"
+)
+
+// ConstantLiteral returns the value of the given constant as a Go literal.
+// The blob is decoded according to the type of the constant:
+// https://www.ecma-international.org/publications-and-standards/standards/ecma-335/ (II.22.9)
+func ConstantLiteral(c types.Constant) (string, error) {
+	value := []byte(c.Value)
+
+	// fixed size constants must have exactly the size of their type
+	size, ok := constantSize(c.Type)
+	if ok && len(value) != size {
+		return "", fmt.Errorf("invalid constant of type %v: expected %d bytes, got %d", c.Type, size, len(value))
+	}
+
+	switch c.Type {
+	case types.ELEMENT_TYPE_BOOLEAN:
+		return strconv.FormatBool(value[0] != 0), nil
+	case types.ELEMENT_TYPE_CHAR:
+		// chars are UTF-16 code units, which may not be valid runes on their own
+		return strconv.FormatUint(uint64(binary.LittleEndian.Uint16(value)), 10), nil
+	case types.ELEMENT_TYPE_I1:
+		return strconv.FormatInt(int64(int8(value[0])), 10), nil
+	case types.ELEMENT_TYPE_U1:
+		return strconv.FormatUint(uint64(value[0]), 10), nil
+	case types.ELEMENT_TYPE_I2:
+		return strconv.FormatInt(int64(int16(binary.LittleEndian.Uint16(value))), 10), nil
+	case types.ELEMENT_TYPE_U2:
+		return strconv.FormatUint(uint64(binary.LittleEndian.Uint16(value)), 10), nil
+	case types.ELEMENT_TYPE_I4:
+		return strconv.FormatInt(int64(int32(binary.LittleEndian.Uint32(value))), 10), nil
+	case types.ELEMENT_TYPE_U4:
+		return strconv.FormatUint(uint64(binary.LittleEndian.Uint32(value)), 10), nil
+	case types.ELEMENT_TYPE_I8:
+		return strconv.FormatInt(int64(binary.LittleEndian.Uint64(value)), 10), nil
+	case types.ELEMENT_TYPE_U8:
+		return strconv.FormatUint(binary.LittleEndian.Uint64(value), 10), nil
+	case types.ELEMENT_TYPE_R4:
+		return floatLiteral(float64(math.Float32frombits(binary.LittleEndian.Uint32(value))), 32)
+	case types.ELEMENT_TYPE_R8:
+		return floatLiteral(math.Float64frombits(binary.LittleEndian.Uint64(value)), 64)
+	case types.ELEMENT_TYPE_STRING:
+		// strings are stored as UTF-16 without a trailing zero
+		if len(value)%2 != 0 {
+			return "", fmt.Errorf("invalid string constant: odd length %d", len(value))
+		}
+		units := make([]uint16, 0, len(value)/2)
+		for i := 0; i < len(value); i += 2 {
+			units = append(units, binary.LittleEndian.Uint16(value[i:]))
+		}
+		return strconv.Quote(string(utf16.Decode(units))), nil
+	case types.ELEMENT_TYPE_CLASS:
+		// the only valid class constant is a null reference
+		return "nil", nil
+	}
+
+	return "", fmt.Errorf("unsupported constant type %v", c.Type)
+}
+
+// floatLiteral formats a floating point constant of the given bit size. NaN and infinite values have no
+// Go literal, and math.NaN() or math.Inf() can not be used in constant declarations, so they are rejected.
+func floatLiteral(f float64, bitSize int) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("float constant %v can not be represented as a Go constant", f)
+	}
+	return strconv.FormatFloat(f, 'g', -1, bitSize), nil
+}
+
+// constantSize returns the size of the blob of a fixed size constant.
+func constantSize(kind types.ElementTypeKind) (int, bool) {
+	switch kind {
+	case types.ELEMENT_TYPE_BOOLEAN, types.ELEMENT_TYPE_I1, types.ELEMENT_TYPE_U1:
+		return 1, true
+	case types.ELEMENT_TYPE_CHAR, types.ELEMENT_TYPE_I2, types.ELEMENT_TYPE_U2:
+		return 2, true
+	case types.ELEMENT_TYPE_I4, types.ELEMENT_TYPE_U4, types.ELEMENT_TYPE_R4, types.ELEMENT_TYPE_CLASS:
+		return 4, true
+	case types.ELEMENT_TYPE_I8, types.ELEMENT_TYPE_U8, types.ELEMENT_TYPE_R8:
+		return 8, true
+	}
+	return 0, false
+}
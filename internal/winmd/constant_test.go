@@ -0,0 +1,174 @@
+package winmd
+
+import (
+	"encoding/binary"
+	"strconv"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tdakkota/win32metadata/md"
+	"github.com/tdakkota/win32metadata/types"
+)
+
+func TestConstantLiteral(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    types.ElementTypeKind
+		value   []byte
+		literal string
+	}{
+		{"bool true", types.ELEMENT_TYPE_BOOLEAN, []byte{1}, "true"},
+		{"bool false", types.ELEMENT_TYPE_BOOLEAN, []byte{0}, "false"},
+		{"char", types.ELEMENT_TYPE_CHAR, []byte{0x41, 0}, "65"},
+		{"int8", types.ELEMENT_TYPE_I1, []byte{0xff}, "-1"},
+		{"uint8", types.ELEMENT_TYPE_U1, []byte{0xff}, "255"},
+		{"int16", types.ELEMENT_TYPE_I2, []byte{0x00, 0x80}, "-32768"},
+		{"uint16", types.ELEMENT_TYPE_U2, []byte{0xff, 0xff}, "65535"},
+		{"negative int32", types.ELEMENT_TYPE_I4, []byte{0xfe, 0xff, 0xff, 0xff}, "-2"},
+		{"positive int32", types.ELEMENT_TYPE_I4, []byte{0x01, 0x02, 0x00, 0x00}, "513"},
+		{"max uint32", types.ELEMENT_TYPE_U4, []byte{0xff, 0xff, 0xff, 0xff}, "4294967295"},
+		{"min int64", types.ELEMENT_TYPE_I8, []byte{0, 0, 0, 0, 0, 0, 0, 0x80}, "-9223372036854775808"},
+		{"max uint64", types.ELEMENT_TYPE_U8, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "18446744073709551615"},
+		{"float32", types.ELEMENT_TYPE_R4, []byte{0x00, 0x00, 0xc0, 0x3f}, "1.5"},
+		{"float64", types.ELEMENT_TYPE_R8, []byte{0, 0, 0, 0, 0, 0, 0xf8, 0xbf}, "-1.5"},
+		{"string", types.ELEMENT_TYPE_STRING, []byte{'h', 0, 'i', 0, '"', 0}, `"hi\""`},
+		{"empty string", types.ELEMENT_TYPE_STRING, []byte{}, `""`},
+		{"null", types.ELEMENT_TYPE_CLASS, []byte{0, 0, 0, 0}, "nil"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			literal, err := ConstantLiteral(types.Constant{Type: tt.kind, Value: tt.value})
+			require.NoError(t, err)
+			assert.Equal(t, tt.literal, literal)
+		})
+	}
+}
+
+func TestConstantLiteralInvalid(t *testing.T) {
+	_, err := ConstantLiteral(types.Constant{Type: types.ELEMENT_TYPE_I4, Value: []byte{1, 2}})
+	assert.Error(t, err)
+
+	_, err = ConstantLiteral(types.Constant{Type: types.ELEMENT_TYPE_STRING, Value: []byte{1}})
+	assert.Error(t, err)
+
+	_, err = ConstantLiteral(types.Constant{Type: types.ELEMENT_TYPE_OBJECT, Value: []byte{}})
+	assert.Error(t, err)
+}
+
+func TestConstantLiteralNonFinite(t *testing.T) {
+	tests := []struct {
+		name  string
+		kind  types.ElementTypeKind
+		value []byte
+	}{
+		{"float32 NaN", types.ELEMENT_TYPE_R4, []byte{0x00, 0x00, 0xc0, 0x7f}},
+		{"float32 +Inf", types.ELEMENT_TYPE_R4, []byte{0x00, 0x00, 0x80, 0x7f}},
+		{"float32 -Inf", types.ELEMENT_TYPE_R4, []byte{0x00, 0x00, 0x80, 0xff}},
+		{"float64 NaN", types.ELEMENT_TYPE_R8, []byte{0, 0, 0, 0, 0, 0, 0xf8, 0x7f}},
+		{"float64 +Inf", types.ELEMENT_TYPE_R8, []byte{0, 0, 0, 0, 0, 0, 0xf0, 0x7f}},
+		{"float64 -Inf", types.ELEMENT_TYPE_R8, []byte{0, 0, 0, 0, 0, 0, 0xf0, 0xff}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// NaN and infinite values have no Go literal, and can not be declared as constants
+			_, err := ConstantLiteral(types.Constant{Type: tt.kind, Value: tt.value})
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestEnumConstants checks that the literal of every enum value in the embedded metadata
+// has the type of the enum and represents exactly the value stored in the metadata.
+func TestEnumConstants(t *testing.T) {
+	mdStore, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	for name, ctx := range mdStore.contexts {
+		t.Run(name, func(t *testing.T) {
+			enumFields := enumFieldTypes(t, mdStore, ctx)
+			require.NotEmpty(t, enumFields)
+
+			checked := 0
+			tableConstants := ctx.Table(md.Constant)
+			for i := uint32(0); i < tableConstants.RowCount(); i++ {
+				var constant types.Constant
+				require.NoError(t, constant.FromRow(tableConstants.Row(i)))
+
+				if table, _ := constant.Parent.Table(); table != md.Field {
+					continue
+				}
+				kind, ok := enumFields[constant.Parent.TableIndex()]
+				if !ok {
+					continue
+				}
+
+				require.Equal(t, kind, constant.Type, "constant type does not match the enum type")
+
+				literal, err := ConstantLiteral(constant)
+				require.NoError(t, err)
+				assert.Equal(t, []byte(constant.Value), encodeIntLiteral(t, kind, literal), "literal %s", literal)
+				checked++
+			}
+			assert.Equal(t, len(enumFields), checked, "some enum values do not have a constant")
+		})
+	}
+}
+
+// enumFieldTypes returns the underlying type of every enum value field defined in the given context.
+func enumFieldTypes(t *testing.T, mdStore *Store, ctx *types.Context) map[uint32]types.ElementTypeKind {
+	t.Helper()
+
+	result := make(map[uint32]types.ElementTypeKind)
+	typeDefTable := ctx.Table(md.TypeDef)
+	for i := uint32(0); i < typeDefTable.RowCount(); i++ {
+		var td types.TypeDef
+		require.NoError(t, td.FromRow(typeDefTable.Row(i)))
+
+		typeDef := &TypeDef{TypeDef: td, HasContext: HasContext{ctx}, logger: mdStore.logger}
+		if !typeDef.IsEnum() {
+			continue
+		}
+
+		fields, err := typeDef.ResolveFieldList(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, fields)
+
+		fieldSig, err := fields[0].Signature.Reader().Field(ctx)
+		require.NoError(t, err)
+
+		for j := 1; j < len(fields); j++ {
+			result[typeDef.FieldList.Start()+uint32(j)] = fieldSig.Field.Type.Kind
+		}
+	}
+	return result
+}
+
+// encodeIntLiteral parses the literal as an integer of the given type and returns its little endian representation.
+func encodeIntLiteral(t *testing.T, kind types.ElementTypeKind, literal string) []byte {
+	t.Helper()
+
+	size, ok := constantSize(kind)
+	require.True(t, ok)
+
+	var bits uint64
+	switch kind {
+	case types.ELEMENT_TYPE_I1, types.ELEMENT_TYPE_I2, types.ELEMENT_TYPE_I4, types.ELEMENT_TYPE_I8:
+		v, err := strconv.ParseInt(literal, 10, size*8)
+		require.NoError(t, err)
+		bits = uint64(v)
+	case types.ELEMENT_TYPE_U1, types.ELEMENT_TYPE_U2, types.ELEMENT_TYPE_U4, types.ELEMENT_TYPE_U8:
+		v, err := strconv.ParseUint(literal, 10, size*8)
+		require.NoError(t, err)
+		bits = v
+	default:
+		t.Fatalf("unexpected enum type %v", kind)
+	}
+
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, bits)
+	return buf[:size]
+}
@@ -0,0 +1,189 @@
+package winmd
+
+import (
+	"fmt"
+
+	"github.com/tdakkota/win32metadata/md"
+	"github.com/tdakkota/win32metadata/types"
+)
+
+// ContractVersion identifies the version of the API contract that introduced a type or a member.
+// https://docs.microsoft.com/en-us/uwp/winrt-cref/winmd-files#versioning
+type ContractVersion struct {
+	// Contract is the full name of the API contract. It is empty for APIs versioned using the
+	// VersionAttribute, that predate API contracts and use a Windows version instead.
+	Contract string
+	// Version is the version of the contract: the major version is stored in the high 16 bits
+	// and the minor version in the low 16 bits.
+	Version uint32
+}
+
+// NewContractVersion returns the version of the given contract.
+func NewContractVersion(contract string, major, minor uint16) ContractVersion {
+	return ContractVersion{Contract: contract, Version: uint32(major)<<16 | uint32(minor)}
+}
+
+// Major returns the major version of the contract.
+func (cv ContractVersion) Major() uint16 {
+	return uint16(cv.Version >> 16)
+}
+
+// Minor returns the minor version of the contract.
+func (cv ContractVersion) Minor() uint16 {
+	return uint16(cv.Version)
+}
+
+func (cv ContractVersion) String() string {
+	if cv.Contract == "" {
+		// Windows versions are stored like NTDDI versions: 0x06030000 is Windows 8.1 (6.3)
+		return fmt.Sprintf("Windows %d.%d", cv.Version>>24, (cv.Version>>16)&0xff)
+	}
+	return fmt.Sprintf("%s v%d.%d", cv.Contract, cv.Major(), cv.Minor())
+}
+
+// ContractVersion returns the contract version of the type, or nil if it is not versioned.
+func (typeDef *TypeDef) ContractVersion() (*ContractVersion, error) {
+	attrs, err := typeDef.GetAttributes(AttributeTypeContractVersionAttribute)
+	if err != nil {
+		return nil, err
+	}
+	versionAttrs, err := typeDef.GetAttributes(AttributeTypeVersionAttribute)
+	if err != nil {
+		return nil, err
+	}
+
+	attr := contractVersionAttribute(append(attrs, versionAttrs...))
+	if attr == nil {
+		return nil, nil
+	}
+	cv, ok := contractVersionFromAttribute(attr)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s in %s.%s", attr.Type, typeDef.TypeNamespace, typeDef.TypeName)
+	}
+	if cv.Contract == "" && attr.Type == AttributeTypeContractVersionAttribute {
+		// API contracts are versioned using an attribute without contract name
+		cv.Contract = typeDef.TypeNamespace + "." + typeDef.TypeName
+	}
+	return cv, nil
+}
+
+// FieldContractVersion returns the contract version of the given field, like an enum value, or nil if it is not versioned.
+func (typeDef *TypeDef) FieldContractVersion(fieldIndex uint32) (*ContractVersion, error) {
+	attrs, err := typeDef.FieldAttributes(fieldIndex)
+	if err != nil {
+		return nil, err
+	}
+	return FindContractVersion(attrs)
+}
+
+// FindContractVersion returns the contract version found in the given attributes, or nil if there is none.
+func FindContractVersion(attrs []*Attribute) (*ContractVersion, error) {
+	attr := contractVersionAttribute(attrs)
+	if attr == nil {
+		return nil, nil
+	}
+	cv, ok := contractVersionFromAttribute(attr)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s", attr.Type)
+	}
+	return cv, nil
+}
+
+// contractVersionAttribute selects the attribute holding the version of an API among the given attributes.
+// The ContractVersionAttribute takes precedence over the VersionAttribute, used by the APIs that predate
+// API contracts. It returns nil if there is none.
+func contractVersionAttribute(attrs []*Attribute) *Attribute {
+	var version *Attribute
+	for _, attr := range attrs {
+		switch attr.Type {
+		case AttributeTypeContractVersionAttribute:
+			return attr
+		case AttributeTypeVersionAttribute:
+			if version == nil {
+				version = attr
+			}
+		}
+	}
+	return version
+}
+
+// AttributeContractVersion returns the contract version referenced by the arguments of attributes like
+// the ActivatableAttribute or the StaticAttribute: [Type,] version, [contract | platform]
+func AttributeContractVersion(attr *Attribute) (*ContractVersion, bool) {
+	var cv ContractVersion
+	found := false
+	for _, arg := range attr.FixedArgs {
+		switch arg.Kind {
+		case types.ELEMENT_TYPE_U4:
+			cv.Version, found = arg.Value.(uint32)
+		case types.ELEMENT_TYPE_STRING:
+			cv.Contract, _ = arg.String()
+		}
+	}
+	if !found {
+		return nil, false
+	}
+	return &cv, true
+}
+
+// contractVersionFromAttribute decodes a ContractVersionAttribute or a VersionAttribute:
+//   - ContractVersionAttribute(uint32 version), used by the contracts themselves.
+//   - ContractVersionAttribute(System.Type contract, uint32 version)
+//   - ContractVersionAttribute(string contract, uint32 version)
+//   - VersionAttribute(uint32 version [, Platform platform])
+func contractVersionFromAttribute(attr *Attribute) (*ContractVersion, bool) {
+	var cv ContractVersion
+	found := false
+	for _, arg := range attr.FixedArgs {
+		switch arg.Kind {
+		case types.ELEMENT_TYPE_U4:
+			cv.Version, found = arg.Value.(uint32)
+		case types.ELEMENT_TYPE_STRING, types.ELEMENT_TYPE_CLASS:
+			if attr.Type == AttributeTypeContractVersionAttribute {
+				cv.Contract, _ = arg.String()
+			}
+		}
+	}
+	if !found {
+		return nil, false
+	}
+	return &cv, true
+}
+
+// FieldAttributes returns the decoded attributes of the given field of the type.
+func (typeDef *TypeDef) FieldAttributes(fieldIndex uint32) ([]*Attribute, error) {
+	return memberAttributes(typeDef.Ctx(), md.Field, fieldIndex, typeDef.enumTypeResolver())
+}
+
+// MethodAttributes returns the decoded attributes of the given method of the type.
+func (typeDef *TypeDef) MethodAttributes(methodIndex uint32) ([]*Attribute, error) {
+	return memberAttributes(typeDef.Ctx(), md.MethodDef, methodIndex, typeDef.enumTypeResolver())
+}
+
+// memberAttributes returns the decoded attributes of the given row of a member table, like Field or MethodDef.
+func memberAttributes(ctx *types.Context, table md.TableType, index uint32, enumType EnumTypeResolver) ([]*Attribute, error) {
+	result := make([]*Attribute, 0)
+	cAttrTable := ctx.Table(md.CustomAttribute)
+	for i := uint32(0); i < cAttrTable.RowCount(); i++ {
+		// check the parent before reading the whole row, most attributes belong to other members
+		parent, err := ctx.Uint64(md.CustomAttribute, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		if t, _ := types.HasCustomAttribute(parent).Table(); t != table || types.HasCustomAttribute(parent).TableIndex() != index {
+			continue
+		}
+
+		cAttr, err := readCustomAttribute(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+
+		attr, err := decodeAttribute(ctx, cAttr, enumType)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, attr)
+	}
+	return result, nil
+}
@@ -0,0 +1,100 @@
+package winmd
+
+import (
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tdakkota/win32metadata/types"
+)
+
+func TestContractVersionString(t *testing.T) {
+	cv := NewContractVersion("Windows.Foundation.UniversalApiContract", 13, 1)
+	assert.Equal(t, uint32(0x000d0001), cv.Version)
+	assert.Equal(t, uint16(13), cv.Major())
+	assert.Equal(t, uint16(1), cv.Minor())
+	assert.Equal(t, "Windows.Foundation.UniversalApiContract v13.1", cv.String())
+
+	// VersionAttribute platform versions
+	assert.Equal(t, "Windows 6.3", ContractVersion{Version: 0x06030000}.String())
+}
+
+func TestTypeDefContractVersion(t *testing.T) {
+	mdStore, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	tests := map[string]ContractVersion{
+		"Windows.Devices.Bluetooth.BluetoothLEDevice":   NewContractVersion("Windows.Foundation.UniversalApiContract", 1, 0),
+		"Windows.Devices.Bluetooth.IBluetoothLEDevice6": NewContractVersion("Windows.Foundation.UniversalApiContract", 13, 0),
+		"Windows.Foundation.IAsyncInfo":                 NewContractVersion("Windows.Foundation.FoundationContract", 1, 0),
+		// contracts are versioned using their own name
+		"Windows.Foundation.UniversalApiContract": NewContractVersion("Windows.Foundation.UniversalApiContract", 15, 0),
+	}
+	for name, expected := range tests {
+		t.Run(name, func(t *testing.T) {
+			typeDef, err := mdStore.TypeDefByName(name)
+			require.NoError(t, err)
+
+			cv, err := typeDef.ContractVersion()
+			require.NoError(t, err)
+			require.NotNil(t, cv)
+			assert.Equal(t, expected, *cv)
+		})
+	}
+}
+
+func TestFindContractVersion(t *testing.T) {
+	version := &Attribute{
+		Type:      AttributeTypeVersionAttribute,
+		FixedArgs: []AttributeArg{{Kind: types.ELEMENT_TYPE_U4, Value: uint32(0x06020000)}},
+	}
+	contract := &Attribute{
+		Type: AttributeTypeContractVersionAttribute,
+		FixedArgs: []AttributeArg{
+			{Kind: types.ELEMENT_TYPE_STRING, Value: "Windows.Foundation.UniversalApiContract"},
+			{Kind: types.ELEMENT_TYPE_U4, Value: uint32(0x00010000)},
+		},
+	}
+	other := &Attribute{Type: "Windows.Foundation.Metadata.MarshalingBehaviorAttribute"}
+
+	// the ContractVersionAttribute is selected regardless of the order of the attributes
+	cv, err := FindContractVersion([]*Attribute{other, version, contract})
+	require.NoError(t, err)
+	require.NotNil(t, cv)
+	assert.Equal(t, NewContractVersion("Windows.Foundation.UniversalApiContract", 1, 0), *cv)
+
+	cv, err = FindContractVersion([]*Attribute{other, version})
+	require.NoError(t, err)
+	require.NotNil(t, cv)
+	assert.Equal(t, ContractVersion{Version: 0x06020000}, *cv)
+
+	cv, err = FindContractVersion([]*Attribute{other})
+	require.NoError(t, err)
+	assert.Nil(t, cv)
+}
+
+func TestTypeDefFieldContractVersion(t *testing.T) {
+	mdStore, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	typeDef, err := mdStore.TypeDefByName("Windows.Devices.Bluetooth.BluetoothError")
+	require.NoError(t, err)
+
+	fields, err := typeDef.ResolveFieldList(typeDef.Ctx())
+	require.NoError(t, err)
+
+	versions := make(map[string]*ContractVersion)
+	for i, f := range fields {
+		cv, err := typeDef.FieldContractVersion(typeDef.FieldList.Start() + uint32(i))
+		require.NoError(t, err)
+		versions[f.Name] = cv
+	}
+
+	// values introduced with the enum are not versioned
+	assert.Nil(t, versions["Success"])
+	require.NotNil(t, versions["DisabledByUser"])
+	assert.Equal(t, NewContractVersion("Windows.Foundation.UniversalApiContract", 2, 0), *versions["DisabledByUser"])
+	require.NotNil(t, versions["TransportNotSupported"])
+	assert.Equal(t, NewContractVersion("Windows.Foundation.UniversalApiContract", 4, 0), *versions["TransportNotSupported"])
+}
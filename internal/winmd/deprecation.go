@@ -0,0 +1,79 @@
+package winmd
+
+import (
+	"fmt"
+)
+
+// Deprecation holds the information of a DeprecatedAttribute.
+// https://docs.microsoft.com/en-us/uwp/api/windows.foundation.metadata.deprecatedattribute
+type Deprecation struct {
+	// Message describes the deprecation, and usually the API to use instead.
+	Message string
+	// Removed is true if the API has been removed, instead of only deprecated.
+	Removed bool
+	// Since is the contract version that deprecated the API.
+	Since ContractVersion
+}
+
+// deprecationTypeRemove is the value of DeprecationType.Remove
+const deprecationTypeRemove = 1
+
+// FindDeprecation returns the deprecation information found in the given attributes, or nil if
+// the element is not deprecated. When an element has been deprecated several times, the first
+// deprecation is returned.
+func FindDeprecation(attrs []*Attribute) (*Deprecation, error) {
+	for _, attr := range attrs {
+		if attr.Type != AttributeTypeDeprecatedAttribute {
+			continue
+		}
+
+		// DeprecatedAttribute(string message, DeprecationType type, uint32 version [, string contract | Platform platform])
+		if len(attr.FixedArgs) < 3 {
+			return nil, fmt.Errorf("invalid %s: expected at least 3 arguments, got %d", attr.Type, len(attr.FixedArgs))
+		}
+		msg, _ := attr.FixedArgs[0].String()
+		removed := false
+		switch v := attr.FixedArgs[1].Value.(type) {
+		case int32:
+			removed = v == deprecationTypeRemove
+		case uint32:
+			removed = v == deprecationTypeRemove
+		}
+
+		var since ContractVersion
+		since.Version, _ = attr.FixedArgs[2].Uint32()
+		if len(attr.FixedArgs) > 3 {
+			since.Contract, _ = attr.FixedArgs[3].String()
+		}
+		return &Deprecation{Message: msg, Removed: removed, Since: since}, nil
+	}
+	return nil, nil
+}
+
+// HasAttribute returns true if any of the given attributes has the given type.
+func HasAttribute(attrs []*Attribute, attrType string) bool {
+	for _, attr := range attrs {
+		if attr.Type == attrType {
+			return true
+		}
+	}
+	return false
+}
+
+// Deprecation returns the deprecation information of the type, or nil if it is not deprecated.
+func (typeDef *TypeDef) Deprecation() (*Deprecation, error) {
+	attrs, err := typeDef.GetAttributes(AttributeTypeDeprecatedAttribute)
+	if err != nil {
+		return nil, err
+	}
+	return FindDeprecation(attrs)
+}
+
+// IsExperimental returns true if the type is marked with the ExperimentalAttribute.
+func (typeDef *TypeDef) IsExperimental() (bool, error) {
+	attrs, err := typeDef.GetAttributes(AttributeTypeExperimentalAttribute)
+	if err != nil {
+		return false, err
+	}
+	return len(attrs) > 0, nil
+}
@@ -0,0 +1,93 @@
+package winmd
+
+import (
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tdakkota/win32metadata/types"
+)
+
+func TestFindDeprecation(t *testing.T) {
+	attrs := []*Attribute{
+		{Type: AttributeTypeContractVersionAttribute},
+		{
+			Type: AttributeTypeDeprecatedAttribute,
+			FixedArgs: []AttributeArg{
+				{Kind: types.ELEMENT_TYPE_STRING, Value: "Use Bar instead."},
+				{Kind: types.ELEMENT_TYPE_VALUETYPE, TypeName: "Windows.Foundation.Metadata.DeprecationType", Value: int32(1)},
+				{Kind: types.ELEMENT_TYPE_U4, Value: uint32(0x00040000)},
+				{Kind: types.ELEMENT_TYPE_STRING, Value: "Windows.Foundation.UniversalApiContract"},
+			},
+		},
+	}
+
+	d, err := FindDeprecation(attrs)
+	require.NoError(t, err)
+	require.NotNil(t, d)
+	assert.Equal(t, Deprecation{
+		Message: "Use Bar instead.",
+		Removed: true,
+		Since:   NewContractVersion("Windows.Foundation.UniversalApiContract", 4, 0),
+	}, *d)
+
+	d, err = FindDeprecation(attrs[:1])
+	require.NoError(t, err)
+	assert.Nil(t, d)
+
+	_, err = FindDeprecation([]*Attribute{{Type: AttributeTypeDeprecatedAttribute}})
+	assert.Error(t, err)
+}
+
+func TestTypeDefDeprecation(t *testing.T) {
+	mdStore, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	typeDef, err := mdStore.TypeDefByName("Windows.Media.MediaControl")
+	require.NoError(t, err)
+	d, err := typeDef.Deprecation()
+	require.NoError(t, err)
+	require.NotNil(t, d)
+	assert.Contains(t, d.Message, "use SystemMediaTransportControls")
+	assert.False(t, d.Removed)
+
+	typeDef, err = mdStore.TypeDefByName("Windows.Devices.Bluetooth.BluetoothLEDevice")
+	require.NoError(t, err)
+	d, err = typeDef.Deprecation()
+	require.NoError(t, err)
+	assert.Nil(t, d)
+	experimental, err := typeDef.IsExperimental()
+	require.NoError(t, err)
+	assert.False(t, experimental)
+
+	typeDef, err = mdStore.TypeDefByName("Windows.Storage.Provider.StorageProviderShareLinkState")
+	require.NoError(t, err)
+	experimental, err = typeDef.IsExperimental()
+	require.NoError(t, err)
+	assert.True(t, experimental)
+}
+
+func TestTypeDefMethodAttributes(t *testing.T) {
+	mdStore, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	typeDef, err := mdStore.TypeDefByName("Windows.Devices.Bluetooth.IBluetoothLEDevice")
+	require.NoError(t, err)
+	methods, err := typeDef.ResolveMethodList(typeDef.Ctx())
+	require.NoError(t, err)
+
+	deprecated := make(map[string]string)
+	for i, m := range methods {
+		attrs, err := typeDef.MethodAttributes(typeDef.MethodList.Start() + uint32(i))
+		require.NoError(t, err)
+		d, err := FindDeprecation(attrs)
+		require.NoError(t, err)
+		if d != nil {
+			deprecated[m.Name] = d.Message
+		}
+	}
+	require.Len(t, deprecated, 2)
+	assert.Contains(t, deprecated["get_GattServices"], "Use GetGattServicesAsync instead of GattServices.")
+	assert.Contains(t, deprecated["GetGattService"], "Use GetGattServicesForUuidAsync instead of GetGattService.")
+}
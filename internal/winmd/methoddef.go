@@ -9,8 +9,8 @@ import (
 func GetMethodOverloadName(ctx *types.Context, methodDef *types.MethodDef) string {
 	cAttrTable := ctx.Table(md.CustomAttribute)
 	for i := uint32(0); i < cAttrTable.RowCount(); i++ {
-		var cAttr types.CustomAttribute
-		if err := cAttr.FromRow(cAttrTable.Row(i)); err != nil {
+		cAttr, err := readCustomAttribute(ctx, i)
+		if err != nil {
 			continue
 		}
 
@@ -33,43 +33,18 @@ func GetMethodOverloadName(ctx *types.Context, methodDef *types.MethodDef) strin
 			continue
 		}
 
-		// - Type: the attribute type must be the given type
-		// the cAttr.Type table can be either a MemberRef or a MethodRef.
-		// Since we are looking for a type, we will only consider the MemberRef.
-		if cAttrTypeTable, _ := cAttr.Type.Table(); cAttrTypeTable != md.MemberRef {
+		attrType, _, err := attributeConstructor(ctx, cAttr)
+		if err != nil || attrType != AttributeTypeOverloadAttribute {
 			continue
 		}
 
-		var attrTypeMemberRef types.MemberRef
-		row, ok = cAttr.Type.Row(ctx)
-		if !ok {
-			continue
-		}
-		if err := attrTypeMemberRef.FromRow(row); err != nil {
+		// [OverloadAttribute(string)]
+		attr, err := decodeAttribute(ctx, cAttr, nil)
+		if err != nil || len(attr.FixedArgs) != 1 {
 			continue
 		}
-
-		// we need to check the MemberRef Class
-		// the value can belong to several tables, but we are only going to check for TypeRef
-		if classTable, _ := attrTypeMemberRef.Class.Table(); classTable != md.TypeRef {
-			continue
-		}
-
-		var attrTypeRef types.TypeRef
-		row, ok = attrTypeMemberRef.Class.Row(ctx)
-		if !ok {
-			continue
-		}
-		if err := attrTypeRef.FromRow(row); err != nil {
-			continue
-		}
-
-		if attrTypeRef.TypeNamespace+"."+attrTypeRef.TypeName == AttributeTypeOverloadAttribute {
-			// Metadata values start with 0x01 0x00 and ends with 0x00 0x00
-			mdVal := cAttr.Value[2 : len(cAttr.Value)-2]
-			// the next value is the length of the string
-			mdVal = mdVal[1:]
-			return string(mdVal)
+		if name, ok := attr.FixedArgs[0].String(); ok {
+			return name
 		}
 	}
 	return methodDef.Name
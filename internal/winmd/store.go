@@ -2,6 +2,8 @@ package winmd
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/go-kit/log"
 	"github.com/tdakkota/win32metadata/md"
@@ -21,6 +23,10 @@ func (e *ClassNotFoundError) Error() string {
 type Store struct {
 	contexts map[string]*types.Context
 	logger   log.Logger
+
+	// enumTypes caches the underlying type of the enums used by custom attributes, by name.
+	enumTypesMu sync.Mutex
+	enumTypes   map[string]types.ElementTypeKind
 }
 
 // NewStore loads all windows metadata files and returns a new Store.
@@ -42,8 +48,9 @@ func NewStore(logger log.Logger) (*Store, error) {
 	}
 
 	return &Store{
-		contexts: contexts,
-		logger:   logger,
+		contexts:  contexts,
+		logger:    logger,
+		enumTypes: make(map[string]types.ElementTypeKind),
 	}, nil
 }
 
@@ -54,7 +61,40 @@ func parseWinMDFile(path string) (*types.Context, error) {
 	}
 	defer func() { _ = f.Close() }()
 
-	return types.FromPE(f)
+	ctx, err := types.FromPE(f)
+	if err != nil {
+		return nil, err
+	}
+	fixConstantTableLayout(ctx)
+	return ctx, nil
+}
+
+// fixConstantTableLayout fixes the size of the Parent column of the Constant table.
+// The parser computes the size of the HasConstant coded index using the TypeDef, TypeRef and Property tables,
+// instead of the Field, Param and Property tables defined in ECMA-335 (II.24.2.6).
+// Large files, like Windows.UI.Xaml.winmd, need 4 byte indexes, so the Constant table and all the tables
+// stored after it would be read from the wrong offset.
+func fixConstantTableLayout(ctx *types.Context) {
+	const tagBits = 2 // Field, Param or Property
+	hasConstantSize := uint32(2)
+	for _, t := range []md.TableType{md.Field, md.Param, md.Property} {
+		if ctx.Tables[t].RowCount >= 1<<(16-tagBits) {
+			hasConstantSize = 4
+		}
+	}
+
+	constant := &ctx.Tables[md.Constant]
+	if constant.Columns[1].Size == hasConstantSize {
+		return
+	}
+	constant.SetRowType([6]uint32{2, hasConstantSize, ctx.BlobIndexSize()})
+
+	// tables are stored one after the other, so the offsets need to be recomputed
+	offset := ctx.Tables[0].Offset
+	for i := range ctx.Tables {
+		ctx.Tables[i].Offset = offset
+		offset += int64(ctx.Tables[i].RowCount * ctx.Tables[i].RowSize)
+	}
 }
 
 // TypeDefByName returns a type definition that matches the given name.
@@ -81,9 +121,74 @@ func (mds *Store) typeDefByNameAndCtx(class string, ctx *types.Context) *TypeDef
 				TypeDef:    typeDef,
 				HasContext: HasContext{ctx},
 				logger:     mds.logger,
+				store:      mds,
 			}
 		}
 	}
 
 	return nil
 }
+
+// TypeDefs returns all the type definitions of the store, sorted by their full name.
+func (mds *Store) TypeDefs() []*TypeDef {
+	files := make([]string, 0, len(mds.contexts))
+	for f := range mds.contexts {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+
+	var typeDefs []*TypeDef
+	for _, f := range files {
+		ctx := mds.contexts[f]
+		typeDefTable := ctx.Table(md.TypeDef)
+		for i := uint32(0); i < typeDefTable.RowCount(); i++ {
+			var typeDef types.TypeDef
+			if err := typeDef.FromRow(typeDefTable.Row(i)); err != nil {
+				continue // keep reading instead of failing
+			}
+			typeDefs = append(typeDefs, &TypeDef{
+				TypeDef:    typeDef,
+				HasContext: HasContext{ctx},
+				logger:     mds.logger,
+				store:      mds,
+			})
+		}
+	}
+
+	sort.SliceStable(typeDefs, func(i, j int) bool {
+		return typeDefs[i].TypeNamespace+"."+typeDefs[i].TypeName < typeDefs[j].TypeNamespace+"."+typeDefs[j].TypeName
+	})
+	return typeDefs
+}
+
+// DecodeAttribute decodes the given custom attribute row of the given context.
+func (mds *Store) DecodeAttribute(ctx *types.Context, cAttr types.CustomAttribute) (*Attribute, error) {
+	return decodeAttribute(ctx, cAttr, mds.enumType)
+}
+
+// enumType returns the underlying type of the given enum. It is used to decode the enum arguments of custom attributes.
+func (mds *Store) enumType(name string) (types.ElementTypeKind, bool) {
+	mds.enumTypesMu.Lock()
+	defer mds.enumTypesMu.Unlock()
+
+	if kind, ok := mds.enumTypes[name]; ok {
+		return kind, true
+	}
+
+	typeDef, err := mds.TypeDefByName(name)
+	if err != nil || !typeDef.IsEnum() {
+		return 0, false
+	}
+	fields, err := typeDef.ResolveFieldList(typeDef.Ctx())
+	if err != nil || len(fields) == 0 {
+		return 0, false
+	}
+	fieldSig, err := fields[0].Signature.Reader().Field(typeDef.Ctx())
+	if err != nil {
+		return 0, false
+	}
+
+	kind := fieldSig.Field.Type.Kind
+	mds.enumTypes[name] = kind
+	return kind, true
+}
@@ -0,0 +1,25 @@
+package winmd
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreTypeDefs(t *testing.T) {
+	mdStore, err := NewStore(log.NewNopLogger())
+	require.NoError(t, err)
+
+	typeDefs := mdStore.TypeDefs()
+	require.NotEmpty(t, typeDefs)
+
+	names := make([]string, 0, len(typeDefs))
+	for _, typeDef := range typeDefs {
+		names = append(names, typeDef.TypeNamespace+"."+typeDef.TypeName)
+	}
+	assert.True(t, sort.StringsAreSorted(names))
+	assert.Contains(t, names, "Windows.Devices.Bluetooth.BluetoothLEDevice")
+}
@@ -2,7 +2,6 @@ package winmd
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
@@ -17,6 +16,8 @@ type TypeDef struct {
 	HasContext
 
 	logger log.Logger
+	// store is used to resolve the types referenced by the custom attributes of the type. It may be nil.
+	store *Store
 }
 
 // QualifiedID holds the namespace and the name of a qualified element. This may be a type, a static function or a field
@@ -25,14 +26,24 @@ type QualifiedID struct {
 	Name      string
 }
 
-// GetValueForEnumField returns the value of the requested enum field.
+// GetValueForEnumField returns the value of the requested enum field as a Go literal.
 func (typeDef *TypeDef) GetValueForEnumField(fieldIndex uint32) (string, error) {
 	// For each Enum value definition, there is a corresponding row in the Constant table to store the integer value for the enum value.
+	constant, err := typeDef.GetConstantForField(fieldIndex)
+	if err != nil {
+		return "", err
+	}
+
+	return ConstantLiteral(*constant)
+}
+
+// GetConstantForField returns the row of the Constant table that holds the value of the given field.
+func (typeDef *TypeDef) GetConstantForField(fieldIndex uint32) (*types.Constant, error) {
 	tableConstants := typeDef.Ctx().Table(md.Constant)
 	for i := uint32(0); i < tableConstants.RowCount(); i++ {
 		var constant types.Constant
 		if err := constant.FromRow(tableConstants.Row(i)); err != nil {
-			return "", err
+			return nil, err
 		}
 
 		if t, _ := constant.Parent.Table(); t != md.Field {
@@ -45,15 +56,10 @@ func (typeDef *TypeDef) GetValueForEnumField(fieldIndex uint32) (string, error)
 			continue
 		}
 
-		// The value is a blob that we need to read as little endian
-		var blobIndex uint32
-		for i, b := range constant.Value {
-			blobIndex += uint32(b) << (i * 8)
-		}
-		return strconv.Itoa(int(blobIndex)), nil
+		return &constant, nil
 	}
 
-	return "", fmt.Errorf("no value found for field %d", fieldIndex)
+	return nil, fmt.Errorf("no value found for field %d", fieldIndex)
 }
 
 // GetAttributeWithType returns the value of the given attribute type and fails if not found.
@@ -75,66 +81,83 @@ func (typeDef *TypeDef) GetAttributeWithType(lookupAttrTypeClass string) ([]byte
 // GetTypeDefAttributesWithType returns the values of all the attributes that match the given type.
 func (typeDef *TypeDef) GetTypeDefAttributesWithType(lookupAttrTypeClass string) [][]byte {
 	result := make([][]byte, 0)
-	cAttrTable := typeDef.Ctx().Table(md.CustomAttribute)
-	for i := uint32(0); i < cAttrTable.RowCount(); i++ {
-		var cAttr types.CustomAttribute
-		if err := cAttr.FromRow(cAttrTable.Row(i)); err != nil {
+	for _, cAttr := range typeDef.customAttributes() {
+		attrType, _, err := attributeConstructor(typeDef.Ctx(), cAttr)
+		if err != nil {
 			continue
 		}
 
-		// - Parent: The owner of the Attribute must be the given typeDef
-		if cAttrParentTable, _ := cAttr.Parent.Table(); cAttrParentTable != md.TypeDef {
-			continue
+		if attrType == lookupAttrTypeClass {
+			result = append(result, cAttr.Value)
 		}
+	}
 
-		var parentTypeDef TypeDef
-		row, ok := cAttr.Parent.Row(typeDef.Ctx())
-		if !ok {
-			continue
+	return result
+}
+
+// GetAttributes returns the decoded attributes of the type that match the given attribute type.
+func (typeDef *TypeDef) GetAttributes(lookupAttrTypeClass string) ([]*Attribute, error) {
+	enumType := typeDef.enumTypeResolver()
+
+	result := make([]*Attribute, 0)
+	for _, cAttr := range typeDef.customAttributes() {
+		attrType, _, err := attributeConstructor(typeDef.Ctx(), cAttr)
+		if err != nil {
+			return nil, err
 		}
-		if err := parentTypeDef.FromRow(row); err != nil {
+		if attrType != lookupAttrTypeClass {
 			continue
 		}
 
-		// does the blob belong to the type we're looking for?
-		if parentTypeDef.TypeNamespace != typeDef.TypeNamespace || parentTypeDef.TypeName != typeDef.TypeName {
-			continue
+		attr, err := decodeAttribute(typeDef.Ctx(), cAttr, enumType)
+		if err != nil {
+			return nil, err
 		}
+		result = append(result, attr)
+	}
 
-		// - Type: the attribute type must be the given type
-		// the cAttr.Type table can be either a MemberRef or a MethodRef.
-		// Since we are looking for a type, we will only consider the MemberRef.
-		if cAttrTypeTable, _ := cAttr.Type.Table(); cAttrTypeTable != md.MemberRef {
-			continue
-		}
+	return result, nil
+}
 
-		var attrTypeMemberRef types.MemberRef
-		row, ok = cAttr.Type.Row(typeDef.Ctx())
-		if !ok {
-			continue
-		}
-		if err := attrTypeMemberRef.FromRow(row); err != nil {
+// enumTypeResolver returns the function used to resolve the enums referenced by the attributes of the type.
+func (typeDef *TypeDef) enumTypeResolver() EnumTypeResolver {
+	// without a store, enums will be decoded using their default type
+	if typeDef.store == nil {
+		return nil
+	}
+	return typeDef.store.enumType
+}
+
+// customAttributes returns the rows of the CustomAttribute table owned by the type.
+func (typeDef *TypeDef) customAttributes() []types.CustomAttribute {
+	result := make([]types.CustomAttribute, 0)
+	cAttrTable := typeDef.Ctx().Table(md.CustomAttribute)
+	for i := uint32(0); i < cAttrTable.RowCount(); i++ {
+		cAttr, err := readCustomAttribute(typeDef.Ctx(), i)
+		if err != nil {
 			continue
 		}
 
-		// we need to check the MemberRef Class
-		// the value can belong to several tables, but we are only going to check for TypeRef
-		if classTable, _ := attrTypeMemberRef.Class.Table(); classTable != md.TypeRef {
+		// - Parent: The owner of the Attribute must be the given typeDef
+		if cAttrParentTable, _ := cAttr.Parent.Table(); cAttrParentTable != md.TypeDef {
 			continue
 		}
 
-		var attrTypeRef types.TypeRef
-		row, ok = attrTypeMemberRef.Class.Row(typeDef.Ctx())
+		var parentTypeDef TypeDef
+		row, ok := cAttr.Parent.Row(typeDef.Ctx())
 		if !ok {
 			continue
 		}
-		if err := attrTypeRef.FromRow(row); err != nil {
+		if err := parentTypeDef.FromRow(row); err != nil {
 			continue
 		}
 
-		if attrTypeRef.TypeNamespace+"."+attrTypeRef.TypeName == lookupAttrTypeClass {
-			result = append(result, cAttr.Value)
+		// does the blob belong to the type we're looking for?
+		if parentTypeDef.TypeNamespace != typeDef.TypeNamespace || parentTypeDef.TypeName != typeDef.TypeName {
+			continue
 		}
+
+		result = append(result, cAttr)
 	}
 
 	return result
@@ -239,6 +262,11 @@ func (typeDef *TypeDef) IsEnum() bool {
 	return ok
 }
 
+// IsFlags returns true if the type is an enum whose values are bit flags
+func (typeDef *TypeDef) IsFlags() bool {
+	return typeDef.IsEnum() && len(typeDef.GetTypeDefAttributesWithType(AttributeTypeFlagsAttribute)) > 0
+}
+
 // IsDelegate returns true if the type is a delegate
 func (typeDef *TypeDef) IsDelegate() bool {
 	if !(typeDef.Flags.Public() && typeDef.Flags.Sealed()) {
@@ -12,12 +12,19 @@ import (
 
 // Custom Attributes
 const (
-	AttributeTypeGUID                 = "Windows.Foundation.Metadata.GuidAttribute"
-	AttributeTypeExclusiveTo          = "Windows.Foundation.Metadata.ExclusiveToAttribute"
-	AttributeTypeStaticAttribute      = "Windows.Foundation.Metadata.StaticAttribute"
-	AttributeTypeActivatableAttribute = "Windows.Foundation.Metadata.ActivatableAttribute"
-	AttributeTypeDefaultAttribute     = "Windows.Foundation.Metadata.DefaultAttribute"
-	AttributeTypeOverloadAttribute    = "Windows.Foundation.Metadata.OverloadAttribute"
+	AttributeTypeGUID                     = "Windows.Foundation.Metadata.GuidAttribute"
+	AttributeTypeExclusiveTo              = "Windows.Foundation.Metadata.ExclusiveToAttribute"
+	AttributeTypeStaticAttribute          = "Windows.Foundation.Metadata.StaticAttribute"
+	AttributeTypeActivatableAttribute     = "Windows.Foundation.Metadata.ActivatableAttribute"
+	AttributeTypeDefaultAttribute         = "Windows.Foundation.Metadata.DefaultAttribute"
+	AttributeTypeOverloadAttribute        = "Windows.Foundation.Metadata.OverloadAttribute"
+	AttributeTypeDefaultOverloadAttribute = "Windows.Foundation.Metadata.DefaultOverloadAttribute"
+	AttributeTypeFlagsAttribute           = "System.FlagsAttribute"
+
+	AttributeTypeContractVersionAttribute = "Windows.Foundation.Metadata.ContractVersionAttribute"
+	AttributeTypeVersionAttribute         = "Windows.Foundation.Metadata.VersionAttribute"
+	AttributeTypeDeprecatedAttribute      = "Windows.Foundation.Metadata.DeprecatedAttribute"
+	AttributeTypeExperimentalAttribute    = "Windows.Foundation.Metadata.ExperimentalAttribute"
 )
 
 // HasContext is a helper struct that holds the original context of a metadata element.
@@ -0,0 +1,27 @@
+package winrt
+
+import (
+	"errors"
+
+	"github.com/go-ole/go-ole"
+)
+
+// QueryInterface returns the interface with the given IID implemented by the object, which must be released
+// by the caller. The name of the interface is used to report errors: objects that do not implement the
+// interface, like the objects of a Windows build that predates it, return an *Error matching ErrNoInterface.
+func QueryInterface(obj *ole.IUnknown, iid *ole.GUID, iface string) (*ole.IUnknown, error) {
+	itf, err := obj.QueryInterface(iid)
+	if err != nil {
+		return nil, queryInterfaceError(err, iface)
+	}
+	return &itf.IUnknown, nil
+}
+
+// queryInterfaceError returns the error reported when querying the given interface fails.
+func queryInterfaceError(err error, iface string) error {
+	var oleErr *ole.OleError
+	if !errors.As(err, &oleErr) {
+		return err
+	}
+	return &Error{HResult: HResult(oleErr.Code()), Interface: iface, Method: "QueryInterface"}
+}
@@ -0,0 +1,25 @@
+package winrt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryInterfaceError(t *testing.T) {
+	err := queryInterfaceError(ole.NewError(ole.E_NOINTERFACE), "Windows.Devices.Bluetooth.IBluetoothLEDevice6")
+	assert.True(t, errors.Is(err, ErrNoInterface))
+	assert.EqualError(t, err, "winrt: Windows.Devices.Bluetooth.IBluetoothLEDevice6.QueryInterface: E_NOINTERFACE (0x80004002): no such interface supported")
+
+	var winrtErr *Error
+	require.True(t, errors.As(err, &winrtErr))
+	assert.Equal(t, "Windows.Devices.Bluetooth.IBluetoothLEDevice6", winrtErr.Interface)
+	assert.Equal(t, "QueryInterface", winrtErr.Method)
+
+	// errors that do not carry an HRESULT are returned as is
+	other := errors.New("other error")
+	assert.Equal(t, other, queryInterfaceError(other, "Windows.Foundation.IClosable"))
+}
@@ -0,0 +1,161 @@
+//go:build windows
+
+package winrt
+
+import (
+	"errors"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeObject is a COM object that implements any interface, and counts the calls to its IUnknown methods.
+// Its vtable also includes a property getter, that returns a constant value.
+type fakeObject struct {
+	ole.IUnknown
+	queries  int64
+	addRefs  int64
+	releases int64
+}
+
+// fakeVtbl is the vtable of the fake objects.
+type fakeVtbl struct {
+	ole.IUnknownVtbl
+	GetValue uintptr
+}
+
+// fakeObjectVtbl is shared by all the fake objects, the number of callbacks of a process is limited.
+var fakeObjectVtbl = &fakeVtbl{
+	IUnknownVtbl: ole.IUnknownVtbl{
+		QueryInterface: syscall.NewCallback(func(this *fakeObject, _ *ole.GUID, out **fakeObject) uintptr {
+			atomic.AddInt64(&this.queries, 1)
+			*out = this
+			return ole.S_OK
+		}),
+		AddRef: syscall.NewCallback(func(this *fakeObject) uintptr {
+			atomic.AddInt64(&this.addRefs, 1)
+			return 1
+		}),
+		Release: syscall.NewCallback(func(this *fakeObject) uintptr {
+			atomic.AddInt64(&this.releases, 1)
+			return 1
+		}),
+	},
+	GetValue: syscall.NewCallback(func(this *fakeObject, out *int32) uintptr {
+		*out = 42
+		return ole.S_OK
+	}),
+}
+
+func newFakeObject() *fakeObject {
+	obj := &fakeObject{}
+	obj.RawVTable = (*interface{})(unsafe.Pointer(fakeObjectVtbl))
+	return obj
+}
+
+// calls returns the number of calls to the IUnknown methods of the object.
+func (obj *fakeObject) calls() int64 {
+	return atomic.LoadInt64(&obj.queries) + atomic.LoadInt64(&obj.addRefs) + atomic.LoadInt64(&obj.releases)
+}
+
+func TestHandleQueryInterface(t *testing.T) {
+	obj := newFakeObject()
+	iid := ole.NewGUID(guidIAsyncInfo)
+
+	h := NewHandle(&obj.IUnknown)
+	for i := 0; i < 10; i++ {
+		itf, err := h.QueryInterface(iid, asyncInfoName)
+		require.NoError(t, err)
+		assert.Equal(t, unsafe.Pointer(obj), unsafe.Pointer(itf))
+	}
+	// the object is only queried once, and the cached interface is borrowed by the callers
+	assert.EqualValues(t, 1, obj.queries)
+	assert.Zero(t, obj.addRefs)
+	assert.Zero(t, obj.releases)
+
+	// both the cached interface and the object are released
+	require.NoError(t, h.Close())
+	assert.EqualValues(t, 2, obj.releases)
+
+	// the interfaces are not cached once the handle is closed
+	_, err := h.QueryInterface(iid, asyncInfoName)
+	assert.True(t, errors.Is(err, ErrClosed))
+	assert.EqualValues(t, 1, obj.queries)
+}
+
+func BenchmarkQueryInterface(b *testing.B) {
+	iid := ole.NewGUID(guidIAsyncInfo)
+
+	b.Run("Uncached", func(b *testing.B) {
+		obj := newFakeObject()
+		for i := 0; i < b.N; i++ {
+			itf, err := QueryInterface(&obj.IUnknown, iid, asyncInfoName)
+			if err != nil {
+				b.Fatal(err)
+			}
+			itf.Release()
+		}
+		b.ReportMetric(float64(obj.calls())/float64(b.N), "calls/op")
+	})
+
+	b.Run("Cached", func(b *testing.B) {
+		obj := newFakeObject()
+		h := NewHandle(&obj.IUnknown)
+		for i := 0; i < b.N; i++ {
+			if _, err := h.QueryInterface(iid, asyncInfoName); err != nil {
+				b.Fatal(err)
+			}
+		}
+		_ = h.Close()
+		b.ReportMetric(float64(obj.calls())/float64(b.N), "calls/op")
+	})
+}
+
+// getValue calls the property getter of the fake object like the generated class methods, querying the interface
+// that declares it.
+func getValue(obj *fakeObject, iid *ole.GUID) (int32, error) {
+	itf, err := QueryInterface(&obj.IUnknown, iid, asyncInfoName)
+	if err != nil {
+		return 0, err
+	}
+	defer itf.Release()
+
+	var value int32
+	hr, _, _ := syscall.SyscallN(
+		(*fakeVtbl)(unsafe.Pointer(itf.RawVTable)).GetValue,
+		uintptr(unsafe.Pointer(itf)),    // this
+		uintptr(unsafe.Pointer(&value)), // out int32
+	)
+	if hr != 0 {
+		return 0, NewError(hr, asyncInfoName, "get_Value")
+	}
+	return value, nil
+}
+
+func BenchmarkPropertyGetter(b *testing.B) {
+	obj := newFakeObject()
+
+	// the IID is parsed on every call
+	b.Run("NewGUID", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := getValue(obj, ole.NewGUID(guidIAsyncInfo)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	// the IID is parsed once, like the IID variables of the generated code
+	b.Run("IID", func(b *testing.B) {
+		iid := *ole.NewGUID(guidIAsyncInfo)
+		for i := 0; i < b.N; i++ {
+			if _, err := getValue(obj, &iid); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
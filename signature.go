@@ -4,7 +4,10 @@ import (
 	"crypto/sha1" // #nosec this is not used for security purposes
 	"encoding/binary"
 	"fmt"
+	"reflect"
 	"strings"
+	"sync"
+	"unsafe"
 
 	"github.com/go-ole/go-ole"
 )
@@ -27,6 +30,121 @@ const (
 	SignatureGUID    = "g16"
 )
 
+// SignatureObject is the signature of System.Object, which is projected as IInspectable.
+const SignatureObject = "cinterface(IInspectable)"
+
+// Signer is implemented by all the generated types. It returns the WinRT signature of the type,
+// which is required to compute the IID of the parameterized types instantiated with it.
+type Signer interface {
+	Signature() string
+}
+
+var signerType = reflect.TypeOf((*Signer)(nil)).Elem()
+
+// SignatureOf returns the WinRT signature of the given type. T must be a primitive type, a string, a GUID,
+// an unsafe.Pointer (System.Object) or a type implementing the Signer interface, it panics otherwise.
+func SignatureOf[T any]() string {
+	var zero T
+	switch v := any(zero).(type) {
+	case uint8:
+		return SignatureUInt8
+	case uint16:
+		return SignatureUInt16
+	case uint32:
+		return SignatureUInt32
+	case uint64:
+		return SignatureUInt64
+	case int8:
+		return SignatureInt8
+	case int16:
+		return SignatureInt16
+	case int32:
+		return SignatureInt32
+	case int64:
+		return SignatureInt64
+	case float32:
+		return SignatureFloat32
+	case float64:
+		return SignatureFloat64
+	case bool:
+		return SignatureBool
+	case string:
+		return SignatureString
+	case ole.GUID:
+		return SignatureGUID
+	case unsafe.Pointer, *ole.IInspectable:
+		return SignatureObject
+	case Signer:
+		return v.Signature()
+	}
+	if isSyscallGUID(reflect.TypeOf(zero)) {
+		return SignatureGUID
+	}
+	panic(fmt.Sprintf("winrt: type %T does not have a WinRT signature", zero))
+}
+
+// FromOpaque converts a value of an opaque stand-in type, named Opaque<TypeName> by the generator, to the actual
+// type T it stands in for. The generated code uses the stand-ins when the package that declares the actual type can
+// not be imported without introducing an import cycle. Both types must share the same signature and size, so the
+// value is known to be of the actual type.
+func FromOpaque[T, O any](v O) (T, error) {
+	var result T
+	if sig, opaqueSig := SignatureOf[T](), SignatureOf[O](); sig != opaqueSig {
+		return result, fmt.Errorf("winrt: %T (%s) does not stand in for %T (%s)", v, opaqueSig, result, sig)
+	}
+	if unsafe.Sizeof(result) != unsafe.Sizeof(v) {
+		return result, fmt.Errorf("winrt: %T and %T do not have the same size", v, result)
+	}
+	return *(*T)(unsafe.Pointer(&v)), nil
+}
+
+// isSyscallGUID returns true if the given type is syscall.GUID, which is used to project System.Guid.
+// It is only defined on Windows, so we can't reference it directly.
+func isSyscallGUID(t reflect.Type) bool {
+	return t != nil && t.PkgPath() == "syscall" && t.Name() == "GUID"
+}
+
+// The following functions build the signatures of the WinRT types, as defined in
+// https://docs.microsoft.com/en-us/uwp/winrt-cref/winrt-type-system#guid-generation-for-parameterized-types
+// They are shared by the code generator and the runtime IID computation.
+
+// InterfaceSignature returns the signature of a non-parameterized interface.
+func InterfaceSignature(guid string) string {
+	// interface_signature => guid
+	return fmt.Sprintf("{%s}", guid)
+}
+
+// DelegateSignature returns the signature of a non-parameterized delegate.
+func DelegateSignature(guid string) string {
+	// delegate_signature => "delegate(" guid ")"
+	return fmt.Sprintf("delegate({%s})", guid)
+}
+
+// EnumSignature returns the signature of an enum, given the signature of its underlying integer type.
+func EnumSignature(name, underlyingTypeSignature string) string {
+	// enum_signature => "enum(" enum_name ";" enum_underlying_type ")"
+	return fmt.Sprintf("enum(%s;%s)", name, underlyingTypeSignature)
+}
+
+// StructSignature returns the signature of a struct, given the signatures of its fields.
+func StructSignature(name string, fieldSignatures ...string) string {
+	// struct_signature => "struct(" struct_name ";" args ")"
+	return fmt.Sprintf("struct(%s;%s)", name, strings.Join(fieldSignatures, ";"))
+}
+
+// RuntimeClassSignature returns the signature of a runtime class, given the signature of its default interface.
+func RuntimeClassSignature(name, defaultInterfaceSignature string) string {
+	// runtime_class_signature => "rc(" runtime_class_name ";" default_interface ")"
+	return fmt.Sprintf("rc(%s;%s)", name, defaultInterfaceSignature)
+}
+
+// ParameterizedSignature returns the signature of an instance of a "generic" WinRT delegate or interface.
+func ParameterizedSignature(baseGUID string, signatures ...string) string {
+	// pinterface_instance_signature => "pinterface(" piid_guid ";" args ")"
+	// pdelegate_instance_signature => "pinterface(" piid_guid ";" args ")"
+	return fmt.Sprintf("pinterface({%s};%s)", baseGUID, strings.Join(signatures, ";"))
+}
+
 // ParameterizedInstanceGUID creates a `GUID` for a "generic" WinRT delegate or interface. This was ported from the RUST implementation
 // of WinRT, checkout for the source code:
 // https://github.com/microsoft/windows-rs/blob/68576f37df4c02f09bc6e4dd1ed8ed8844c6eb9c/crates/libs/windows/src/core/guid.rs#L44
@@ -34,8 +152,52 @@ const (
 // Checkout the following link for documentation on how the signatures are generated:
 // https://docs.microsoft.com/en-us/uwp/winrt-cref/winrt-type-system#guid-generation-for-parameterized-types
 func ParameterizedInstanceGUID(baseGUID string, signatures ...string) string {
-	res := fmt.Sprintf("pinterface({%s};%s)", baseGUID, strings.Join(signatures, ";"))
-	return guidFromSignature(res)
+	return guidFromSignature(ParameterizedSignature(baseGUID, signatures...))
+}
+
+var iids sync.Map // signature => *ole.GUID
+
+// IIDOf returns the IID of the given interface, delegate or runtime class type. The IID is computed from
+// the signature of T, so this also works for instances of "generic" types. Results are cached, so it is
+// cheap to call this function several times for the same type.
+func IIDOf[T any]() *ole.GUID {
+	sig := SignatureOf[T]()
+	if iid, ok := iids.Load(sig); ok {
+		return iid.(*ole.GUID)
+	}
+
+	iid := ole.NewGUID(IIDFromSignature(sig))
+	if iid == nil {
+		panic(fmt.Sprintf("winrt: type %T does not have an IID, its signature is %s", *new(T), sig))
+	}
+	iids.Store(sig, iid)
+	return iid
+}
+
+// IIDFromSignature returns the IID of the interface, delegate or runtime class with the given signature.
+// It returns an empty string if the signature does not belong to one of those types.
+func IIDFromSignature(sig string) string {
+	switch {
+	case strings.HasPrefix(sig, "{"):
+		// interface_signature => guid
+		return sig
+	case strings.HasPrefix(sig, "delegate("):
+		// delegate_signature => "delegate(" guid ")"
+		return strings.TrimSuffix(strings.TrimPrefix(sig, "delegate("), ")")
+	case strings.HasPrefix(sig, "rc("):
+		// runtime_class_signature => "rc(" runtime_class_name ";" default_interface ")"
+		// The IID of a runtime class is the IID of its default interface.
+		inner := strings.TrimSuffix(strings.TrimPrefix(sig, "rc("), ")")
+		if i := strings.Index(inner, ";"); i >= 0 {
+			return IIDFromSignature(inner[i+1:])
+		}
+		return ""
+	case strings.HasPrefix(sig, "pinterface("):
+		return guidFromSignature(sig)
+	case sig == SignatureObject:
+		return ole.IID_IInspectable.String()
+	}
+	return ""
 }
 
 func guidFromSignature(signature string) string {
@@ -2,8 +2,11 @@ package winrt
 
 import (
 	"testing"
+	"unsafe"
 
+	"github.com/go-ole/go-ole"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Test that we can create a `GUID` for a "generic" WinRT type.
@@ -29,3 +32,75 @@ func TestParametrizedStoppedEvent(t *testing.T) {
 
 	assert.Equal(t, expected, guid)
 }
+
+type testEnum int32
+
+func (testEnum) Signature() string { return "enum(Test.Enum;i4)" }
+
+type testVector[T any] struct{}
+
+func (*testVector[T]) Signature() string {
+	return ParameterizedSignature("913337e9-11a1-4345-a3a2-4e7f956e222d", SignatureOf[T]())
+}
+
+type testClass struct{}
+
+func (*testClass) Signature() string { return signatureBluetoothLEAdvertisementWatcher }
+
+func TestSignatureOf(t *testing.T) {
+	assert.Equal(t, SignatureInt32, SignatureOf[int32]())
+	assert.Equal(t, SignatureBool, SignatureOf[bool]())
+	assert.Equal(t, SignatureString, SignatureOf[string]())
+	assert.Equal(t, SignatureObject, SignatureOf[unsafe.Pointer]())
+	assert.Equal(t, "enum(Test.Enum;i4)", SignatureOf[testEnum]())
+	assert.Equal(t, "pinterface({913337e9-11a1-4345-a3a2-4e7f956e222d};string)", SignatureOf[*testVector[string]]())
+	assert.Panics(t, func() { SignatureOf[int]() })
+}
+
+func TestIIDOf(t *testing.T) {
+	// IVector<String>
+	assert.Equal(t, "{98B9ACC1-4B56-532E-AC73-03D5291CCA90}", IIDOf[*testVector[string]]().String())
+	// runtime classes use the IID of their default interface
+	assert.Equal(t, "{A6AC336F-F3D3-4297-8D6C-C81EA6623F40}", IIDOf[*testClass]().String())
+	assert.Same(t, IIDOf[*testVector[string]](), IIDOf[*testVector[string]]())
+	assert.Panics(t, func() { IIDOf[testEnum]() })
+}
+
+func TestIIDFromSignature(t *testing.T) {
+	assert.Equal(t, "{9de1c534-6ae1-11e0-84e1-18a905bcc53f}", IIDFromSignature(DelegateSignature(guidTypedEventHandler)))
+	assert.Equal(t, "{a6ac336f-f3d3-4297-8d6c-c81ea6623f40}", IIDFromSignature(signatureBluetoothLEAdvertisementWatcher))
+	assert.Equal(t, "{90EB4ECA-D465-5EA0-A61C-033C8C5ECEF2}", IIDFromSignature(ParameterizedSignature(guidTypedEventHandler, signatureBluetoothLEAdvertisementWatcher, signatureBluetoothLEAdvertisementReceivedEventArgs)))
+	assert.Equal(t, ole.IID_IInspectable.String(), IIDFromSignature(SignatureObject))
+	// enums and structs do not have an IID
+	assert.Empty(t, IIDFromSignature(EnumSignature("Test.Enum", SignatureInt32)))
+	assert.Empty(t, IIDFromSignature(StructSignature("Test.Struct", SignatureInt32, SignatureString)))
+}
+
+type testOpaqueClass struct{}
+
+func (*testOpaqueClass) Signature() string { return signatureBluetoothLEAdvertisementWatcher }
+
+type testOpaqueEnum int32
+
+func (testOpaqueEnum) Signature() string { return "enum(Test.Enum;i4)" }
+
+func TestFromOpaque(t *testing.T) {
+	opaque := &testOpaqueClass{}
+	class, err := FromOpaque[*testClass](opaque)
+	require.NoError(t, err)
+	assert.Equal(t, unsafe.Pointer(opaque), unsafe.Pointer(class))
+
+	class, err = FromOpaque[*testClass]((*testOpaqueClass)(nil))
+	require.NoError(t, err)
+	assert.Nil(t, class)
+
+	enum, err := FromOpaque[testEnum](testOpaqueEnum(3))
+	require.NoError(t, err)
+	assert.Equal(t, testEnum(3), enum)
+
+	// the types must share the same signature
+	_, err = FromOpaque[*testVector[int32]](opaque)
+	assert.Error(t, err)
+	_, err = FromOpaque[testEnum](int32(3))
+	assert.Error(t, err)
+}
@@ -6,21 +6,47 @@
 package advertisement
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
-	"github.com/saltosystems/winrt-go/windows/foundation/collections"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEAdvertisement string = "rc(Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisement;{066fb2b7-33d1-4e7d-8367-cf81d0f79653})"
 
+const ContractNameBluetoothLEAdvertisement string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEAdvertisement uint32 = 0x00010000
+
+// BluetoothLEAdvertisement was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothLEAdvertisement struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEAdvertisement) Signature() string {
+	return SignatureBluetoothLEAdvertisement
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisement class is available in the running version of Windows.
+func (impl *BluetoothLEAdvertisement) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisement")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEAdvertisement, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEAdvertisement) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetLocalName", "SetLocalName", "GetServiceUuids", "GetManufacturerData", "GetDataSections":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEAdvertisement", name)
+}
+
 func NewBluetoothLEAdvertisement() (*BluetoothLEAdvertisement, error) {
-	inspectable, err := ole.RoActivateInstance("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisement")
+	inspectable, err := winrt.ActivateInstance("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisement")
 	if err != nil {
 		return nil, err
 	}
@@ -28,35 +54,50 @@ func NewBluetoothLEAdvertisement() (*BluetoothLEAdvertisement, error) {
 }
 
 func (impl *BluetoothLEAdvertisement) GetLocalName() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisement))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisement, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement")
+	if err != nil {
+		return "", err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisement)(unsafe.Pointer(itf))
 	return v.GetLocalName()
 }
 
 func (impl *BluetoothLEAdvertisement) SetLocalName(value string) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisement))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisement, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisement)(unsafe.Pointer(itf))
 	return v.SetLocalName(value)
 }
 
-func (impl *BluetoothLEAdvertisement) GetServiceUuids() (*collections.IVector, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisement))
+func (impl *BluetoothLEAdvertisement) GetServiceUuids() (*IVectorGuid, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisement, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisement)(unsafe.Pointer(itf))
 	return v.GetServiceUuids()
 }
 
-func (impl *BluetoothLEAdvertisement) GetManufacturerData() (*collections.IVector, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisement))
+func (impl *BluetoothLEAdvertisement) GetManufacturerData() (*IVectorBluetoothLEManufacturerData, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisement, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisement)(unsafe.Pointer(itf))
 	return v.GetManufacturerData()
 }
 
-func (impl *BluetoothLEAdvertisement) GetDataSections() (*collections.IVector, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisement))
+func (impl *BluetoothLEAdvertisement) GetDataSections() (*IVectorBluetoothLEAdvertisementDataSection, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisement, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisement)(unsafe.Pointer(itf))
 	return v.GetDataSections()
@@ -65,10 +106,20 @@ func (impl *BluetoothLEAdvertisement) GetDataSections() (*collections.IVector, e
 const GUIDiBluetoothLEAdvertisement string = "066fb2b7-33d1-4e7d-8367-cf81d0f79653"
 const SignatureiBluetoothLEAdvertisement string = "{066fb2b7-33d1-4e7d-8367-cf81d0f79653}"
 
+var IIDiBluetoothLEAdvertisement = ole.GUID{Data1: 0x066FB2B7, Data2: 0x33D1, Data3: 0x4E7D, Data4: [8]byte{0x83, 0x67, 0xCF, 0x81, 0xD0, 0xF7, 0x96, 0x53}}
+
+const ContractNameiBluetoothLEAdvertisement string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEAdvertisement uint32 = 0x00010000
+
+// iBluetoothLEAdvertisement was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iBluetoothLEAdvertisement struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEAdvertisement) Signature() string {
+	return SignatureiBluetoothLEAdvertisement
+}
+
 type iBluetoothLEAdvertisementVtbl struct {
 	ole.IInspectableVtbl
 
@@ -89,6 +140,10 @@ func (v *iBluetoothLEAdvertisement) VTable() *iBluetoothLEAdvertisementVtbl {
 
 func (v *iBluetoothLEAdvertisement) GetLocalName() (string, error) {
 	var outHStr ole.HString
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetLocalName,
 		uintptr(unsafe.Pointer(v)),        // this
@@ -96,7 +151,7 @@ func (v *iBluetoothLEAdvertisement) GetLocalName() (string, error) {
 	)
 
 	if hr != 0 {
-		return "", ole.NewError(hr)
+		return "", winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement", "get_LocalName")
 	}
 
 	out := outHStr.String()
@@ -109,6 +164,10 @@ func (v *iBluetoothLEAdvertisement) SetLocalName(value string) error {
 	if err != nil {
 		return err
 	}
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetLocalName,
 		uintptr(unsafe.Pointer(v)), // this
@@ -116,52 +175,64 @@ func (v *iBluetoothLEAdvertisement) SetLocalName(value string) error {
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement", "put_LocalName")
 	}
 
 	return nil
 }
 
-func (v *iBluetoothLEAdvertisement) GetServiceUuids() (*collections.IVector, error) {
-	var out *collections.IVector
+func (v *iBluetoothLEAdvertisement) GetServiceUuids() (*IVectorGuid, error) {
+	var out *IVectorGuid
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetServiceUuids,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVector
+		uintptr(unsafe.Pointer(&out)), // out IVectorGuid
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement", "get_ServiceUuids")
 	}
 
 	return out, nil
 }
 
-func (v *iBluetoothLEAdvertisement) GetManufacturerData() (*collections.IVector, error) {
-	var out *collections.IVector
+func (v *iBluetoothLEAdvertisement) GetManufacturerData() (*IVectorBluetoothLEManufacturerData, error) {
+	var out *IVectorBluetoothLEManufacturerData
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetManufacturerData,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVector
+		uintptr(unsafe.Pointer(&out)), // out IVectorBluetoothLEManufacturerData
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement", "get_ManufacturerData")
 	}
 
 	return out, nil
 }
 
-func (v *iBluetoothLEAdvertisement) GetDataSections() (*collections.IVector, error) {
-	var out *collections.IVector
+func (v *iBluetoothLEAdvertisement) GetDataSections() (*IVectorBluetoothLEAdvertisementDataSection, error) {
+	var out *IVectorBluetoothLEAdvertisementDataSection
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetDataSections,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVector
+		uintptr(unsafe.Pointer(&out)), // out IVectorBluetoothLEAdvertisementDataSection
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisement", "get_DataSections")
 	}
 
 	return out, nil
@@ -6,20 +6,47 @@
 package advertisement
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEAdvertisementDataSection string = "rc(Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementDataSection;{d7213314-3a43-40f9-b6f0-92bfefc34ae3})"
 
+const ContractNameBluetoothLEAdvertisementDataSection string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEAdvertisementDataSection uint32 = 0x00010000
+
+// BluetoothLEAdvertisementDataSection was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothLEAdvertisementDataSection struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEAdvertisementDataSection) Signature() string {
+	return SignatureBluetoothLEAdvertisementDataSection
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementDataSection class is available in the running version of Windows.
+func (impl *BluetoothLEAdvertisementDataSection) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementDataSection")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEAdvertisementDataSection, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEAdvertisementDataSection) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetDataType":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementDataSection")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEAdvertisementDataSection", name)
+}
+
 func NewBluetoothLEAdvertisementDataSection() (*BluetoothLEAdvertisementDataSection, error) {
-	inspectable, err := ole.RoActivateInstance("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementDataSection")
+	inspectable, err := winrt.ActivateInstance("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementDataSection")
 	if err != nil {
 		return nil, err
 	}
@@ -27,7 +54,10 @@ func NewBluetoothLEAdvertisementDataSection() (*BluetoothLEAdvertisementDataSect
 }
 
 func (impl *BluetoothLEAdvertisementDataSection) GetDataType() (uint8, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementDataSection))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementDataSection, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementDataSection")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementDataSection)(unsafe.Pointer(itf))
 	return v.GetDataType()
@@ -36,10 +66,20 @@ func (impl *BluetoothLEAdvertisementDataSection) GetDataType() (uint8, error) {
 const GUIDiBluetoothLEAdvertisementDataSection string = "d7213314-3a43-40f9-b6f0-92bfefc34ae3"
 const SignatureiBluetoothLEAdvertisementDataSection string = "{d7213314-3a43-40f9-b6f0-92bfefc34ae3}"
 
+var IIDiBluetoothLEAdvertisementDataSection = ole.GUID{Data1: 0xD7213314, Data2: 0x3A43, Data3: 0x40F9, Data4: [8]byte{0xB6, 0xF0, 0x92, 0xBF, 0xEF, 0xC3, 0x4A, 0xE3}}
+
+const ContractNameiBluetoothLEAdvertisementDataSection string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEAdvertisementDataSection uint32 = 0x00010000
+
+// iBluetoothLEAdvertisementDataSection was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iBluetoothLEAdvertisementDataSection struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEAdvertisementDataSection) Signature() string {
+	return SignatureiBluetoothLEAdvertisementDataSection
+}
+
 type iBluetoothLEAdvertisementDataSectionVtbl struct {
 	ole.IInspectableVtbl
 
@@ -55,6 +95,10 @@ func (v *iBluetoothLEAdvertisementDataSection) VTable() *iBluetoothLEAdvertiseme
 
 func (v *iBluetoothLEAdvertisementDataSection) GetDataType() (uint8, error) {
 	var out uint8
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetDataType,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -62,7 +106,7 @@ func (v *iBluetoothLEAdvertisementDataSection) GetDataType() (uint8, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementDataSection", "get_DataType")
 	}
 
 	return out, nil
@@ -6,20 +6,47 @@
 package advertisement
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEAdvertisementPublisher string = "rc(Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementPublisher;{cde820f9-d9fa-43d6-a264-ddd8b7da8b78})"
 
+const ContractNameBluetoothLEAdvertisementPublisher string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEAdvertisementPublisher uint32 = 0x00010000
+
+// BluetoothLEAdvertisementPublisher was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothLEAdvertisementPublisher struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEAdvertisementPublisher) Signature() string {
+	return SignatureBluetoothLEAdvertisementPublisher
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementPublisher class is available in the running version of Windows.
+func (impl *BluetoothLEAdvertisementPublisher) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementPublisher")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEAdvertisementPublisher, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEAdvertisementPublisher) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetStatus", "GetAdvertisement", "Start", "Stop":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementPublisher")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEAdvertisementPublisher", name)
+}
+
 func NewBluetoothLEAdvertisementPublisher() (*BluetoothLEAdvertisementPublisher, error) {
-	inspectable, err := ole.RoActivateInstance("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementPublisher")
+	inspectable, err := winrt.ActivateInstance("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementPublisher")
 	if err != nil {
 		return nil, err
 	}
@@ -27,28 +54,40 @@ func NewBluetoothLEAdvertisementPublisher() (*BluetoothLEAdvertisementPublisher,
 }
 
 func (impl *BluetoothLEAdvertisementPublisher) GetStatus() (BluetoothLEAdvertisementPublisherStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementPublisher))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementPublisher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementPublisher")
+	if err != nil {
+		return BluetoothLEAdvertisementPublisherStatusCreated, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementPublisher)(unsafe.Pointer(itf))
 	return v.GetStatus()
 }
 
 func (impl *BluetoothLEAdvertisementPublisher) GetAdvertisement() (*BluetoothLEAdvertisement, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementPublisher))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementPublisher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementPublisher")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementPublisher)(unsafe.Pointer(itf))
 	return v.GetAdvertisement()
 }
 
 func (impl *BluetoothLEAdvertisementPublisher) Start() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementPublisher))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementPublisher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementPublisher")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementPublisher)(unsafe.Pointer(itf))
 	return v.Start()
 }
 
 func (impl *BluetoothLEAdvertisementPublisher) Stop() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementPublisher))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementPublisher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementPublisher")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementPublisher)(unsafe.Pointer(itf))
 	return v.Stop()
@@ -57,10 +96,20 @@ func (impl *BluetoothLEAdvertisementPublisher) Stop() error {
 const GUIDiBluetoothLEAdvertisementPublisher string = "cde820f9-d9fa-43d6-a264-ddd8b7da8b78"
 const SignatureiBluetoothLEAdvertisementPublisher string = "{cde820f9-d9fa-43d6-a264-ddd8b7da8b78}"
 
+var IIDiBluetoothLEAdvertisementPublisher = ole.GUID{Data1: 0xCDE820F9, Data2: 0xD9FA, Data3: 0x43D6, Data4: [8]byte{0xA2, 0x64, 0xDD, 0xD8, 0xB7, 0xDA, 0x8B, 0x78}}
+
+const ContractNameiBluetoothLEAdvertisementPublisher string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEAdvertisementPublisher uint32 = 0x00010000
+
+// iBluetoothLEAdvertisementPublisher was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iBluetoothLEAdvertisementPublisher struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEAdvertisementPublisher) Signature() string {
+	return SignatureiBluetoothLEAdvertisementPublisher
+}
+
 type iBluetoothLEAdvertisementPublisherVtbl struct {
 	ole.IInspectableVtbl
 
@@ -78,6 +127,10 @@ func (v *iBluetoothLEAdvertisementPublisher) VTable() *iBluetoothLEAdvertisement
 
 func (v *iBluetoothLEAdvertisementPublisher) GetStatus() (BluetoothLEAdvertisementPublisherStatus, error) {
 	var out BluetoothLEAdvertisementPublisherStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -85,7 +138,7 @@ func (v *iBluetoothLEAdvertisementPublisher) GetStatus() (BluetoothLEAdvertiseme
 	)
 
 	if hr != 0 {
-		return BluetoothLEAdvertisementPublisherStatusCreated, ole.NewError(hr)
+		return BluetoothLEAdvertisementPublisherStatusCreated, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementPublisher", "get_Status")
 	}
 
 	return out, nil
@@ -93,6 +146,10 @@ func (v *iBluetoothLEAdvertisementPublisher) GetStatus() (BluetoothLEAdvertiseme
 
 func (v *iBluetoothLEAdvertisementPublisher) GetAdvertisement() (*BluetoothLEAdvertisement, error) {
 	var out *BluetoothLEAdvertisement
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetAdvertisement,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -100,33 +157,41 @@ func (v *iBluetoothLEAdvertisementPublisher) GetAdvertisement() (*BluetoothLEAdv
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementPublisher", "get_Advertisement")
 	}
 
 	return out, nil
 }
 
 func (v *iBluetoothLEAdvertisementPublisher) Start() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().Start,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementPublisher", "Start")
 	}
 
 	return nil
 }
 
 func (v *iBluetoothLEAdvertisementPublisher) Stop() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().Stop,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementPublisher", "Stop")
 	}
 
 	return nil
@@ -135,10 +200,20 @@ func (v *iBluetoothLEAdvertisementPublisher) Stop() error {
 const GUIDiBluetoothLEAdvertisementPublisher2 string = "fbdb545e-56f1-510f-a434-217fbd9e7bd2"
 const SignatureiBluetoothLEAdvertisementPublisher2 string = "{fbdb545e-56f1-510f-a434-217fbd9e7bd2}"
 
+var IIDiBluetoothLEAdvertisementPublisher2 = ole.GUID{Data1: 0xFBDB545E, Data2: 0x56F1, Data3: 0x510F, Data4: [8]byte{0xA4, 0x34, 0x21, 0x7F, 0xBD, 0x9E, 0x7B, 0xD2}}
+
+const ContractNameiBluetoothLEAdvertisementPublisher2 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEAdvertisementPublisher2 uint32 = 0x000a0000
+
+// iBluetoothLEAdvertisementPublisher2 was introduced in Windows.Foundation.UniversalApiContract v10.0.
 type iBluetoothLEAdvertisementPublisher2 struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEAdvertisementPublisher2) Signature() string {
+	return SignatureiBluetoothLEAdvertisementPublisher2
+}
+
 type iBluetoothLEAdvertisementPublisher2Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -5,10 +5,23 @@
 //nolint:all
 package advertisement
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameBluetoothLEAdvertisementPublisherStatus string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEAdvertisementPublisherStatus uint32 = 0x00010000
+
+// BluetoothLEAdvertisementPublisherStatus was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothLEAdvertisementPublisherStatus int32
 
 const SignatureBluetoothLEAdvertisementPublisherStatus string = "enum(Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementPublisherStatus;i4)"
 
+func (v BluetoothLEAdvertisementPublisherStatus) Signature() string {
+	return SignatureBluetoothLEAdvertisementPublisherStatus
+}
+
 const (
 	BluetoothLEAdvertisementPublisherStatusCreated  BluetoothLEAdvertisementPublisherStatus = 0
 	BluetoothLEAdvertisementPublisherStatusWaiting  BluetoothLEAdvertisementPublisherStatus = 1
@@ -17,3 +30,56 @@ const (
 	BluetoothLEAdvertisementPublisherStatusStopped  BluetoothLEAdvertisementPublisherStatus = 4
 	BluetoothLEAdvertisementPublisherStatusAborted  BluetoothLEAdvertisementPublisherStatus = 5
 )
+
+var namesBluetoothLEAdvertisementPublisherStatus = map[BluetoothLEAdvertisementPublisherStatus]string{
+	BluetoothLEAdvertisementPublisherStatusCreated:  "Created",
+	BluetoothLEAdvertisementPublisherStatusWaiting:  "Waiting",
+	BluetoothLEAdvertisementPublisherStatusStarted:  "Started",
+	BluetoothLEAdvertisementPublisherStatusStopping: "Stopping",
+	BluetoothLEAdvertisementPublisherStatusStopped:  "Stopped",
+	BluetoothLEAdvertisementPublisherStatusAborted:  "Aborted",
+}
+
+var valuesBluetoothLEAdvertisementPublisherStatus = map[string]BluetoothLEAdvertisementPublisherStatus{
+	"Created":  BluetoothLEAdvertisementPublisherStatusCreated,
+	"Waiting":  BluetoothLEAdvertisementPublisherStatusWaiting,
+	"Started":  BluetoothLEAdvertisementPublisherStatusStarted,
+	"Stopping": BluetoothLEAdvertisementPublisherStatusStopping,
+	"Stopped":  BluetoothLEAdvertisementPublisherStatusStopped,
+	"Aborted":  BluetoothLEAdvertisementPublisherStatusAborted,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v BluetoothLEAdvertisementPublisherStatus) String() string {
+	if name, ok := namesBluetoothLEAdvertisementPublisherStatus[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseBluetoothLEAdvertisementPublisherStatus parses the name of a value, as returned by String.
+func ParseBluetoothLEAdvertisementPublisherStatus(s string) (BluetoothLEAdvertisementPublisherStatus, error) {
+	if v, ok := valuesBluetoothLEAdvertisementPublisherStatus[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid BluetoothLEAdvertisementPublisherStatus %q", s)
+	}
+	return BluetoothLEAdvertisementPublisherStatus(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BluetoothLEAdvertisementPublisherStatus) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BluetoothLEAdvertisementPublisherStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseBluetoothLEAdvertisementPublisherStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
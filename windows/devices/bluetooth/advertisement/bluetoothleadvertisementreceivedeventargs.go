@@ -6,34 +6,70 @@
 package advertisement
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEAdvertisementReceivedEventArgs string = "rc(Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementReceivedEventArgs;{27987ddf-e596-41be-8d43-9e6731d4a913})"
 
+const ContractNameBluetoothLEAdvertisementReceivedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEAdvertisementReceivedEventArgs uint32 = 0x00010000
+
+// BluetoothLEAdvertisementReceivedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothLEAdvertisementReceivedEventArgs struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEAdvertisementReceivedEventArgs) Signature() string {
+	return SignatureBluetoothLEAdvertisementReceivedEventArgs
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementReceivedEventArgs class is available in the running version of Windows.
+func (impl *BluetoothLEAdvertisementReceivedEventArgs) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementReceivedEventArgs")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEAdvertisementReceivedEventArgs, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEAdvertisementReceivedEventArgs) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetRawSignalStrengthInDBm", "GetBluetoothAddress", "GetAdvertisement":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementReceivedEventArgs")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEAdvertisementReceivedEventArgs", name)
+}
+
 func (impl *BluetoothLEAdvertisementReceivedEventArgs) GetRawSignalStrengthInDBm() (int16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementReceivedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementReceivedEventArgs, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementReceivedEventArgs")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementReceivedEventArgs)(unsafe.Pointer(itf))
 	return v.GetRawSignalStrengthInDBm()
 }
 
 func (impl *BluetoothLEAdvertisementReceivedEventArgs) GetBluetoothAddress() (uint64, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementReceivedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementReceivedEventArgs, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementReceivedEventArgs")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementReceivedEventArgs)(unsafe.Pointer(itf))
 	return v.GetBluetoothAddress()
 }
 
 func (impl *BluetoothLEAdvertisementReceivedEventArgs) GetAdvertisement() (*BluetoothLEAdvertisement, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementReceivedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementReceivedEventArgs, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementReceivedEventArgs")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementReceivedEventArgs)(unsafe.Pointer(itf))
 	return v.GetAdvertisement()
@@ -42,10 +78,20 @@ func (impl *BluetoothLEAdvertisementReceivedEventArgs) GetAdvertisement() (*Blue
 const GUIDiBluetoothLEAdvertisementReceivedEventArgs string = "27987ddf-e596-41be-8d43-9e6731d4a913"
 const SignatureiBluetoothLEAdvertisementReceivedEventArgs string = "{27987ddf-e596-41be-8d43-9e6731d4a913}"
 
+var IIDiBluetoothLEAdvertisementReceivedEventArgs = ole.GUID{Data1: 0x27987DDF, Data2: 0xE596, Data3: 0x41BE, Data4: [8]byte{0x8D, 0x43, 0x9E, 0x67, 0x31, 0xD4, 0xA9, 0x13}}
+
+const ContractNameiBluetoothLEAdvertisementReceivedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEAdvertisementReceivedEventArgs uint32 = 0x00010000
+
+// iBluetoothLEAdvertisementReceivedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iBluetoothLEAdvertisementReceivedEventArgs struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEAdvertisementReceivedEventArgs) Signature() string {
+	return SignatureiBluetoothLEAdvertisementReceivedEventArgs
+}
+
 type iBluetoothLEAdvertisementReceivedEventArgsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -62,6 +108,10 @@ func (v *iBluetoothLEAdvertisementReceivedEventArgs) VTable() *iBluetoothLEAdver
 
 func (v *iBluetoothLEAdvertisementReceivedEventArgs) GetRawSignalStrengthInDBm() (int16, error) {
 	var out int16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetRawSignalStrengthInDBm,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -69,7 +119,7 @@ func (v *iBluetoothLEAdvertisementReceivedEventArgs) GetRawSignalStrengthInDBm()
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementReceivedEventArgs", "get_RawSignalStrengthInDBm")
 	}
 
 	return out, nil
@@ -77,6 +127,10 @@ func (v *iBluetoothLEAdvertisementReceivedEventArgs) GetRawSignalStrengthInDBm()
 
 func (v *iBluetoothLEAdvertisementReceivedEventArgs) GetBluetoothAddress() (uint64, error) {
 	var out uint64
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetBluetoothAddress,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -84,7 +138,7 @@ func (v *iBluetoothLEAdvertisementReceivedEventArgs) GetBluetoothAddress() (uint
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementReceivedEventArgs", "get_BluetoothAddress")
 	}
 
 	return out, nil
@@ -92,6 +146,10 @@ func (v *iBluetoothLEAdvertisementReceivedEventArgs) GetBluetoothAddress() (uint
 
 func (v *iBluetoothLEAdvertisementReceivedEventArgs) GetAdvertisement() (*BluetoothLEAdvertisement, error) {
 	var out *BluetoothLEAdvertisement
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetAdvertisement,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -99,7 +157,7 @@ func (v *iBluetoothLEAdvertisementReceivedEventArgs) GetAdvertisement() (*Blueto
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementReceivedEventArgs", "get_Advertisement")
 	}
 
 	return out, nil
@@ -108,10 +166,20 @@ func (v *iBluetoothLEAdvertisementReceivedEventArgs) GetAdvertisement() (*Blueto
 const GUIDiBluetoothLEAdvertisementReceivedEventArgs2 string = "12d9c87b-0399-5f0e-a348-53b02b6b162e"
 const SignatureiBluetoothLEAdvertisementReceivedEventArgs2 string = "{12d9c87b-0399-5f0e-a348-53b02b6b162e}"
 
+var IIDiBluetoothLEAdvertisementReceivedEventArgs2 = ole.GUID{Data1: 0x12D9C87B, Data2: 0x0399, Data3: 0x5F0E, Data4: [8]byte{0xA3, 0x48, 0x53, 0xB0, 0x2B, 0x6B, 0x16, 0x2E}}
+
+const ContractNameiBluetoothLEAdvertisementReceivedEventArgs2 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEAdvertisementReceivedEventArgs2 uint32 = 0x000a0000
+
+// iBluetoothLEAdvertisementReceivedEventArgs2 was introduced in Windows.Foundation.UniversalApiContract v10.0.
 type iBluetoothLEAdvertisementReceivedEventArgs2 struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEAdvertisementReceivedEventArgs2) Signature() string {
+	return SignatureiBluetoothLEAdvertisementReceivedEventArgs2
+}
+
 type iBluetoothLEAdvertisementReceivedEventArgs2Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -6,21 +6,51 @@
 package advertisement
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEAdvertisementWatcher string = "rc(Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcher;{a6ac336f-f3d3-4297-8d6c-c81ea6623f40})"
 
+const ContractNameBluetoothLEAdvertisementWatcher string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEAdvertisementWatcher uint32 = 0x00010000
+
+// BluetoothLEAdvertisementWatcher was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothLEAdvertisementWatcher struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEAdvertisementWatcher) Signature() string {
+	return SignatureBluetoothLEAdvertisementWatcher
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcher class is available in the running version of Windows.
+func (impl *BluetoothLEAdvertisementWatcher) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcher")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEAdvertisementWatcher, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEAdvertisementWatcher) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetStatus", "GetScanningMode", "SetScanningMode", "Start", "Stop", "AddReceived", "OnReceived", "RemoveReceived", "AddStopped", "OnStopped", "RemoveStopped":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher")
+	case "GetAllowExtendedAdvertisements", "SetAllowExtendedAdvertisements":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher2")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEAdvertisementWatcher", name)
+}
+
 func NewBluetoothLEAdvertisementWatcher() (*BluetoothLEAdvertisementWatcher, error) {
-	inspectable, err := ole.RoActivateInstance("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcher")
+	inspectable, err := winrt.ActivateInstance("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcher")
 	if err != nil {
 		return nil, err
 	}
@@ -28,77 +58,170 @@ func NewBluetoothLEAdvertisementWatcher() (*BluetoothLEAdvertisementWatcher, err
 }
 
 func (impl *BluetoothLEAdvertisementWatcher) GetStatus() (BluetoothLEAdvertisementWatcherStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcher))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher")
+	if err != nil {
+		return BluetoothLEAdvertisementWatcherStatusCreated, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcher)(unsafe.Pointer(itf))
 	return v.GetStatus()
 }
 
 func (impl *BluetoothLEAdvertisementWatcher) GetScanningMode() (BluetoothLEScanningMode, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcher))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher")
+	if err != nil {
+		return BluetoothLEScanningModePassive, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcher)(unsafe.Pointer(itf))
 	return v.GetScanningMode()
 }
 
 func (impl *BluetoothLEAdvertisementWatcher) SetScanningMode(value BluetoothLEScanningMode) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcher))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcher)(unsafe.Pointer(itf))
 	return v.SetScanningMode(value)
 }
 
 func (impl *BluetoothLEAdvertisementWatcher) Start() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcher))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcher)(unsafe.Pointer(itf))
 	return v.Start()
 }
 
 func (impl *BluetoothLEAdvertisementWatcher) Stop() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcher))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcher)(unsafe.Pointer(itf))
 	return v.Stop()
 }
 
-func (impl *BluetoothLEAdvertisementWatcher) AddReceived(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcher))
+func (impl *BluetoothLEAdvertisementWatcher) AddReceived(handler *foundation.TypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementReceivedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcher)(unsafe.Pointer(itf))
 	return v.AddReceived(handler)
 }
 
+// OnReceived registers the given function as a handler of the event, using AddReceived. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveReceived, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *BluetoothLEAdvertisementWatcher) OnReceived(callback func(sender *BluetoothLEAdvertisementWatcher, args *BluetoothLEAdvertisementReceivedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementReceivedEventArgs](func(_ *foundation.TypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementReceivedEventArgs], sender *BluetoothLEAdvertisementWatcher, args *BluetoothLEAdvertisementReceivedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddReceived(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveReceived(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *BluetoothLEAdvertisementWatcher) RemoveReceived(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcher))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcher)(unsafe.Pointer(itf))
 	return v.RemoveReceived(token)
 }
 
-func (impl *BluetoothLEAdvertisementWatcher) AddStopped(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcher))
+func (impl *BluetoothLEAdvertisementWatcher) AddStopped(handler *foundation.TypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementWatcherStoppedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcher)(unsafe.Pointer(itf))
 	return v.AddStopped(handler)
 }
 
+// OnStopped registers the given function as a handler of the event, using AddStopped. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveStopped, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *BluetoothLEAdvertisementWatcher) OnStopped(callback func(sender *BluetoothLEAdvertisementWatcher, args *BluetoothLEAdvertisementWatcherStoppedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementWatcherStoppedEventArgs](func(_ *foundation.TypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementWatcherStoppedEventArgs], sender *BluetoothLEAdvertisementWatcher, args *BluetoothLEAdvertisementWatcherStoppedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddStopped(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveStopped(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *BluetoothLEAdvertisementWatcher) RemoveStopped(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcher))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcher, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcher)(unsafe.Pointer(itf))
 	return v.RemoveStopped(token)
 }
 
 func (impl *BluetoothLEAdvertisementWatcher) GetAllowExtendedAdvertisements() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcher2))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcher2, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher2")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcher2)(unsafe.Pointer(itf))
 	return v.GetAllowExtendedAdvertisements()
 }
 
 func (impl *BluetoothLEAdvertisementWatcher) SetAllowExtendedAdvertisements(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcher2))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcher2, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher2")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcher2)(unsafe.Pointer(itf))
 	return v.SetAllowExtendedAdvertisements(value)
@@ -107,10 +230,20 @@ func (impl *BluetoothLEAdvertisementWatcher) SetAllowExtendedAdvertisements(valu
 const GUIDiBluetoothLEAdvertisementWatcher string = "a6ac336f-f3d3-4297-8d6c-c81ea6623f40"
 const SignatureiBluetoothLEAdvertisementWatcher string = "{a6ac336f-f3d3-4297-8d6c-c81ea6623f40}"
 
+var IIDiBluetoothLEAdvertisementWatcher = ole.GUID{Data1: 0xA6AC336F, Data2: 0xF3D3, Data3: 0x4297, Data4: [8]byte{0x8D, 0x6C, 0xC8, 0x1E, 0xA6, 0x62, 0x3F, 0x40}}
+
+const ContractNameiBluetoothLEAdvertisementWatcher string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEAdvertisementWatcher uint32 = 0x00010000
+
+// iBluetoothLEAdvertisementWatcher was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iBluetoothLEAdvertisementWatcher struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEAdvertisementWatcher) Signature() string {
+	return SignatureiBluetoothLEAdvertisementWatcher
+}
+
 type iBluetoothLEAdvertisementWatcherVtbl struct {
 	ole.IInspectableVtbl
 
@@ -139,6 +272,10 @@ func (v *iBluetoothLEAdvertisementWatcher) VTable() *iBluetoothLEAdvertisementWa
 
 func (v *iBluetoothLEAdvertisementWatcher) GetStatus() (BluetoothLEAdvertisementWatcherStatus, error) {
 	var out BluetoothLEAdvertisementWatcherStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -146,7 +283,7 @@ func (v *iBluetoothLEAdvertisementWatcher) GetStatus() (BluetoothLEAdvertisement
 	)
 
 	if hr != 0 {
-		return BluetoothLEAdvertisementWatcherStatusCreated, ole.NewError(hr)
+		return BluetoothLEAdvertisementWatcherStatusCreated, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher", "get_Status")
 	}
 
 	return out, nil
@@ -154,6 +291,10 @@ func (v *iBluetoothLEAdvertisementWatcher) GetStatus() (BluetoothLEAdvertisement
 
 func (v *iBluetoothLEAdvertisementWatcher) GetScanningMode() (BluetoothLEScanningMode, error) {
 	var out BluetoothLEScanningMode
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetScanningMode,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -161,13 +302,17 @@ func (v *iBluetoothLEAdvertisementWatcher) GetScanningMode() (BluetoothLEScannin
 	)
 
 	if hr != 0 {
-		return BluetoothLEScanningModePassive, ole.NewError(hr)
+		return BluetoothLEScanningModePassive, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher", "get_ScanningMode")
 	}
 
 	return out, nil
 }
 
 func (v *iBluetoothLEAdvertisementWatcher) SetScanningMode(value BluetoothLEScanningMode) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetScanningMode,
 		uintptr(unsafe.Pointer(v)), // this
@@ -175,93 +320,189 @@ func (v *iBluetoothLEAdvertisementWatcher) SetScanningMode(value BluetoothLEScan
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher", "put_ScanningMode")
 	}
 
 	return nil
 }
 
 func (v *iBluetoothLEAdvertisementWatcher) Start() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().Start,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher", "Start")
 	}
 
 	return nil
 }
 
 func (v *iBluetoothLEAdvertisementWatcher) Stop() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().Stop,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher", "Stop")
 	}
 
 	return nil
 }
 
-func (v *iBluetoothLEAdvertisementWatcher) AddReceived(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iBluetoothLEAdvertisementWatcher) AddReceived(handler *foundation.TypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementReceivedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddReceived,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementReceivedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher", "add_Received")
 	}
 
 	return out, nil
 }
 
+// OnReceived registers the given function as a handler of the event, using AddReceived. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveReceived, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iBluetoothLEAdvertisementWatcher) OnReceived(callback func(sender *BluetoothLEAdvertisementWatcher, args *BluetoothLEAdvertisementReceivedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementReceivedEventArgs](func(_ *foundation.TypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementReceivedEventArgs], sender *BluetoothLEAdvertisementWatcher, args *BluetoothLEAdvertisementReceivedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddReceived(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveReceived(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iBluetoothLEAdvertisementWatcher) RemoveReceived(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveReceived,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveReceived, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher", "remove_Received")
 	}
 
 	return nil
 }
 
-func (v *iBluetoothLEAdvertisementWatcher) AddStopped(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iBluetoothLEAdvertisementWatcher) AddStopped(handler *foundation.TypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementWatcherStoppedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddStopped,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementWatcherStoppedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher", "add_Stopped")
 	}
 
 	return out, nil
 }
 
+// OnStopped registers the given function as a handler of the event, using AddStopped. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveStopped, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iBluetoothLEAdvertisementWatcher) OnStopped(callback func(sender *BluetoothLEAdvertisementWatcher, args *BluetoothLEAdvertisementWatcherStoppedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementWatcherStoppedEventArgs](func(_ *foundation.TypedEventHandler[*BluetoothLEAdvertisementWatcher, *BluetoothLEAdvertisementWatcherStoppedEventArgs], sender *BluetoothLEAdvertisementWatcher, args *BluetoothLEAdvertisementWatcherStoppedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddStopped(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveStopped(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iBluetoothLEAdvertisementWatcher) RemoveStopped(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveStopped,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveStopped, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher", "remove_Stopped")
 	}
 
 	return nil
@@ -270,10 +511,20 @@ func (v *iBluetoothLEAdvertisementWatcher) RemoveStopped(token foundation.EventR
 const GUIDiBluetoothLEAdvertisementWatcher2 string = "01bf26bc-b164-5805-90a3-e8a7997ff225"
 const SignatureiBluetoothLEAdvertisementWatcher2 string = "{01bf26bc-b164-5805-90a3-e8a7997ff225}"
 
+var IIDiBluetoothLEAdvertisementWatcher2 = ole.GUID{Data1: 0x01BF26BC, Data2: 0xB164, Data3: 0x5805, Data4: [8]byte{0x90, 0xA3, 0xE8, 0xA7, 0x99, 0x7F, 0xF2, 0x25}}
+
+const ContractNameiBluetoothLEAdvertisementWatcher2 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEAdvertisementWatcher2 uint32 = 0x000a0000
+
+// iBluetoothLEAdvertisementWatcher2 was introduced in Windows.Foundation.UniversalApiContract v10.0.
 type iBluetoothLEAdvertisementWatcher2 struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEAdvertisementWatcher2) Signature() string {
+	return SignatureiBluetoothLEAdvertisementWatcher2
+}
+
 type iBluetoothLEAdvertisementWatcher2Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -287,6 +538,10 @@ func (v *iBluetoothLEAdvertisementWatcher2) VTable() *iBluetoothLEAdvertisementW
 
 func (v *iBluetoothLEAdvertisementWatcher2) GetAllowExtendedAdvertisements() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetAllowExtendedAdvertisements,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -294,13 +549,17 @@ func (v *iBluetoothLEAdvertisementWatcher2) GetAllowExtendedAdvertisements() (bo
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher2", "get_AllowExtendedAdvertisements")
 	}
 
 	return out, nil
 }
 
 func (v *iBluetoothLEAdvertisementWatcher2) SetAllowExtendedAdvertisements(value bool) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetAllowExtendedAdvertisements,
 		uintptr(unsafe.Pointer(v)),                // this
@@ -308,7 +567,7 @@ func (v *iBluetoothLEAdvertisementWatcher2) SetAllowExtendedAdvertisements(value
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcher2", "put_AllowExtendedAdvertisements")
 	}
 
 	return nil
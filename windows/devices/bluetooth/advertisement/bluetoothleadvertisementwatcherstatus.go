@@ -5,10 +5,23 @@
 //nolint:all
 package advertisement
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameBluetoothLEAdvertisementWatcherStatus string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEAdvertisementWatcherStatus uint32 = 0x00010000
+
+// BluetoothLEAdvertisementWatcherStatus was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothLEAdvertisementWatcherStatus int32
 
 const SignatureBluetoothLEAdvertisementWatcherStatus string = "enum(Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcherStatus;i4)"
 
+func (v BluetoothLEAdvertisementWatcherStatus) Signature() string {
+	return SignatureBluetoothLEAdvertisementWatcherStatus
+}
+
 const (
 	BluetoothLEAdvertisementWatcherStatusCreated  BluetoothLEAdvertisementWatcherStatus = 0
 	BluetoothLEAdvertisementWatcherStatusStarted  BluetoothLEAdvertisementWatcherStatus = 1
@@ -16,3 +29,54 @@ const (
 	BluetoothLEAdvertisementWatcherStatusStopped  BluetoothLEAdvertisementWatcherStatus = 3
 	BluetoothLEAdvertisementWatcherStatusAborted  BluetoothLEAdvertisementWatcherStatus = 4
 )
+
+var namesBluetoothLEAdvertisementWatcherStatus = map[BluetoothLEAdvertisementWatcherStatus]string{
+	BluetoothLEAdvertisementWatcherStatusCreated:  "Created",
+	BluetoothLEAdvertisementWatcherStatusStarted:  "Started",
+	BluetoothLEAdvertisementWatcherStatusStopping: "Stopping",
+	BluetoothLEAdvertisementWatcherStatusStopped:  "Stopped",
+	BluetoothLEAdvertisementWatcherStatusAborted:  "Aborted",
+}
+
+var valuesBluetoothLEAdvertisementWatcherStatus = map[string]BluetoothLEAdvertisementWatcherStatus{
+	"Created":  BluetoothLEAdvertisementWatcherStatusCreated,
+	"Started":  BluetoothLEAdvertisementWatcherStatusStarted,
+	"Stopping": BluetoothLEAdvertisementWatcherStatusStopping,
+	"Stopped":  BluetoothLEAdvertisementWatcherStatusStopped,
+	"Aborted":  BluetoothLEAdvertisementWatcherStatusAborted,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v BluetoothLEAdvertisementWatcherStatus) String() string {
+	if name, ok := namesBluetoothLEAdvertisementWatcherStatus[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseBluetoothLEAdvertisementWatcherStatus parses the name of a value, as returned by String.
+func ParseBluetoothLEAdvertisementWatcherStatus(s string) (BluetoothLEAdvertisementWatcherStatus, error) {
+	if v, ok := valuesBluetoothLEAdvertisementWatcherStatus[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid BluetoothLEAdvertisementWatcherStatus %q", s)
+	}
+	return BluetoothLEAdvertisementWatcherStatus(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BluetoothLEAdvertisementWatcherStatus) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BluetoothLEAdvertisementWatcherStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseBluetoothLEAdvertisementWatcherStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -6,21 +6,51 @@
 package advertisement
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/devices/bluetooth"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEAdvertisementWatcherStoppedEventArgs string = "rc(Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcherStoppedEventArgs;{dd40f84d-e7b9-43e3-9c04-0685d085fd8c})"
 
+const ContractNameBluetoothLEAdvertisementWatcherStoppedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEAdvertisementWatcherStoppedEventArgs uint32 = 0x00010000
+
+// BluetoothLEAdvertisementWatcherStoppedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothLEAdvertisementWatcherStoppedEventArgs struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEAdvertisementWatcherStoppedEventArgs) Signature() string {
+	return SignatureBluetoothLEAdvertisementWatcherStoppedEventArgs
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcherStoppedEventArgs class is available in the running version of Windows.
+func (impl *BluetoothLEAdvertisementWatcherStoppedEventArgs) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.BluetoothLEAdvertisementWatcherStoppedEventArgs")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEAdvertisementWatcherStoppedEventArgs, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEAdvertisementWatcherStoppedEventArgs) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetError":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcherStoppedEventArgs")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEAdvertisementWatcherStoppedEventArgs", name)
+}
+
 func (impl *BluetoothLEAdvertisementWatcherStoppedEventArgs) GetError() (bluetooth.BluetoothError, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEAdvertisementWatcherStoppedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEAdvertisementWatcherStoppedEventArgs, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcherStoppedEventArgs")
+	if err != nil {
+		return bluetooth.BluetoothErrorSuccess, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEAdvertisementWatcherStoppedEventArgs)(unsafe.Pointer(itf))
 	return v.GetError()
@@ -29,10 +59,20 @@ func (impl *BluetoothLEAdvertisementWatcherStoppedEventArgs) GetError() (bluetoo
 const GUIDiBluetoothLEAdvertisementWatcherStoppedEventArgs string = "dd40f84d-e7b9-43e3-9c04-0685d085fd8c"
 const SignatureiBluetoothLEAdvertisementWatcherStoppedEventArgs string = "{dd40f84d-e7b9-43e3-9c04-0685d085fd8c}"
 
+var IIDiBluetoothLEAdvertisementWatcherStoppedEventArgs = ole.GUID{Data1: 0xDD40F84D, Data2: 0xE7B9, Data3: 0x43E3, Data4: [8]byte{0x9C, 0x04, 0x06, 0x85, 0xD0, 0x85, 0xFD, 0x8C}}
+
+const ContractNameiBluetoothLEAdvertisementWatcherStoppedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEAdvertisementWatcherStoppedEventArgs uint32 = 0x00010000
+
+// iBluetoothLEAdvertisementWatcherStoppedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iBluetoothLEAdvertisementWatcherStoppedEventArgs struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEAdvertisementWatcherStoppedEventArgs) Signature() string {
+	return SignatureiBluetoothLEAdvertisementWatcherStoppedEventArgs
+}
+
 type iBluetoothLEAdvertisementWatcherStoppedEventArgsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -45,6 +85,10 @@ func (v *iBluetoothLEAdvertisementWatcherStoppedEventArgs) VTable() *iBluetoothL
 
 func (v *iBluetoothLEAdvertisementWatcherStoppedEventArgs) GetError() (bluetooth.BluetoothError, error) {
 	var out bluetooth.BluetoothError
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetError,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -52,7 +96,7 @@ func (v *iBluetoothLEAdvertisementWatcherStoppedEventArgs) GetError() (bluetooth
 	)
 
 	if hr != 0 {
-		return bluetooth.BluetoothErrorSuccess, ole.NewError(hr)
+		return bluetooth.BluetoothErrorSuccess, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEAdvertisementWatcherStoppedEventArgs", "get_Error")
 	}
 
 	return out, nil
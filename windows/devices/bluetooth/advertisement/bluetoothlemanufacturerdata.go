@@ -6,21 +6,50 @@
 package advertisement
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/storage/streams"
 )
 
 const SignatureBluetoothLEManufacturerData string = "rc(Windows.Devices.Bluetooth.Advertisement.BluetoothLEManufacturerData;{912dba18-6963-4533-b061-4694dafb34e5})"
 
+const ContractNameBluetoothLEManufacturerData string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEManufacturerData uint32 = 0x00010000
+
+// BluetoothLEManufacturerData was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothLEManufacturerData struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEManufacturerData) Signature() string {
+	return SignatureBluetoothLEManufacturerData
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.Advertisement.BluetoothLEManufacturerData class is available in the running version of Windows.
+func (impl *BluetoothLEManufacturerData) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.BluetoothLEManufacturerData")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEManufacturerData, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEManufacturerData) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetCompanyId", "SetCompanyId", "GetData", "SetData":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.IBluetoothLEManufacturerData")
+	case "BluetoothLEManufacturerDataCreate":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.Advertisement.IBluetoothLEManufacturerDataFactory")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEManufacturerData", name)
+}
+
 func NewBluetoothLEManufacturerData() (*BluetoothLEManufacturerData, error) {
-	inspectable, err := ole.RoActivateInstance("Windows.Devices.Bluetooth.Advertisement.BluetoothLEManufacturerData")
+	inspectable, err := winrt.ActivateInstance("Windows.Devices.Bluetooth.Advertisement.BluetoothLEManufacturerData")
 	if err != nil {
 		return nil, err
 	}
@@ -28,28 +57,40 @@ func NewBluetoothLEManufacturerData() (*BluetoothLEManufacturerData, error) {
 }
 
 func (impl *BluetoothLEManufacturerData) GetCompanyId() (uint16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEManufacturerData))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEManufacturerData, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEManufacturerData")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEManufacturerData)(unsafe.Pointer(itf))
 	return v.GetCompanyId()
 }
 
 func (impl *BluetoothLEManufacturerData) SetCompanyId(value uint16) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEManufacturerData))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEManufacturerData, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEManufacturerData")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEManufacturerData)(unsafe.Pointer(itf))
 	return v.SetCompanyId(value)
 }
 
 func (impl *BluetoothLEManufacturerData) GetData() (*streams.IBuffer, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEManufacturerData))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEManufacturerData, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEManufacturerData")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEManufacturerData)(unsafe.Pointer(itf))
 	return v.GetData()
 }
 
 func (impl *BluetoothLEManufacturerData) SetData(value *streams.IBuffer) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEManufacturerData))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEManufacturerData, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEManufacturerData")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEManufacturerData)(unsafe.Pointer(itf))
 	return v.SetData(value)
@@ -58,10 +99,20 @@ func (impl *BluetoothLEManufacturerData) SetData(value *streams.IBuffer) error {
 const GUIDiBluetoothLEManufacturerData string = "912dba18-6963-4533-b061-4694dafb34e5"
 const SignatureiBluetoothLEManufacturerData string = "{912dba18-6963-4533-b061-4694dafb34e5}"
 
+var IIDiBluetoothLEManufacturerData = ole.GUID{Data1: 0x912DBA18, Data2: 0x6963, Data3: 0x4533, Data4: [8]byte{0xB0, 0x61, 0x46, 0x94, 0xDA, 0xFB, 0x34, 0xE5}}
+
+const ContractNameiBluetoothLEManufacturerData string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEManufacturerData uint32 = 0x00010000
+
+// iBluetoothLEManufacturerData was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iBluetoothLEManufacturerData struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEManufacturerData) Signature() string {
+	return SignatureiBluetoothLEManufacturerData
+}
+
 type iBluetoothLEManufacturerDataVtbl struct {
 	ole.IInspectableVtbl
 
@@ -77,6 +128,10 @@ func (v *iBluetoothLEManufacturerData) VTable() *iBluetoothLEManufacturerDataVtb
 
 func (v *iBluetoothLEManufacturerData) GetCompanyId() (uint16, error) {
 	var out uint16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCompanyId,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -84,13 +139,17 @@ func (v *iBluetoothLEManufacturerData) GetCompanyId() (uint16, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEManufacturerData", "get_CompanyId")
 	}
 
 	return out, nil
 }
 
 func (v *iBluetoothLEManufacturerData) SetCompanyId(value uint16) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetCompanyId,
 		uintptr(unsafe.Pointer(v)), // this
@@ -98,7 +157,7 @@ func (v *iBluetoothLEManufacturerData) SetCompanyId(value uint16) error {
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEManufacturerData", "put_CompanyId")
 	}
 
 	return nil
@@ -106,6 +165,10 @@ func (v *iBluetoothLEManufacturerData) SetCompanyId(value uint16) error {
 
 func (v *iBluetoothLEManufacturerData) GetData() (*streams.IBuffer, error) {
 	var out *streams.IBuffer
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetData,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -113,13 +176,17 @@ func (v *iBluetoothLEManufacturerData) GetData() (*streams.IBuffer, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEManufacturerData", "get_Data")
 	}
 
 	return out, nil
 }
 
 func (v *iBluetoothLEManufacturerData) SetData(value *streams.IBuffer) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetData,
 		uintptr(unsafe.Pointer(v)),     // this
@@ -127,7 +194,7 @@ func (v *iBluetoothLEManufacturerData) SetData(value *streams.IBuffer) error {
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEManufacturerData", "put_Data")
 	}
 
 	return nil
@@ -136,10 +203,20 @@ func (v *iBluetoothLEManufacturerData) SetData(value *streams.IBuffer) error {
 const GUIDiBluetoothLEManufacturerDataFactory string = "c09b39f8-319a-441e-8de5-66a81e877a6c"
 const SignatureiBluetoothLEManufacturerDataFactory string = "{c09b39f8-319a-441e-8de5-66a81e877a6c}"
 
+var IIDiBluetoothLEManufacturerDataFactory = ole.GUID{Data1: 0xC09B39F8, Data2: 0x319A, Data3: 0x441E, Data4: [8]byte{0x8D, 0xE5, 0x66, 0xA8, 0x1E, 0x87, 0x7A, 0x6C}}
+
+const ContractNameiBluetoothLEManufacturerDataFactory string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEManufacturerDataFactory uint32 = 0x00010000
+
+// iBluetoothLEManufacturerDataFactory was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iBluetoothLEManufacturerDataFactory struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEManufacturerDataFactory) Signature() string {
+	return SignatureiBluetoothLEManufacturerDataFactory
+}
+
 type iBluetoothLEManufacturerDataFactoryVtbl struct {
 	ole.IInspectableVtbl
 
@@ -151,13 +228,18 @@ func (v *iBluetoothLEManufacturerDataFactory) VTable() *iBluetoothLEManufacturer
 }
 
 func BluetoothLEManufacturerDataCreate(companyId uint16, data *streams.IBuffer) (*BluetoothLEManufacturerData, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Devices.Bluetooth.Advertisement.BluetoothLEManufacturerData", ole.NewGUID(GUIDiBluetoothLEManufacturerDataFactory))
+	inspectable, err := winrt.GetActivationFactory("Windows.Devices.Bluetooth.Advertisement.BluetoothLEManufacturerData", &IIDiBluetoothLEManufacturerDataFactory)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iBluetoothLEManufacturerDataFactory)(unsafe.Pointer(inspectable))
 
 	var out *BluetoothLEManufacturerData
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().BluetoothLEManufacturerDataCreate,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -167,7 +249,7 @@ func BluetoothLEManufacturerDataCreate(companyId uint16, data *streams.IBuffer)
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.Advertisement.IBluetoothLEManufacturerDataFactory", "Create")
 	}
 
 	return out, nil
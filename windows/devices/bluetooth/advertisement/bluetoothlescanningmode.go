@@ -5,12 +5,72 @@
 //nolint:all
 package advertisement
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameBluetoothLEScanningMode string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEScanningMode uint32 = 0x00010000
+
+// BluetoothLEScanningMode was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothLEScanningMode int32
 
 const SignatureBluetoothLEScanningMode string = "enum(Windows.Devices.Bluetooth.Advertisement.BluetoothLEScanningMode;i4)"
 
+func (v BluetoothLEScanningMode) Signature() string {
+	return SignatureBluetoothLEScanningMode
+}
+
 const (
 	BluetoothLEScanningModePassive BluetoothLEScanningMode = 0
 	BluetoothLEScanningModeActive  BluetoothLEScanningMode = 1
 	BluetoothLEScanningModeNone    BluetoothLEScanningMode = 2
 )
+
+var namesBluetoothLEScanningMode = map[BluetoothLEScanningMode]string{
+	BluetoothLEScanningModePassive: "Passive",
+	BluetoothLEScanningModeActive:  "Active",
+	BluetoothLEScanningModeNone:    "None",
+}
+
+var valuesBluetoothLEScanningMode = map[string]BluetoothLEScanningMode{
+	"Passive": BluetoothLEScanningModePassive,
+	"Active":  BluetoothLEScanningModeActive,
+	"None":    BluetoothLEScanningModeNone,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v BluetoothLEScanningMode) String() string {
+	if name, ok := namesBluetoothLEScanningMode[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseBluetoothLEScanningMode parses the name of a value, as returned by String.
+func ParseBluetoothLEScanningMode(s string) (BluetoothLEScanningMode, error) {
+	if v, ok := valuesBluetoothLEScanningMode[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid BluetoothLEScanningMode %q", s)
+	}
+	return BluetoothLEScanningMode(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BluetoothLEScanningMode) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BluetoothLEScanningMode) UnmarshalText(text []byte) error {
+	parsed, err := ParseBluetoothLEScanningMode(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
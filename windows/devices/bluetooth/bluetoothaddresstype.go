@@ -5,12 +5,72 @@
 //nolint:all
 package bluetooth
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameBluetoothAddressType string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothAddressType uint32 = 0x00020000
+
+// BluetoothAddressType was introduced in Windows.Foundation.UniversalApiContract v2.0.
 type BluetoothAddressType int32
 
 const SignatureBluetoothAddressType string = "enum(Windows.Devices.Bluetooth.BluetoothAddressType;i4)"
 
+func (v BluetoothAddressType) Signature() string {
+	return SignatureBluetoothAddressType
+}
+
 const (
 	BluetoothAddressTypePublic      BluetoothAddressType = 0
 	BluetoothAddressTypeRandom      BluetoothAddressType = 1
 	BluetoothAddressTypeUnspecified BluetoothAddressType = 2
 )
+
+var namesBluetoothAddressType = map[BluetoothAddressType]string{
+	BluetoothAddressTypePublic:      "Public",
+	BluetoothAddressTypeRandom:      "Random",
+	BluetoothAddressTypeUnspecified: "Unspecified",
+}
+
+var valuesBluetoothAddressType = map[string]BluetoothAddressType{
+	"Public":      BluetoothAddressTypePublic,
+	"Random":      BluetoothAddressTypeRandom,
+	"Unspecified": BluetoothAddressTypeUnspecified,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v BluetoothAddressType) String() string {
+	if name, ok := namesBluetoothAddressType[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseBluetoothAddressType parses the name of a value, as returned by String.
+func ParseBluetoothAddressType(s string) (BluetoothAddressType, error) {
+	if v, ok := valuesBluetoothAddressType[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid BluetoothAddressType %q", s)
+	}
+	return BluetoothAddressType(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BluetoothAddressType) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BluetoothAddressType) UnmarshalText(text []byte) error {
+	parsed, err := ParseBluetoothAddressType(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
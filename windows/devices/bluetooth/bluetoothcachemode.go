@@ -5,11 +5,69 @@
 //nolint:all
 package bluetooth
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameBluetoothCacheMode string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothCacheMode uint32 = 0x00010000
+
+// BluetoothCacheMode was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothCacheMode int32
 
 const SignatureBluetoothCacheMode string = "enum(Windows.Devices.Bluetooth.BluetoothCacheMode;i4)"
 
+func (v BluetoothCacheMode) Signature() string {
+	return SignatureBluetoothCacheMode
+}
+
 const (
 	BluetoothCacheModeCached   BluetoothCacheMode = 0
 	BluetoothCacheModeUncached BluetoothCacheMode = 1
 )
+
+var namesBluetoothCacheMode = map[BluetoothCacheMode]string{
+	BluetoothCacheModeCached:   "Cached",
+	BluetoothCacheModeUncached: "Uncached",
+}
+
+var valuesBluetoothCacheMode = map[string]BluetoothCacheMode{
+	"Cached":   BluetoothCacheModeCached,
+	"Uncached": BluetoothCacheModeUncached,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v BluetoothCacheMode) String() string {
+	if name, ok := namesBluetoothCacheMode[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseBluetoothCacheMode parses the name of a value, as returned by String.
+func ParseBluetoothCacheMode(s string) (BluetoothCacheMode, error) {
+	if v, ok := valuesBluetoothCacheMode[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid BluetoothCacheMode %q", s)
+	}
+	return BluetoothCacheMode(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BluetoothCacheMode) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BluetoothCacheMode) UnmarshalText(text []byte) error {
+	parsed, err := ParseBluetoothCacheMode(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
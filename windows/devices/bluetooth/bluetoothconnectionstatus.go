@@ -5,11 +5,69 @@
 //nolint:all
 package bluetooth
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameBluetoothConnectionStatus string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothConnectionStatus uint32 = 0x00010000
+
+// BluetoothConnectionStatus was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothConnectionStatus int32
 
 const SignatureBluetoothConnectionStatus string = "enum(Windows.Devices.Bluetooth.BluetoothConnectionStatus;i4)"
 
+func (v BluetoothConnectionStatus) Signature() string {
+	return SignatureBluetoothConnectionStatus
+}
+
 const (
 	BluetoothConnectionStatusDisconnected BluetoothConnectionStatus = 0
 	BluetoothConnectionStatusConnected    BluetoothConnectionStatus = 1
 )
+
+var namesBluetoothConnectionStatus = map[BluetoothConnectionStatus]string{
+	BluetoothConnectionStatusDisconnected: "Disconnected",
+	BluetoothConnectionStatusConnected:    "Connected",
+}
+
+var valuesBluetoothConnectionStatus = map[string]BluetoothConnectionStatus{
+	"Disconnected": BluetoothConnectionStatusDisconnected,
+	"Connected":    BluetoothConnectionStatusConnected,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v BluetoothConnectionStatus) String() string {
+	if name, ok := namesBluetoothConnectionStatus[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseBluetoothConnectionStatus parses the name of a value, as returned by String.
+func ParseBluetoothConnectionStatus(s string) (BluetoothConnectionStatus, error) {
+	if v, ok := valuesBluetoothConnectionStatus[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid BluetoothConnectionStatus %q", s)
+	}
+	return BluetoothConnectionStatus(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BluetoothConnectionStatus) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BluetoothConnectionStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseBluetoothConnectionStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -6,34 +6,70 @@
 package bluetooth
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothDeviceId string = "rc(Windows.Devices.Bluetooth.BluetoothDeviceId;{c17949af-57c1-4642-bcce-e6c06b20ae76})"
 
+const ContractNameBluetoothDeviceId string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothDeviceId uint32 = 0x00040000
+
+// BluetoothDeviceId was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type BluetoothDeviceId struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothDeviceId) Signature() string {
+	return SignatureBluetoothDeviceId
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.BluetoothDeviceId class is available in the running version of Windows.
+func (impl *BluetoothDeviceId) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.BluetoothDeviceId")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothDeviceId, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothDeviceId) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetId", "GetIsClassicDevice", "GetIsLowEnergyDevice":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothDeviceId")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothDeviceId", name)
+}
+
 func (impl *BluetoothDeviceId) GetId() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothDeviceId))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothDeviceId, "Windows.Devices.Bluetooth.IBluetoothDeviceId")
+	if err != nil {
+		return "", err
+	}
 	defer itf.Release()
 	v := (*iBluetoothDeviceId)(unsafe.Pointer(itf))
 	return v.GetId()
 }
 
 func (impl *BluetoothDeviceId) GetIsClassicDevice() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothDeviceId))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothDeviceId, "Windows.Devices.Bluetooth.IBluetoothDeviceId")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothDeviceId)(unsafe.Pointer(itf))
 	return v.GetIsClassicDevice()
 }
 
 func (impl *BluetoothDeviceId) GetIsLowEnergyDevice() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothDeviceId))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothDeviceId, "Windows.Devices.Bluetooth.IBluetoothDeviceId")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothDeviceId)(unsafe.Pointer(itf))
 	return v.GetIsLowEnergyDevice()
@@ -42,10 +78,20 @@ func (impl *BluetoothDeviceId) GetIsLowEnergyDevice() (bool, error) {
 const GUIDiBluetoothDeviceId string = "c17949af-57c1-4642-bcce-e6c06b20ae76"
 const SignatureiBluetoothDeviceId string = "{c17949af-57c1-4642-bcce-e6c06b20ae76}"
 
+var IIDiBluetoothDeviceId = ole.GUID{Data1: 0xC17949AF, Data2: 0x57C1, Data3: 0x4642, Data4: [8]byte{0xBC, 0xCE, 0xE6, 0xC0, 0x6B, 0x20, 0xAE, 0x76}}
+
+const ContractNameiBluetoothDeviceId string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothDeviceId uint32 = 0x00040000
+
+// iBluetoothDeviceId was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iBluetoothDeviceId struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothDeviceId) Signature() string {
+	return SignatureiBluetoothDeviceId
+}
+
 type iBluetoothDeviceIdVtbl struct {
 	ole.IInspectableVtbl
 
@@ -60,6 +106,10 @@ func (v *iBluetoothDeviceId) VTable() *iBluetoothDeviceIdVtbl {
 
 func (v *iBluetoothDeviceId) GetId() (string, error) {
 	var outHStr ole.HString
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetId,
 		uintptr(unsafe.Pointer(v)),        // this
@@ -67,7 +117,7 @@ func (v *iBluetoothDeviceId) GetId() (string, error) {
 	)
 
 	if hr != 0 {
-		return "", ole.NewError(hr)
+		return "", winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothDeviceId", "get_Id")
 	}
 
 	out := outHStr.String()
@@ -77,6 +127,10 @@ func (v *iBluetoothDeviceId) GetId() (string, error) {
 
 func (v *iBluetoothDeviceId) GetIsClassicDevice() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsClassicDevice,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -84,7 +138,7 @@ func (v *iBluetoothDeviceId) GetIsClassicDevice() (bool, error) {
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothDeviceId", "get_IsClassicDevice")
 	}
 
 	return out, nil
@@ -92,6 +146,10 @@ func (v *iBluetoothDeviceId) GetIsClassicDevice() (bool, error) {
 
 func (v *iBluetoothDeviceId) GetIsLowEnergyDevice() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsLowEnergyDevice,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -99,7 +157,7 @@ func (v *iBluetoothDeviceId) GetIsLowEnergyDevice() (bool, error) {
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothDeviceId", "get_IsLowEnergyDevice")
 	}
 
 	return out, nil
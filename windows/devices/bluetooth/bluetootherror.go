@@ -5,10 +5,23 @@
 //nolint:all
 package bluetooth
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameBluetoothError string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothError uint32 = 0x00010000
+
+// BluetoothError was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothError int32
 
 const SignatureBluetoothError string = "enum(Windows.Devices.Bluetooth.BluetoothError;i4)"
 
+func (v BluetoothError) Signature() string {
+	return SignatureBluetoothError
+}
+
 const (
 	BluetoothErrorSuccess               BluetoothError = 0
 	BluetoothErrorRadioNotAvailable     BluetoothError = 1
@@ -21,3 +34,64 @@ const (
 	BluetoothErrorConsentRequired       BluetoothError = 8
 	BluetoothErrorTransportNotSupported BluetoothError = 9
 )
+
+var namesBluetoothError = map[BluetoothError]string{
+	BluetoothErrorSuccess:               "Success",
+	BluetoothErrorRadioNotAvailable:     "RadioNotAvailable",
+	BluetoothErrorResourceInUse:         "ResourceInUse",
+	BluetoothErrorDeviceNotConnected:    "DeviceNotConnected",
+	BluetoothErrorOtherError:            "OtherError",
+	BluetoothErrorDisabledByPolicy:      "DisabledByPolicy",
+	BluetoothErrorNotSupported:          "NotSupported",
+	BluetoothErrorDisabledByUser:        "DisabledByUser",
+	BluetoothErrorConsentRequired:       "ConsentRequired",
+	BluetoothErrorTransportNotSupported: "TransportNotSupported",
+}
+
+var valuesBluetoothError = map[string]BluetoothError{
+	"Success":               BluetoothErrorSuccess,
+	"RadioNotAvailable":     BluetoothErrorRadioNotAvailable,
+	"ResourceInUse":         BluetoothErrorResourceInUse,
+	"DeviceNotConnected":    BluetoothErrorDeviceNotConnected,
+	"OtherError":            BluetoothErrorOtherError,
+	"DisabledByPolicy":      BluetoothErrorDisabledByPolicy,
+	"NotSupported":          BluetoothErrorNotSupported,
+	"DisabledByUser":        BluetoothErrorDisabledByUser,
+	"ConsentRequired":       BluetoothErrorConsentRequired,
+	"TransportNotSupported": BluetoothErrorTransportNotSupported,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v BluetoothError) String() string {
+	if name, ok := namesBluetoothError[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseBluetoothError parses the name of a value, as returned by String.
+func ParseBluetoothError(s string) (BluetoothError, error) {
+	if v, ok := valuesBluetoothError[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid BluetoothError %q", s)
+	}
+	return BluetoothError(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BluetoothError) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BluetoothError) UnmarshalText(text []byte) error {
+	parsed, err := ParseBluetoothError(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
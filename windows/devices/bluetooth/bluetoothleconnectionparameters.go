@@ -6,34 +6,70 @@
 package bluetooth
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEConnectionParameters string = "rc(Windows.Devices.Bluetooth.BluetoothLEConnectionParameters;{33cb0771-8da9-508f-a366-1ca388c929ab})"
 
+const ContractNameBluetoothLEConnectionParameters string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEConnectionParameters uint32 = 0x000d0000
+
+// BluetoothLEConnectionParameters was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type BluetoothLEConnectionParameters struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEConnectionParameters) Signature() string {
+	return SignatureBluetoothLEConnectionParameters
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.BluetoothLEConnectionParameters class is available in the running version of Windows.
+func (impl *BluetoothLEConnectionParameters) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.BluetoothLEConnectionParameters")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEConnectionParameters, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEConnectionParameters) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetLinkTimeout", "GetConnectionLatency", "GetConnectionInterval":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothLEConnectionParameters")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEConnectionParameters", name)
+}
+
 func (impl *BluetoothLEConnectionParameters) GetLinkTimeout() (uint16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEConnectionParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEConnectionParameters, "Windows.Devices.Bluetooth.IBluetoothLEConnectionParameters")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEConnectionParameters)(unsafe.Pointer(itf))
 	return v.GetLinkTimeout()
 }
 
 func (impl *BluetoothLEConnectionParameters) GetConnectionLatency() (uint16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEConnectionParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEConnectionParameters, "Windows.Devices.Bluetooth.IBluetoothLEConnectionParameters")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEConnectionParameters)(unsafe.Pointer(itf))
 	return v.GetConnectionLatency()
 }
 
 func (impl *BluetoothLEConnectionParameters) GetConnectionInterval() (uint16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEConnectionParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEConnectionParameters, "Windows.Devices.Bluetooth.IBluetoothLEConnectionParameters")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEConnectionParameters)(unsafe.Pointer(itf))
 	return v.GetConnectionInterval()
@@ -42,10 +78,20 @@ func (impl *BluetoothLEConnectionParameters) GetConnectionInterval() (uint16, er
 const GUIDiBluetoothLEConnectionParameters string = "33cb0771-8da9-508f-a366-1ca388c929ab"
 const SignatureiBluetoothLEConnectionParameters string = "{33cb0771-8da9-508f-a366-1ca388c929ab}"
 
+var IIDiBluetoothLEConnectionParameters = ole.GUID{Data1: 0x33CB0771, Data2: 0x8DA9, Data3: 0x508F, Data4: [8]byte{0xA3, 0x66, 0x1C, 0xA3, 0x88, 0xC9, 0x29, 0xAB}}
+
+const ContractNameiBluetoothLEConnectionParameters string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEConnectionParameters uint32 = 0x000d0000
+
+// iBluetoothLEConnectionParameters was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type iBluetoothLEConnectionParameters struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEConnectionParameters) Signature() string {
+	return SignatureiBluetoothLEConnectionParameters
+}
+
 type iBluetoothLEConnectionParametersVtbl struct {
 	ole.IInspectableVtbl
 
@@ -60,6 +106,10 @@ func (v *iBluetoothLEConnectionParameters) VTable() *iBluetoothLEConnectionParam
 
 func (v *iBluetoothLEConnectionParameters) GetLinkTimeout() (uint16, error) {
 	var out uint16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetLinkTimeout,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -67,7 +117,7 @@ func (v *iBluetoothLEConnectionParameters) GetLinkTimeout() (uint16, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEConnectionParameters", "get_LinkTimeout")
 	}
 
 	return out, nil
@@ -75,6 +125,10 @@ func (v *iBluetoothLEConnectionParameters) GetLinkTimeout() (uint16, error) {
 
 func (v *iBluetoothLEConnectionParameters) GetConnectionLatency() (uint16, error) {
 	var out uint16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetConnectionLatency,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -82,7 +136,7 @@ func (v *iBluetoothLEConnectionParameters) GetConnectionLatency() (uint16, error
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEConnectionParameters", "get_ConnectionLatency")
 	}
 
 	return out, nil
@@ -90,6 +144,10 @@ func (v *iBluetoothLEConnectionParameters) GetConnectionLatency() (uint16, error
 
 func (v *iBluetoothLEConnectionParameters) GetConnectionInterval() (uint16, error) {
 	var out uint16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetConnectionInterval,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -97,7 +155,7 @@ func (v *iBluetoothLEConnectionParameters) GetConnectionInterval() (uint16, erro
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEConnectionParameters", "get_ConnectionInterval")
 	}
 
 	return out, nil
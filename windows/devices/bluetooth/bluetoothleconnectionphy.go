@@ -6,27 +6,60 @@
 package bluetooth
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEConnectionPhy string = "rc(Windows.Devices.Bluetooth.BluetoothLEConnectionPhy;{781e5e48-621e-5a7e-8be6-1b9561ff63c9})"
 
+const ContractNameBluetoothLEConnectionPhy string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEConnectionPhy uint32 = 0x000d0000
+
+// BluetoothLEConnectionPhy was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type BluetoothLEConnectionPhy struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEConnectionPhy) Signature() string {
+	return SignatureBluetoothLEConnectionPhy
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.BluetoothLEConnectionPhy class is available in the running version of Windows.
+func (impl *BluetoothLEConnectionPhy) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.BluetoothLEConnectionPhy")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEConnectionPhy, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEConnectionPhy) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetTransmitInfo", "GetReceiveInfo":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothLEConnectionPhy")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEConnectionPhy", name)
+}
+
 func (impl *BluetoothLEConnectionPhy) GetTransmitInfo() (*BluetoothLEConnectionPhyInfo, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEConnectionPhy))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEConnectionPhy, "Windows.Devices.Bluetooth.IBluetoothLEConnectionPhy")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEConnectionPhy)(unsafe.Pointer(itf))
 	return v.GetTransmitInfo()
 }
 
 func (impl *BluetoothLEConnectionPhy) GetReceiveInfo() (*BluetoothLEConnectionPhyInfo, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEConnectionPhy))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEConnectionPhy, "Windows.Devices.Bluetooth.IBluetoothLEConnectionPhy")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEConnectionPhy)(unsafe.Pointer(itf))
 	return v.GetReceiveInfo()
@@ -35,10 +68,20 @@ func (impl *BluetoothLEConnectionPhy) GetReceiveInfo() (*BluetoothLEConnectionPh
 const GUIDiBluetoothLEConnectionPhy string = "781e5e48-621e-5a7e-8be6-1b9561ff63c9"
 const SignatureiBluetoothLEConnectionPhy string = "{781e5e48-621e-5a7e-8be6-1b9561ff63c9}"
 
+var IIDiBluetoothLEConnectionPhy = ole.GUID{Data1: 0x781E5E48, Data2: 0x621E, Data3: 0x5A7E, Data4: [8]byte{0x8B, 0xE6, 0x1B, 0x95, 0x61, 0xFF, 0x63, 0xC9}}
+
+const ContractNameiBluetoothLEConnectionPhy string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEConnectionPhy uint32 = 0x000d0000
+
+// iBluetoothLEConnectionPhy was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type iBluetoothLEConnectionPhy struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEConnectionPhy) Signature() string {
+	return SignatureiBluetoothLEConnectionPhy
+}
+
 type iBluetoothLEConnectionPhyVtbl struct {
 	ole.IInspectableVtbl
 
@@ -52,6 +95,10 @@ func (v *iBluetoothLEConnectionPhy) VTable() *iBluetoothLEConnectionPhyVtbl {
 
 func (v *iBluetoothLEConnectionPhy) GetTransmitInfo() (*BluetoothLEConnectionPhyInfo, error) {
 	var out *BluetoothLEConnectionPhyInfo
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetTransmitInfo,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -59,7 +106,7 @@ func (v *iBluetoothLEConnectionPhy) GetTransmitInfo() (*BluetoothLEConnectionPhy
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEConnectionPhy", "get_TransmitInfo")
 	}
 
 	return out, nil
@@ -67,6 +114,10 @@ func (v *iBluetoothLEConnectionPhy) GetTransmitInfo() (*BluetoothLEConnectionPhy
 
 func (v *iBluetoothLEConnectionPhy) GetReceiveInfo() (*BluetoothLEConnectionPhyInfo, error) {
 	var out *BluetoothLEConnectionPhyInfo
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetReceiveInfo,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -74,7 +125,7 @@ func (v *iBluetoothLEConnectionPhy) GetReceiveInfo() (*BluetoothLEConnectionPhyI
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEConnectionPhy", "get_ReceiveInfo")
 	}
 
 	return out, nil
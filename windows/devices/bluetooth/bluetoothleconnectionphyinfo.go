@@ -6,34 +6,70 @@
 package bluetooth
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEConnectionPhyInfo string = "rc(Windows.Devices.Bluetooth.BluetoothLEConnectionPhyInfo;{9a100bdd-602e-5c27-a1ae-b230015a6394})"
 
+const ContractNameBluetoothLEConnectionPhyInfo string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEConnectionPhyInfo uint32 = 0x000d0000
+
+// BluetoothLEConnectionPhyInfo was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type BluetoothLEConnectionPhyInfo struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEConnectionPhyInfo) Signature() string {
+	return SignatureBluetoothLEConnectionPhyInfo
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.BluetoothLEConnectionPhyInfo class is available in the running version of Windows.
+func (impl *BluetoothLEConnectionPhyInfo) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.BluetoothLEConnectionPhyInfo")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEConnectionPhyInfo, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEConnectionPhyInfo) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetIsUncoded1MPhy", "GetIsUncoded2MPhy", "GetIsCodedPhy":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothLEConnectionPhyInfo")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEConnectionPhyInfo", name)
+}
+
 func (impl *BluetoothLEConnectionPhyInfo) GetIsUncoded1MPhy() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEConnectionPhyInfo))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEConnectionPhyInfo, "Windows.Devices.Bluetooth.IBluetoothLEConnectionPhyInfo")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEConnectionPhyInfo)(unsafe.Pointer(itf))
 	return v.GetIsUncoded1MPhy()
 }
 
 func (impl *BluetoothLEConnectionPhyInfo) GetIsUncoded2MPhy() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEConnectionPhyInfo))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEConnectionPhyInfo, "Windows.Devices.Bluetooth.IBluetoothLEConnectionPhyInfo")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEConnectionPhyInfo)(unsafe.Pointer(itf))
 	return v.GetIsUncoded2MPhy()
 }
 
 func (impl *BluetoothLEConnectionPhyInfo) GetIsCodedPhy() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEConnectionPhyInfo))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEConnectionPhyInfo, "Windows.Devices.Bluetooth.IBluetoothLEConnectionPhyInfo")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEConnectionPhyInfo)(unsafe.Pointer(itf))
 	return v.GetIsCodedPhy()
@@ -42,10 +78,20 @@ func (impl *BluetoothLEConnectionPhyInfo) GetIsCodedPhy() (bool, error) {
 const GUIDiBluetoothLEConnectionPhyInfo string = "9a100bdd-602e-5c27-a1ae-b230015a6394"
 const SignatureiBluetoothLEConnectionPhyInfo string = "{9a100bdd-602e-5c27-a1ae-b230015a6394}"
 
+var IIDiBluetoothLEConnectionPhyInfo = ole.GUID{Data1: 0x9A100BDD, Data2: 0x602E, Data3: 0x5C27, Data4: [8]byte{0xA1, 0xAE, 0xB2, 0x30, 0x01, 0x5A, 0x63, 0x94}}
+
+const ContractNameiBluetoothLEConnectionPhyInfo string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEConnectionPhyInfo uint32 = 0x000d0000
+
+// iBluetoothLEConnectionPhyInfo was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type iBluetoothLEConnectionPhyInfo struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEConnectionPhyInfo) Signature() string {
+	return SignatureiBluetoothLEConnectionPhyInfo
+}
+
 type iBluetoothLEConnectionPhyInfoVtbl struct {
 	ole.IInspectableVtbl
 
@@ -60,6 +106,10 @@ func (v *iBluetoothLEConnectionPhyInfo) VTable() *iBluetoothLEConnectionPhyInfoV
 
 func (v *iBluetoothLEConnectionPhyInfo) GetIsUncoded1MPhy() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsUncoded1MPhy,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -67,7 +117,7 @@ func (v *iBluetoothLEConnectionPhyInfo) GetIsUncoded1MPhy() (bool, error) {
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEConnectionPhyInfo", "get_IsUncoded1MPhy")
 	}
 
 	return out, nil
@@ -75,6 +125,10 @@ func (v *iBluetoothLEConnectionPhyInfo) GetIsUncoded1MPhy() (bool, error) {
 
 func (v *iBluetoothLEConnectionPhyInfo) GetIsUncoded2MPhy() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsUncoded2MPhy,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -82,7 +136,7 @@ func (v *iBluetoothLEConnectionPhyInfo) GetIsUncoded2MPhy() (bool, error) {
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEConnectionPhyInfo", "get_IsUncoded2MPhy")
 	}
 
 	return out, nil
@@ -90,6 +144,10 @@ func (v *iBluetoothLEConnectionPhyInfo) GetIsUncoded2MPhy() (bool, error) {
 
 func (v *iBluetoothLEConnectionPhyInfo) GetIsCodedPhy() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsCodedPhy,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -97,7 +155,7 @@ func (v *iBluetoothLEConnectionPhyInfo) GetIsCodedPhy() (bool, error) {
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEConnectionPhyInfo", "get_IsCodedPhy")
 	}
 
 	return out, nil
@@ -6,112 +6,262 @@
 package bluetooth
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEDevice string = "rc(Windows.Devices.Bluetooth.BluetoothLEDevice;{b5ee2f7b-4ad8-4642-ac48-80a0b500e887})"
 
+const ContractNameBluetoothLEDevice string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEDevice uint32 = 0x00010000
+
+// BluetoothLEDevice was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type BluetoothLEDevice struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEDevice) Signature() string {
+	return SignatureBluetoothLEDevice
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.BluetoothLEDevice class is available in the running version of Windows.
+func (impl *BluetoothLEDevice) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.BluetoothLEDevice")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEDevice, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEDevice) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetConnectionStatus", "AddConnectionStatusChanged", "OnConnectionStatusChanged", "RemoveConnectionStatusChanged":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothLEDevice")
+	case "GetBluetoothDeviceId":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothLEDevice4")
+	case "GetConnectionParameters", "GetConnectionPhy", "RequestPreferredConnectionParameters", "AddConnectionParametersChanged", "OnConnectionParametersChanged", "RemoveConnectionParametersChanged", "AddConnectionPhyChanged", "OnConnectionPhyChanged", "RemoveConnectionPhyChanged":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothLEDevice6")
+	case "Close":
+		return metadata.ApiInformationIsTypePresent("Windows.Foundation.IClosable")
+	case "BluetoothLEDeviceFromBluetoothAddressWithBluetoothAddressTypeAsync":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothLEDeviceStatics2")
+	case "BluetoothLEDeviceFromBluetoothAddressAsync":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothLEDeviceStatics")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEDevice", name)
+}
+
 func (impl *BluetoothLEDevice) GetConnectionStatus() (BluetoothConnectionStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice, "Windows.Devices.Bluetooth.IBluetoothLEDevice")
+	if err != nil {
+		return BluetoothConnectionStatusDisconnected, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEDevice)(unsafe.Pointer(itf))
 	return v.GetConnectionStatus()
 }
 
-func (impl *BluetoothLEDevice) AddConnectionStatusChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice))
+func (impl *BluetoothLEDevice) AddConnectionStatusChanged(handler *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice, "Windows.Devices.Bluetooth.IBluetoothLEDevice")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEDevice)(unsafe.Pointer(itf))
 	return v.AddConnectionStatusChanged(handler)
 }
 
-func (impl *BluetoothLEDevice) RemoveConnectionStatusChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice))
-	defer itf.Release()
-	v := (*iBluetoothLEDevice)(unsafe.Pointer(itf))
-	return v.RemoveConnectionStatusChanged(token)
-}
+// OnConnectionStatusChanged registers the given function as a handler of the event, using AddConnectionStatusChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveConnectionStatusChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *BluetoothLEDevice) OnConnectionStatusChanged(callback func(sender *BluetoothLEDevice, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*BluetoothLEDevice, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer], sender *BluetoothLEDevice, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddConnectionStatusChanged(handler)
+	if err != nil {
+		return nil, err
+	}
 
-func (impl *BluetoothLEDevice) GetGattServicesAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice3))
-	defer itf.Release()
-	v := (*iBluetoothLEDevice3)(unsafe.Pointer(itf))
-	return v.GetGattServicesAsync()
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveConnectionStatusChanged(token)
+		})
+		return removeErr
+	}, nil
 }
 
-func (impl *BluetoothLEDevice) GetGattServicesWithCacheModeAsync(cacheMode BluetoothCacheMode) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice3))
+func (impl *BluetoothLEDevice) RemoveConnectionStatusChanged(token foundation.EventRegistrationToken) error {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice, "Windows.Devices.Bluetooth.IBluetoothLEDevice")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
-	v := (*iBluetoothLEDevice3)(unsafe.Pointer(itf))
-	return v.GetGattServicesWithCacheModeAsync(cacheMode)
+	v := (*iBluetoothLEDevice)(unsafe.Pointer(itf))
+	return v.RemoveConnectionStatusChanged(token)
 }
 
 func (impl *BluetoothLEDevice) GetBluetoothDeviceId() (*BluetoothDeviceId, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice4))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice4, "Windows.Devices.Bluetooth.IBluetoothLEDevice4")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEDevice4)(unsafe.Pointer(itf))
 	return v.GetBluetoothDeviceId()
 }
 
 func (impl *BluetoothLEDevice) GetConnectionParameters() (*BluetoothLEConnectionParameters, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice6))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice6, "Windows.Devices.Bluetooth.IBluetoothLEDevice6")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEDevice6)(unsafe.Pointer(itf))
 	return v.GetConnectionParameters()
 }
 
 func (impl *BluetoothLEDevice) GetConnectionPhy() (*BluetoothLEConnectionPhy, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice6))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice6, "Windows.Devices.Bluetooth.IBluetoothLEDevice6")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEDevice6)(unsafe.Pointer(itf))
 	return v.GetConnectionPhy()
 }
 
 func (impl *BluetoothLEDevice) RequestPreferredConnectionParameters(preferredConnectionParameters *BluetoothLEPreferredConnectionParameters) (*BluetoothLEPreferredConnectionParametersRequest, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice6))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice6, "Windows.Devices.Bluetooth.IBluetoothLEDevice6")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEDevice6)(unsafe.Pointer(itf))
 	return v.RequestPreferredConnectionParameters(preferredConnectionParameters)
 }
 
-func (impl *BluetoothLEDevice) AddConnectionParametersChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice6))
+func (impl *BluetoothLEDevice) AddConnectionParametersChanged(handler *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice6, "Windows.Devices.Bluetooth.IBluetoothLEDevice6")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEDevice6)(unsafe.Pointer(itf))
 	return v.AddConnectionParametersChanged(handler)
 }
 
+// OnConnectionParametersChanged registers the given function as a handler of the event, using AddConnectionParametersChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveConnectionParametersChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *BluetoothLEDevice) OnConnectionParametersChanged(callback func(sender *BluetoothLEDevice, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*BluetoothLEDevice, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer], sender *BluetoothLEDevice, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddConnectionParametersChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveConnectionParametersChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *BluetoothLEDevice) RemoveConnectionParametersChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice6))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice6, "Windows.Devices.Bluetooth.IBluetoothLEDevice6")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEDevice6)(unsafe.Pointer(itf))
 	return v.RemoveConnectionParametersChanged(token)
 }
 
-func (impl *BluetoothLEDevice) AddConnectionPhyChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice6))
+func (impl *BluetoothLEDevice) AddConnectionPhyChanged(handler *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice6, "Windows.Devices.Bluetooth.IBluetoothLEDevice6")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEDevice6)(unsafe.Pointer(itf))
 	return v.AddConnectionPhyChanged(handler)
 }
 
+// OnConnectionPhyChanged registers the given function as a handler of the event, using AddConnectionPhyChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveConnectionPhyChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *BluetoothLEDevice) OnConnectionPhyChanged(callback func(sender *BluetoothLEDevice, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*BluetoothLEDevice, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer], sender *BluetoothLEDevice, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddConnectionPhyChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveConnectionPhyChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *BluetoothLEDevice) RemoveConnectionPhyChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEDevice6))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEDevice6, "Windows.Devices.Bluetooth.IBluetoothLEDevice6")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEDevice6)(unsafe.Pointer(itf))
 	return v.RemoveConnectionPhyChanged(token)
 }
 
 func (impl *BluetoothLEDevice) Close() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(foundation.GUIDIClosable))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &foundation.IIDIClosable, "Windows.Foundation.IClosable")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*foundation.IClosable)(unsafe.Pointer(itf))
 	return v.Close()
@@ -120,10 +270,20 @@ func (impl *BluetoothLEDevice) Close() error {
 const GUIDiBluetoothLEDevice string = "b5ee2f7b-4ad8-4642-ac48-80a0b500e887"
 const SignatureiBluetoothLEDevice string = "{b5ee2f7b-4ad8-4642-ac48-80a0b500e887}"
 
+var IIDiBluetoothLEDevice = ole.GUID{Data1: 0xB5EE2F7B, Data2: 0x4AD8, Data3: 0x4642, Data4: [8]byte{0xAC, 0x48, 0x80, 0xA0, 0xB5, 0x00, 0xE8, 0x87}}
+
+const ContractNameiBluetoothLEDevice string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEDevice uint32 = 0x00010000
+
+// iBluetoothLEDevice was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iBluetoothLEDevice struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEDevice) Signature() string {
+	return SignatureiBluetoothLEDevice
+}
+
 type iBluetoothLEDeviceVtbl struct {
 	ole.IInspectableVtbl
 
@@ -147,6 +307,10 @@ func (v *iBluetoothLEDevice) VTable() *iBluetoothLEDeviceVtbl {
 
 func (v *iBluetoothLEDevice) GetConnectionStatus() (BluetoothConnectionStatus, error) {
 	var out BluetoothConnectionStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetConnectionStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -154,37 +318,81 @@ func (v *iBluetoothLEDevice) GetConnectionStatus() (BluetoothConnectionStatus, e
 	)
 
 	if hr != 0 {
-		return BluetoothConnectionStatusDisconnected, ole.NewError(hr)
+		return BluetoothConnectionStatusDisconnected, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDevice", "get_ConnectionStatus")
 	}
 
 	return out, nil
 }
 
-func (v *iBluetoothLEDevice) AddConnectionStatusChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iBluetoothLEDevice) AddConnectionStatusChanged(handler *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddConnectionStatusChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDevice", "add_ConnectionStatusChanged")
 	}
 
 	return out, nil
 }
 
+// OnConnectionStatusChanged registers the given function as a handler of the event, using AddConnectionStatusChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveConnectionStatusChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iBluetoothLEDevice) OnConnectionStatusChanged(callback func(sender *BluetoothLEDevice, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*BluetoothLEDevice, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer], sender *BluetoothLEDevice, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddConnectionStatusChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveConnectionStatusChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iBluetoothLEDevice) RemoveConnectionStatusChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveConnectionStatusChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveConnectionStatusChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDevice", "remove_ConnectionStatusChanged")
 	}
 
 	return nil
@@ -193,10 +401,20 @@ func (v *iBluetoothLEDevice) RemoveConnectionStatusChanged(token foundation.Even
 const GUIDiBluetoothLEDevice2 string = "26f062b3-7aee-4d31-baba-b1b9775f5916"
 const SignatureiBluetoothLEDevice2 string = "{26f062b3-7aee-4d31-baba-b1b9775f5916}"
 
+var IIDiBluetoothLEDevice2 = ole.GUID{Data1: 0x26F062B3, Data2: 0x7AEE, Data3: 0x4D31, Data4: [8]byte{0xBA, 0xBA, 0xB1, 0xB9, 0x77, 0x5F, 0x59, 0x16}}
+
+const ContractNameiBluetoothLEDevice2 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEDevice2 uint32 = 0x00020000
+
+// iBluetoothLEDevice2 was introduced in Windows.Foundation.UniversalApiContract v2.0.
 type iBluetoothLEDevice2 struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEDevice2) Signature() string {
+	return SignatureiBluetoothLEDevice2
+}
+
 type iBluetoothLEDevice2Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -212,10 +430,20 @@ func (v *iBluetoothLEDevice2) VTable() *iBluetoothLEDevice2Vtbl {
 const GUIDiBluetoothLEDevice3 string = "aee9e493-44ac-40dc-af33-b2c13c01ca46"
 const SignatureiBluetoothLEDevice3 string = "{aee9e493-44ac-40dc-af33-b2c13c01ca46}"
 
+var IIDiBluetoothLEDevice3 = ole.GUID{Data1: 0xAEE9E493, Data2: 0x44AC, Data3: 0x40DC, Data4: [8]byte{0xAF, 0x33, 0xB2, 0xC1, 0x3C, 0x01, 0xCA, 0x46}}
+
+const ContractNameiBluetoothLEDevice3 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEDevice3 uint32 = 0x00040000
+
+// iBluetoothLEDevice3 was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iBluetoothLEDevice3 struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEDevice3) Signature() string {
+	return SignatureiBluetoothLEDevice3
+}
+
 type iBluetoothLEDevice3Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -231,44 +459,23 @@ func (v *iBluetoothLEDevice3) VTable() *iBluetoothLEDevice3Vtbl {
 	return (*iBluetoothLEDevice3Vtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func (v *iBluetoothLEDevice3) GetGattServicesAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().GetGattServicesAsync,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
-	)
-
-	if hr != 0 {
-		return nil, ole.NewError(hr)
-	}
-
-	return out, nil
-}
-
-func (v *iBluetoothLEDevice3) GetGattServicesWithCacheModeAsync(cacheMode BluetoothCacheMode) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().GetGattServicesWithCacheModeAsync,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(cacheMode),            // in BluetoothCacheMode
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
-	)
-
-	if hr != 0 {
-		return nil, ole.NewError(hr)
-	}
-
-	return out, nil
-}
-
 const GUIDiBluetoothLEDevice4 string = "2b605031-2248-4b2f-acf0-7cee36fc5870"
 const SignatureiBluetoothLEDevice4 string = "{2b605031-2248-4b2f-acf0-7cee36fc5870}"
 
+var IIDiBluetoothLEDevice4 = ole.GUID{Data1: 0x2B605031, Data2: 0x2248, Data3: 0x4B2F, Data4: [8]byte{0xAC, 0xF0, 0x7C, 0xEE, 0x36, 0xFC, 0x58, 0x70}}
+
+const ContractNameiBluetoothLEDevice4 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEDevice4 uint32 = 0x00050000
+
+// iBluetoothLEDevice4 was introduced in Windows.Foundation.UniversalApiContract v5.0.
 type iBluetoothLEDevice4 struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEDevice4) Signature() string {
+	return SignatureiBluetoothLEDevice4
+}
+
 type iBluetoothLEDevice4Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -281,6 +488,10 @@ func (v *iBluetoothLEDevice4) VTable() *iBluetoothLEDevice4Vtbl {
 
 func (v *iBluetoothLEDevice4) GetBluetoothDeviceId() (*BluetoothDeviceId, error) {
 	var out *BluetoothDeviceId
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetBluetoothDeviceId,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -288,7 +499,7 @@ func (v *iBluetoothLEDevice4) GetBluetoothDeviceId() (*BluetoothDeviceId, error)
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDevice4", "get_BluetoothDeviceId")
 	}
 
 	return out, nil
@@ -297,10 +508,20 @@ func (v *iBluetoothLEDevice4) GetBluetoothDeviceId() (*BluetoothDeviceId, error)
 const GUIDiBluetoothLEDevice5 string = "9d6a1260-5287-458e-95ba-17c8b7bb326e"
 const SignatureiBluetoothLEDevice5 string = "{9d6a1260-5287-458e-95ba-17c8b7bb326e}"
 
+var IIDiBluetoothLEDevice5 = ole.GUID{Data1: 0x9D6A1260, Data2: 0x5287, Data3: 0x458E, Data4: [8]byte{0x95, 0xBA, 0x17, 0xC8, 0xB7, 0xBB, 0x32, 0x6E}}
+
+const ContractNameiBluetoothLEDevice5 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEDevice5 uint32 = 0x00060000
+
+// iBluetoothLEDevice5 was introduced in Windows.Foundation.UniversalApiContract v6.0.
 type iBluetoothLEDevice5 struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEDevice5) Signature() string {
+	return SignatureiBluetoothLEDevice5
+}
+
 type iBluetoothLEDevice5Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -314,10 +535,20 @@ func (v *iBluetoothLEDevice5) VTable() *iBluetoothLEDevice5Vtbl {
 const GUIDiBluetoothLEDevice6 string = "ca7190ef-0cae-573c-a1ca-e1fc5bfc39e2"
 const SignatureiBluetoothLEDevice6 string = "{ca7190ef-0cae-573c-a1ca-e1fc5bfc39e2}"
 
+var IIDiBluetoothLEDevice6 = ole.GUID{Data1: 0xCA7190EF, Data2: 0x0CAE, Data3: 0x573C, Data4: [8]byte{0xA1, 0xCA, 0xE1, 0xFC, 0x5B, 0xFC, 0x39, 0xE2}}
+
+const ContractNameiBluetoothLEDevice6 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEDevice6 uint32 = 0x000d0000
+
+// iBluetoothLEDevice6 was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type iBluetoothLEDevice6 struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEDevice6) Signature() string {
+	return SignatureiBluetoothLEDevice6
+}
+
 type iBluetoothLEDevice6Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -336,6 +567,10 @@ func (v *iBluetoothLEDevice6) VTable() *iBluetoothLEDevice6Vtbl {
 
 func (v *iBluetoothLEDevice6) GetConnectionParameters() (*BluetoothLEConnectionParameters, error) {
 	var out *BluetoothLEConnectionParameters
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetConnectionParameters,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -343,7 +578,7 @@ func (v *iBluetoothLEDevice6) GetConnectionParameters() (*BluetoothLEConnectionP
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDevice6", "GetConnectionParameters")
 	}
 
 	return out, nil
@@ -351,6 +586,10 @@ func (v *iBluetoothLEDevice6) GetConnectionParameters() (*BluetoothLEConnectionP
 
 func (v *iBluetoothLEDevice6) GetConnectionPhy() (*BluetoothLEConnectionPhy, error) {
 	var out *BluetoothLEConnectionPhy
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetConnectionPhy,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -358,7 +597,7 @@ func (v *iBluetoothLEDevice6) GetConnectionPhy() (*BluetoothLEConnectionPhy, err
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDevice6", "GetConnectionPhy")
 	}
 
 	return out, nil
@@ -366,6 +605,10 @@ func (v *iBluetoothLEDevice6) GetConnectionPhy() (*BluetoothLEConnectionPhy, err
 
 func (v *iBluetoothLEDevice6) RequestPreferredConnectionParameters(preferredConnectionParameters *BluetoothLEPreferredConnectionParameters) (*BluetoothLEPreferredConnectionParametersRequest, error) {
 	var out *BluetoothLEPreferredConnectionParametersRequest
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().RequestPreferredConnectionParameters,
 		uintptr(unsafe.Pointer(v)),                             // this
@@ -374,67 +617,155 @@ func (v *iBluetoothLEDevice6) RequestPreferredConnectionParameters(preferredConn
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDevice6", "RequestPreferredConnectionParameters")
 	}
 
 	return out, nil
 }
 
-func (v *iBluetoothLEDevice6) AddConnectionParametersChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iBluetoothLEDevice6) AddConnectionParametersChanged(handler *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddConnectionParametersChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDevice6", "add_ConnectionParametersChanged")
 	}
 
 	return out, nil
 }
 
+// OnConnectionParametersChanged registers the given function as a handler of the event, using AddConnectionParametersChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveConnectionParametersChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iBluetoothLEDevice6) OnConnectionParametersChanged(callback func(sender *BluetoothLEDevice, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*BluetoothLEDevice, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer], sender *BluetoothLEDevice, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddConnectionParametersChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveConnectionParametersChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iBluetoothLEDevice6) RemoveConnectionParametersChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveConnectionParametersChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveConnectionParametersChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDevice6", "remove_ConnectionParametersChanged")
 	}
 
 	return nil
 }
 
-func (v *iBluetoothLEDevice6) AddConnectionPhyChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iBluetoothLEDevice6) AddConnectionPhyChanged(handler *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddConnectionPhyChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDevice6", "add_ConnectionPhyChanged")
 	}
 
 	return out, nil
 }
 
+// OnConnectionPhyChanged registers the given function as a handler of the event, using AddConnectionPhyChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveConnectionPhyChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iBluetoothLEDevice6) OnConnectionPhyChanged(callback func(sender *BluetoothLEDevice, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*BluetoothLEDevice, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*BluetoothLEDevice, unsafe.Pointer], sender *BluetoothLEDevice, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddConnectionPhyChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveConnectionPhyChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iBluetoothLEDevice6) RemoveConnectionPhyChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveConnectionPhyChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveConnectionPhyChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDevice6", "remove_ConnectionPhyChanged")
 	}
 
 	return nil
@@ -443,10 +774,20 @@ func (v *iBluetoothLEDevice6) RemoveConnectionPhyChanged(token foundation.EventR
 const GUIDiBluetoothLEDeviceStatics2 string = "5f12c06b-3bac-43e8-ad16-563271bd41c2"
 const SignatureiBluetoothLEDeviceStatics2 string = "{5f12c06b-3bac-43e8-ad16-563271bd41c2}"
 
+var IIDiBluetoothLEDeviceStatics2 = ole.GUID{Data1: 0x5F12C06B, Data2: 0x3BAC, Data3: 0x43E8, Data4: [8]byte{0xAD, 0x16, 0x56, 0x32, 0x71, 0xBD, 0x41, 0xC2}}
+
+const ContractNameiBluetoothLEDeviceStatics2 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEDeviceStatics2 uint32 = 0x00020000
+
+// iBluetoothLEDeviceStatics2 was introduced in Windows.Foundation.UniversalApiContract v2.0.
 type iBluetoothLEDeviceStatics2 struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEDeviceStatics2) Signature() string {
+	return SignatureiBluetoothLEDeviceStatics2
+}
+
 type iBluetoothLEDeviceStatics2Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -463,24 +804,35 @@ func (v *iBluetoothLEDeviceStatics2) VTable() *iBluetoothLEDeviceStatics2Vtbl {
 	return (*iBluetoothLEDeviceStatics2Vtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func BluetoothLEDeviceFromBluetoothAddressWithBluetoothAddressTypeAsync(bluetoothAddress uint64, bluetoothAddressType BluetoothAddressType) (*foundation.IAsyncOperation, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Devices.Bluetooth.BluetoothLEDevice", ole.NewGUID(GUIDiBluetoothLEDeviceStatics2))
+func BluetoothLEDeviceFromBluetoothAddressWithBluetoothAddressTypeAsync(bluetoothAddress uint64, bluetoothAddressType BluetoothAddressType) (*IAsyncOperationBluetoothLEDevice, error) {
+	inspectable, err := winrt.GetActivationFactory("Windows.Devices.Bluetooth.BluetoothLEDevice", &IIDiBluetoothLEDeviceStatics2)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iBluetoothLEDeviceStatics2)(unsafe.Pointer(inspectable))
 
-	var out *foundation.IAsyncOperation
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().BluetoothLEDeviceFromBluetoothAddressWithBluetoothAddressTypeAsync,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(bluetoothAddress),     // in uint64
-		uintptr(bluetoothAddressType), // in BluetoothAddressType
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
-	)
+	var out *IAsyncOperationBluetoothLEDevice
+	bluetoothAddressABI, err := winrt.NewInValue(bluetoothAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer bluetoothAddressABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	bluetoothAddressSlots := bluetoothAddressABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 3+len(bluetoothAddressSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v)))    // this
+	callArgs = append(callArgs, bluetoothAddressSlots...)      // in uint64
+	callArgs = append(callArgs, uintptr(bluetoothAddressType)) // in BluetoothAddressType
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(&out))) // out IAsyncOperationBluetoothLEDevice
+	hr, _, _ := syscall.SyscallN(v.VTable().BluetoothLEDeviceFromBluetoothAddressWithBluetoothAddressTypeAsync, callArgs...)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDeviceStatics2", "FromBluetoothAddressWithBluetoothAddressTypeAsync")
 	}
 
 	return out, nil
@@ -489,10 +841,20 @@ func BluetoothLEDeviceFromBluetoothAddressWithBluetoothAddressTypeAsync(bluetoot
 const GUIDiBluetoothLEDeviceStatics string = "c8cf1a19-f0b6-4bf0-8689-41303de2d9f4"
 const SignatureiBluetoothLEDeviceStatics string = "{c8cf1a19-f0b6-4bf0-8689-41303de2d9f4}"
 
+var IIDiBluetoothLEDeviceStatics = ole.GUID{Data1: 0xC8CF1A19, Data2: 0xF0B6, Data3: 0x4BF0, Data4: [8]byte{0x86, 0x89, 0x41, 0x30, 0x3D, 0xE2, 0xD9, 0xF4}}
+
+const ContractNameiBluetoothLEDeviceStatics string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEDeviceStatics uint32 = 0x00010000
+
+// iBluetoothLEDeviceStatics was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iBluetoothLEDeviceStatics struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEDeviceStatics) Signature() string {
+	return SignatureiBluetoothLEDeviceStatics
+}
+
 type iBluetoothLEDeviceStaticsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -505,23 +867,34 @@ func (v *iBluetoothLEDeviceStatics) VTable() *iBluetoothLEDeviceStaticsVtbl {
 	return (*iBluetoothLEDeviceStaticsVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func BluetoothLEDeviceFromBluetoothAddressAsync(bluetoothAddress uint64) (*foundation.IAsyncOperation, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Devices.Bluetooth.BluetoothLEDevice", ole.NewGUID(GUIDiBluetoothLEDeviceStatics))
+func BluetoothLEDeviceFromBluetoothAddressAsync(bluetoothAddress uint64) (*IAsyncOperationBluetoothLEDevice, error) {
+	inspectable, err := winrt.GetActivationFactory("Windows.Devices.Bluetooth.BluetoothLEDevice", &IIDiBluetoothLEDeviceStatics)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iBluetoothLEDeviceStatics)(unsafe.Pointer(inspectable))
 
-	var out *foundation.IAsyncOperation
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().BluetoothLEDeviceFromBluetoothAddressAsync,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(bluetoothAddress),     // in uint64
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
-	)
+	var out *IAsyncOperationBluetoothLEDevice
+	bluetoothAddressABI, err := winrt.NewInValue(bluetoothAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer bluetoothAddressABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	bluetoothAddressSlots := bluetoothAddressABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 2+len(bluetoothAddressSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v)))    // this
+	callArgs = append(callArgs, bluetoothAddressSlots...)      // in uint64
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(&out))) // out IAsyncOperationBluetoothLEDevice
+	hr, _, _ := syscall.SyscallN(v.VTable().BluetoothLEDeviceFromBluetoothAddressAsync, callArgs...)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEDeviceStatics", "FromBluetoothAddressAsync")
 	}
 
 	return out, nil
@@ -6,41 +6,82 @@
 package bluetooth
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEPreferredConnectionParameters string = "rc(Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParameters;{f2f44344-7372-5f7b-9b34-29c944f5a715})"
 
+const ContractNameBluetoothLEPreferredConnectionParameters string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEPreferredConnectionParameters uint32 = 0x000d0000
+
+// BluetoothLEPreferredConnectionParameters was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type BluetoothLEPreferredConnectionParameters struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEPreferredConnectionParameters) Signature() string {
+	return SignatureBluetoothLEPreferredConnectionParameters
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParameters class is available in the running version of Windows.
+func (impl *BluetoothLEPreferredConnectionParameters) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParameters")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEPreferredConnectionParameters, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEPreferredConnectionParameters) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetLinkTimeout", "GetConnectionLatency", "GetMinConnectionInterval", "GetMaxConnectionInterval":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParameters")
+	case "BluetoothLEPreferredConnectionParametersGetBalanced", "BluetoothLEPreferredConnectionParametersGetThroughputOptimized", "BluetoothLEPreferredConnectionParametersGetPowerOptimized":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParametersStatics")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEPreferredConnectionParameters", name)
+}
+
 func (impl *BluetoothLEPreferredConnectionParameters) GetLinkTimeout() (uint16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEPreferredConnectionParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEPreferredConnectionParameters, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParameters")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEPreferredConnectionParameters)(unsafe.Pointer(itf))
 	return v.GetLinkTimeout()
 }
 
 func (impl *BluetoothLEPreferredConnectionParameters) GetConnectionLatency() (uint16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEPreferredConnectionParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEPreferredConnectionParameters, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParameters")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEPreferredConnectionParameters)(unsafe.Pointer(itf))
 	return v.GetConnectionLatency()
 }
 
 func (impl *BluetoothLEPreferredConnectionParameters) GetMinConnectionInterval() (uint16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEPreferredConnectionParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEPreferredConnectionParameters, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParameters")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEPreferredConnectionParameters)(unsafe.Pointer(itf))
 	return v.GetMinConnectionInterval()
 }
 
 func (impl *BluetoothLEPreferredConnectionParameters) GetMaxConnectionInterval() (uint16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEPreferredConnectionParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEPreferredConnectionParameters, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParameters")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEPreferredConnectionParameters)(unsafe.Pointer(itf))
 	return v.GetMaxConnectionInterval()
@@ -49,10 +90,20 @@ func (impl *BluetoothLEPreferredConnectionParameters) GetMaxConnectionInterval()
 const GUIDiBluetoothLEPreferredConnectionParameters string = "f2f44344-7372-5f7b-9b34-29c944f5a715"
 const SignatureiBluetoothLEPreferredConnectionParameters string = "{f2f44344-7372-5f7b-9b34-29c944f5a715}"
 
+var IIDiBluetoothLEPreferredConnectionParameters = ole.GUID{Data1: 0xF2F44344, Data2: 0x7372, Data3: 0x5F7B, Data4: [8]byte{0x9B, 0x34, 0x29, 0xC9, 0x44, 0xF5, 0xA7, 0x15}}
+
+const ContractNameiBluetoothLEPreferredConnectionParameters string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEPreferredConnectionParameters uint32 = 0x000d0000
+
+// iBluetoothLEPreferredConnectionParameters was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type iBluetoothLEPreferredConnectionParameters struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEPreferredConnectionParameters) Signature() string {
+	return SignatureiBluetoothLEPreferredConnectionParameters
+}
+
 type iBluetoothLEPreferredConnectionParametersVtbl struct {
 	ole.IInspectableVtbl
 
@@ -68,6 +119,10 @@ func (v *iBluetoothLEPreferredConnectionParameters) VTable() *iBluetoothLEPrefer
 
 func (v *iBluetoothLEPreferredConnectionParameters) GetLinkTimeout() (uint16, error) {
 	var out uint16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetLinkTimeout,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -75,7 +130,7 @@ func (v *iBluetoothLEPreferredConnectionParameters) GetLinkTimeout() (uint16, er
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParameters", "get_LinkTimeout")
 	}
 
 	return out, nil
@@ -83,6 +138,10 @@ func (v *iBluetoothLEPreferredConnectionParameters) GetLinkTimeout() (uint16, er
 
 func (v *iBluetoothLEPreferredConnectionParameters) GetConnectionLatency() (uint16, error) {
 	var out uint16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetConnectionLatency,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -90,7 +149,7 @@ func (v *iBluetoothLEPreferredConnectionParameters) GetConnectionLatency() (uint
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParameters", "get_ConnectionLatency")
 	}
 
 	return out, nil
@@ -98,6 +157,10 @@ func (v *iBluetoothLEPreferredConnectionParameters) GetConnectionLatency() (uint
 
 func (v *iBluetoothLEPreferredConnectionParameters) GetMinConnectionInterval() (uint16, error) {
 	var out uint16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetMinConnectionInterval,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -105,7 +168,7 @@ func (v *iBluetoothLEPreferredConnectionParameters) GetMinConnectionInterval() (
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParameters", "get_MinConnectionInterval")
 	}
 
 	return out, nil
@@ -113,6 +176,10 @@ func (v *iBluetoothLEPreferredConnectionParameters) GetMinConnectionInterval() (
 
 func (v *iBluetoothLEPreferredConnectionParameters) GetMaxConnectionInterval() (uint16, error) {
 	var out uint16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetMaxConnectionInterval,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -120,7 +187,7 @@ func (v *iBluetoothLEPreferredConnectionParameters) GetMaxConnectionInterval() (
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParameters", "get_MaxConnectionInterval")
 	}
 
 	return out, nil
@@ -129,10 +196,20 @@ func (v *iBluetoothLEPreferredConnectionParameters) GetMaxConnectionInterval() (
 const GUIDiBluetoothLEPreferredConnectionParametersStatics string = "0e3e8edc-2751-55aa-a838-8faeee818d72"
 const SignatureiBluetoothLEPreferredConnectionParametersStatics string = "{0e3e8edc-2751-55aa-a838-8faeee818d72}"
 
+var IIDiBluetoothLEPreferredConnectionParametersStatics = ole.GUID{Data1: 0x0E3E8EDC, Data2: 0x2751, Data3: 0x55AA, Data4: [8]byte{0xA8, 0x38, 0x8F, 0xAE, 0xEE, 0x81, 0x8D, 0x72}}
+
+const ContractNameiBluetoothLEPreferredConnectionParametersStatics string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEPreferredConnectionParametersStatics uint32 = 0x000d0000
+
+// iBluetoothLEPreferredConnectionParametersStatics was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type iBluetoothLEPreferredConnectionParametersStatics struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEPreferredConnectionParametersStatics) Signature() string {
+	return SignatureiBluetoothLEPreferredConnectionParametersStatics
+}
+
 type iBluetoothLEPreferredConnectionParametersStaticsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -146,13 +223,18 @@ func (v *iBluetoothLEPreferredConnectionParametersStatics) VTable() *iBluetoothL
 }
 
 func BluetoothLEPreferredConnectionParametersGetBalanced() (*BluetoothLEPreferredConnectionParameters, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParameters", ole.NewGUID(GUIDiBluetoothLEPreferredConnectionParametersStatics))
+	inspectable, err := winrt.GetActivationFactory("Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParameters", &IIDiBluetoothLEPreferredConnectionParametersStatics)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iBluetoothLEPreferredConnectionParametersStatics)(unsafe.Pointer(inspectable))
 
 	var out *BluetoothLEPreferredConnectionParameters
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().BluetoothLEPreferredConnectionParametersGetBalanced,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -160,20 +242,25 @@ func BluetoothLEPreferredConnectionParametersGetBalanced() (*BluetoothLEPreferre
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParametersStatics", "get_Balanced")
 	}
 
 	return out, nil
 }
 
 func BluetoothLEPreferredConnectionParametersGetThroughputOptimized() (*BluetoothLEPreferredConnectionParameters, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParameters", ole.NewGUID(GUIDiBluetoothLEPreferredConnectionParametersStatics))
+	inspectable, err := winrt.GetActivationFactory("Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParameters", &IIDiBluetoothLEPreferredConnectionParametersStatics)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iBluetoothLEPreferredConnectionParametersStatics)(unsafe.Pointer(inspectable))
 
 	var out *BluetoothLEPreferredConnectionParameters
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().BluetoothLEPreferredConnectionParametersGetThroughputOptimized,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -181,20 +268,25 @@ func BluetoothLEPreferredConnectionParametersGetThroughputOptimized() (*Bluetoot
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParametersStatics", "get_ThroughputOptimized")
 	}
 
 	return out, nil
 }
 
 func BluetoothLEPreferredConnectionParametersGetPowerOptimized() (*BluetoothLEPreferredConnectionParameters, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParameters", ole.NewGUID(GUIDiBluetoothLEPreferredConnectionParametersStatics))
+	inspectable, err := winrt.GetActivationFactory("Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParameters", &IIDiBluetoothLEPreferredConnectionParametersStatics)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iBluetoothLEPreferredConnectionParametersStatics)(unsafe.Pointer(inspectable))
 
 	var out *BluetoothLEPreferredConnectionParameters
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().BluetoothLEPreferredConnectionParametersGetPowerOptimized,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -202,7 +294,7 @@ func BluetoothLEPreferredConnectionParametersGetPowerOptimized() (*BluetoothLEPr
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParametersStatics", "get_PowerOptimized")
 	}
 
 	return out, nil
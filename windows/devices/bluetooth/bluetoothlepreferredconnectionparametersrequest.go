@@ -6,28 +6,63 @@
 package bluetooth
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBluetoothLEPreferredConnectionParametersRequest string = "rc(Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParametersRequest;{8a375276-a528-5266-b661-cce6a5ff9739})"
 
+const ContractNameBluetoothLEPreferredConnectionParametersRequest string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEPreferredConnectionParametersRequest uint32 = 0x000d0000
+
+// BluetoothLEPreferredConnectionParametersRequest was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type BluetoothLEPreferredConnectionParametersRequest struct {
 	ole.IUnknown
 }
 
+func (impl *BluetoothLEPreferredConnectionParametersRequest) Signature() string {
+	return SignatureBluetoothLEPreferredConnectionParametersRequest
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParametersRequest class is available in the running version of Windows.
+func (impl *BluetoothLEPreferredConnectionParametersRequest) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParametersRequest")
+}
+
+// IsMethodPresent reports whether the given method or static function of BluetoothLEPreferredConnectionParametersRequest, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *BluetoothLEPreferredConnectionParametersRequest) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetStatus":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParametersRequest")
+	case "Close":
+		return metadata.ApiInformationIsTypePresent("Windows.Foundation.IClosable")
+	}
+	return false, fmt.Errorf("unknown method %q of BluetoothLEPreferredConnectionParametersRequest", name)
+}
+
 func (impl *BluetoothLEPreferredConnectionParametersRequest) GetStatus() (BluetoothLEPreferredConnectionParametersRequestStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiBluetoothLEPreferredConnectionParametersRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiBluetoothLEPreferredConnectionParametersRequest, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParametersRequest")
+	if err != nil {
+		return BluetoothLEPreferredConnectionParametersRequestStatusUnspecified, err
+	}
 	defer itf.Release()
 	v := (*iBluetoothLEPreferredConnectionParametersRequest)(unsafe.Pointer(itf))
 	return v.GetStatus()
 }
 
 func (impl *BluetoothLEPreferredConnectionParametersRequest) Close() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(foundation.GUIDIClosable))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &foundation.IIDIClosable, "Windows.Foundation.IClosable")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*foundation.IClosable)(unsafe.Pointer(itf))
 	return v.Close()
@@ -36,10 +71,20 @@ func (impl *BluetoothLEPreferredConnectionParametersRequest) Close() error {
 const GUIDiBluetoothLEPreferredConnectionParametersRequest string = "8a375276-a528-5266-b661-cce6a5ff9739"
 const SignatureiBluetoothLEPreferredConnectionParametersRequest string = "{8a375276-a528-5266-b661-cce6a5ff9739}"
 
+var IIDiBluetoothLEPreferredConnectionParametersRequest = ole.GUID{Data1: 0x8A375276, Data2: 0xA528, Data3: 0x5266, Data4: [8]byte{0xB6, 0x61, 0xCC, 0xE6, 0xA5, 0xFF, 0x97, 0x39}}
+
+const ContractNameiBluetoothLEPreferredConnectionParametersRequest string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBluetoothLEPreferredConnectionParametersRequest uint32 = 0x000d0000
+
+// iBluetoothLEPreferredConnectionParametersRequest was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type iBluetoothLEPreferredConnectionParametersRequest struct {
 	ole.IInspectable
 }
 
+func (v *iBluetoothLEPreferredConnectionParametersRequest) Signature() string {
+	return SignatureiBluetoothLEPreferredConnectionParametersRequest
+}
+
 type iBluetoothLEPreferredConnectionParametersRequestVtbl struct {
 	ole.IInspectableVtbl
 
@@ -52,6 +97,10 @@ func (v *iBluetoothLEPreferredConnectionParametersRequest) VTable() *iBluetoothL
 
 func (v *iBluetoothLEPreferredConnectionParametersRequest) GetStatus() (BluetoothLEPreferredConnectionParametersRequestStatus, error) {
 	var out BluetoothLEPreferredConnectionParametersRequestStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -59,7 +108,7 @@ func (v *iBluetoothLEPreferredConnectionParametersRequest) GetStatus() (Bluetoot
 	)
 
 	if hr != 0 {
-		return BluetoothLEPreferredConnectionParametersRequestStatusUnspecified, ole.NewError(hr)
+		return BluetoothLEPreferredConnectionParametersRequestStatusUnspecified, winrt.NewError(hr, "Windows.Devices.Bluetooth.IBluetoothLEPreferredConnectionParametersRequest", "get_Status")
 	}
 
 	return out, nil
@@ -5,13 +5,75 @@
 //nolint:all
 package bluetooth
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameBluetoothLEPreferredConnectionParametersRequestStatus string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBluetoothLEPreferredConnectionParametersRequestStatus uint32 = 0x000d0000
+
+// BluetoothLEPreferredConnectionParametersRequestStatus was introduced in Windows.Foundation.UniversalApiContract v13.0.
 type BluetoothLEPreferredConnectionParametersRequestStatus int32
 
 const SignatureBluetoothLEPreferredConnectionParametersRequestStatus string = "enum(Windows.Devices.Bluetooth.BluetoothLEPreferredConnectionParametersRequestStatus;i4)"
 
+func (v BluetoothLEPreferredConnectionParametersRequestStatus) Signature() string {
+	return SignatureBluetoothLEPreferredConnectionParametersRequestStatus
+}
+
 const (
 	BluetoothLEPreferredConnectionParametersRequestStatusUnspecified        BluetoothLEPreferredConnectionParametersRequestStatus = 0
 	BluetoothLEPreferredConnectionParametersRequestStatusSuccess            BluetoothLEPreferredConnectionParametersRequestStatus = 1
 	BluetoothLEPreferredConnectionParametersRequestStatusDeviceNotAvailable BluetoothLEPreferredConnectionParametersRequestStatus = 2
 	BluetoothLEPreferredConnectionParametersRequestStatusAccessDenied       BluetoothLEPreferredConnectionParametersRequestStatus = 3
 )
+
+var namesBluetoothLEPreferredConnectionParametersRequestStatus = map[BluetoothLEPreferredConnectionParametersRequestStatus]string{
+	BluetoothLEPreferredConnectionParametersRequestStatusUnspecified:        "Unspecified",
+	BluetoothLEPreferredConnectionParametersRequestStatusSuccess:            "Success",
+	BluetoothLEPreferredConnectionParametersRequestStatusDeviceNotAvailable: "DeviceNotAvailable",
+	BluetoothLEPreferredConnectionParametersRequestStatusAccessDenied:       "AccessDenied",
+}
+
+var valuesBluetoothLEPreferredConnectionParametersRequestStatus = map[string]BluetoothLEPreferredConnectionParametersRequestStatus{
+	"Unspecified":        BluetoothLEPreferredConnectionParametersRequestStatusUnspecified,
+	"Success":            BluetoothLEPreferredConnectionParametersRequestStatusSuccess,
+	"DeviceNotAvailable": BluetoothLEPreferredConnectionParametersRequestStatusDeviceNotAvailable,
+	"AccessDenied":       BluetoothLEPreferredConnectionParametersRequestStatusAccessDenied,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v BluetoothLEPreferredConnectionParametersRequestStatus) String() string {
+	if name, ok := namesBluetoothLEPreferredConnectionParametersRequestStatus[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseBluetoothLEPreferredConnectionParametersRequestStatus parses the name of a value, as returned by String.
+func ParseBluetoothLEPreferredConnectionParametersRequestStatus(s string) (BluetoothLEPreferredConnectionParametersRequestStatus, error) {
+	if v, ok := valuesBluetoothLEPreferredConnectionParametersRequestStatus[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid BluetoothLEPreferredConnectionParametersRequestStatus %q", s)
+	}
+	return BluetoothLEPreferredConnectionParametersRequestStatus(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BluetoothLEPreferredConnectionParametersRequestStatus) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BluetoothLEPreferredConnectionParametersRequestStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseBluetoothLEPreferredConnectionParametersRequestStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -6,79 +6,164 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/devices/bluetooth"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/storage/streams"
 )
 
 const SignatureGattCharacteristic string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattCharacteristic;{59cb50c1-5934-4f68-a198-eb864fa44e6b})"
 
+const ContractNameGattCharacteristic string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattCharacteristic uint32 = 0x00010000
+
+// GattCharacteristic was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type GattCharacteristic struct {
 	ole.IUnknown
 }
 
+func (impl *GattCharacteristic) Signature() string {
+	return SignatureGattCharacteristic
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattCharacteristic class is available in the running version of Windows.
+func (impl *GattCharacteristic) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattCharacteristic")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattCharacteristic, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattCharacteristic) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetCharacteristicProperties", "GetUuid", "ReadValueAsync", "ReadValueWithCacheModeAsync", "WriteValueAsync", "WriteValueWithOptionAsync", "WriteClientCharacteristicConfigurationDescriptorAsync", "AddValueChanged", "OnValueChanged", "RemoveValueChanged":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic")
+	}
+	return false, fmt.Errorf("unknown method %q of GattCharacteristic", name)
+}
+
 func (impl *GattCharacteristic) GetCharacteristicProperties() (GattCharacteristicProperties, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic")
+	if err != nil {
+		return GattCharacteristicPropertiesNone, err
+	}
 	defer itf.Release()
 	v := (*iGattCharacteristic)(unsafe.Pointer(itf))
 	return v.GetCharacteristicProperties()
 }
 
 func (impl *GattCharacteristic) GetUuid() (syscall.GUID, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic")
+	if err != nil {
+		return syscall.GUID{}, err
+	}
 	defer itf.Release()
 	v := (*iGattCharacteristic)(unsafe.Pointer(itf))
 	return v.GetUuid()
 }
 
-func (impl *GattCharacteristic) ReadValueAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattCharacteristic))
+func (impl *GattCharacteristic) ReadValueAsync() (*IAsyncOperationGattReadResult, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattCharacteristic)(unsafe.Pointer(itf))
 	return v.ReadValueAsync()
 }
 
-func (impl *GattCharacteristic) ReadValueWithCacheModeAsync(cacheMode bluetooth.BluetoothCacheMode) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattCharacteristic))
+func (impl *GattCharacteristic) ReadValueWithCacheModeAsync(cacheMode bluetooth.BluetoothCacheMode) (*IAsyncOperationGattReadResult, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattCharacteristic)(unsafe.Pointer(itf))
 	return v.ReadValueWithCacheModeAsync(cacheMode)
 }
 
-func (impl *GattCharacteristic) WriteValueAsync(value *streams.IBuffer) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattCharacteristic))
+func (impl *GattCharacteristic) WriteValueAsync(value *streams.IBuffer) (*IAsyncOperationGattCommunicationStatus, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattCharacteristic)(unsafe.Pointer(itf))
 	return v.WriteValueAsync(value)
 }
 
-func (impl *GattCharacteristic) WriteValueWithOptionAsync(value *streams.IBuffer, writeOption GattWriteOption) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattCharacteristic))
+func (impl *GattCharacteristic) WriteValueWithOptionAsync(value *streams.IBuffer, writeOption GattWriteOption) (*IAsyncOperationGattCommunicationStatus, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattCharacteristic)(unsafe.Pointer(itf))
 	return v.WriteValueWithOptionAsync(value, writeOption)
 }
 
-func (impl *GattCharacteristic) WriteClientCharacteristicConfigurationDescriptorAsync(clientCharacteristicConfigurationDescriptorValue GattClientCharacteristicConfigurationDescriptorValue) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattCharacteristic))
+func (impl *GattCharacteristic) WriteClientCharacteristicConfigurationDescriptorAsync(clientCharacteristicConfigurationDescriptorValue GattClientCharacteristicConfigurationDescriptorValue) (*IAsyncOperationGattCommunicationStatus, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattCharacteristic)(unsafe.Pointer(itf))
 	return v.WriteClientCharacteristicConfigurationDescriptorAsync(clientCharacteristicConfigurationDescriptorValue)
 }
 
-func (impl *GattCharacteristic) AddValueChanged(valueChangedHandler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattCharacteristic))
+func (impl *GattCharacteristic) AddValueChanged(valueChangedHandler *foundation.TypedEventHandler[*GattCharacteristic, *GattValueChangedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGattCharacteristic)(unsafe.Pointer(itf))
 	return v.AddValueChanged(valueChangedHandler)
 }
 
+// OnValueChanged registers the given function as a handler of the event, using AddValueChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveValueChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GattCharacteristic) OnValueChanged(callback func(sender *GattCharacteristic, args *GattValueChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattCharacteristic, *GattValueChangedEventArgs](func(_ *foundation.TypedEventHandler[*GattCharacteristic, *GattValueChangedEventArgs], sender *GattCharacteristic, args *GattValueChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddValueChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveValueChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GattCharacteristic) RemoveValueChanged(valueChangedEventCookie foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattCharacteristic)(unsafe.Pointer(itf))
 	return v.RemoveValueChanged(valueChangedEventCookie)
@@ -87,10 +172,20 @@ func (impl *GattCharacteristic) RemoveValueChanged(valueChangedEventCookie found
 const GUIDiGattCharacteristic string = "59cb50c1-5934-4f68-a198-eb864fa44e6b"
 const SignatureiGattCharacteristic string = "{59cb50c1-5934-4f68-a198-eb864fa44e6b}"
 
+var IIDiGattCharacteristic = ole.GUID{Data1: 0x59CB50C1, Data2: 0x5934, Data3: 0x4F68, Data4: [8]byte{0xA1, 0x98, 0xEB, 0x86, 0x4F, 0xA4, 0x4E, 0x6B}}
+
+const ContractNameiGattCharacteristic string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattCharacteristic uint32 = 0x00010000
+
+// iGattCharacteristic was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iGattCharacteristic struct {
 	ole.IInspectable
 }
 
+func (v *iGattCharacteristic) Signature() string {
+	return SignatureiGattCharacteristic
+}
+
 type iGattCharacteristicVtbl struct {
 	ole.IInspectableVtbl
 
@@ -118,6 +213,10 @@ func (v *iGattCharacteristic) VTable() *iGattCharacteristicVtbl {
 
 func (v *iGattCharacteristic) GetCharacteristicProperties() (GattCharacteristicProperties, error) {
 	var out GattCharacteristicProperties
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCharacteristicProperties,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -125,7 +224,7 @@ func (v *iGattCharacteristic) GetCharacteristicProperties() (GattCharacteristicP
 	)
 
 	if hr != 0 {
-		return GattCharacteristicPropertiesNone, ole.NewError(hr)
+		return GattCharacteristicPropertiesNone, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic", "get_CharacteristicProperties")
 	}
 
 	return out, nil
@@ -133,6 +232,10 @@ func (v *iGattCharacteristic) GetCharacteristicProperties() (GattCharacteristicP
 
 func (v *iGattCharacteristic) GetUuid() (syscall.GUID, error) {
 	var out syscall.GUID
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetUuid,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -140,117 +243,181 @@ func (v *iGattCharacteristic) GetUuid() (syscall.GUID, error) {
 	)
 
 	if hr != 0 {
-		return syscall.GUID{}, ole.NewError(hr)
+		return syscall.GUID{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic", "get_Uuid")
 	}
 
 	return out, nil
 }
 
-func (v *iGattCharacteristic) ReadValueAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGattCharacteristic) ReadValueAsync() (*IAsyncOperationGattReadResult, error) {
+	var out *IAsyncOperationGattReadResult
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().ReadValueAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationGattReadResult
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic", "ReadValueAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGattCharacteristic) ReadValueWithCacheModeAsync(cacheMode bluetooth.BluetoothCacheMode) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGattCharacteristic) ReadValueWithCacheModeAsync(cacheMode bluetooth.BluetoothCacheMode) (*IAsyncOperationGattReadResult, error) {
+	var out *IAsyncOperationGattReadResult
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().ReadValueWithCacheModeAsync,
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(cacheMode),            // in bluetooth.BluetoothCacheMode
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationGattReadResult
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic", "ReadValueWithCacheModeAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGattCharacteristic) WriteValueAsync(value *streams.IBuffer) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGattCharacteristic) WriteValueAsync(value *streams.IBuffer) (*IAsyncOperationGattCommunicationStatus, error) {
+	var out *IAsyncOperationGattCommunicationStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().WriteValueAsync,
 		uintptr(unsafe.Pointer(v)),     // this
 		uintptr(unsafe.Pointer(value)), // in streams.IBuffer
-		uintptr(unsafe.Pointer(&out)),  // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)),  // out IAsyncOperationGattCommunicationStatus
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic", "WriteValueAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGattCharacteristic) WriteValueWithOptionAsync(value *streams.IBuffer, writeOption GattWriteOption) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGattCharacteristic) WriteValueWithOptionAsync(value *streams.IBuffer, writeOption GattWriteOption) (*IAsyncOperationGattCommunicationStatus, error) {
+	var out *IAsyncOperationGattCommunicationStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().WriteValueWithOptionAsync,
 		uintptr(unsafe.Pointer(v)),     // this
 		uintptr(unsafe.Pointer(value)), // in streams.IBuffer
 		uintptr(writeOption),           // in GattWriteOption
-		uintptr(unsafe.Pointer(&out)),  // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)),  // out IAsyncOperationGattCommunicationStatus
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic", "WriteValueWithOptionAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGattCharacteristic) WriteClientCharacteristicConfigurationDescriptorAsync(clientCharacteristicConfigurationDescriptorValue GattClientCharacteristicConfigurationDescriptorValue) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGattCharacteristic) WriteClientCharacteristicConfigurationDescriptorAsync(clientCharacteristicConfigurationDescriptorValue GattClientCharacteristicConfigurationDescriptorValue) (*IAsyncOperationGattCommunicationStatus, error) {
+	var out *IAsyncOperationGattCommunicationStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().WriteClientCharacteristicConfigurationDescriptorAsync,
 		uintptr(unsafe.Pointer(v)),                                // this
 		uintptr(clientCharacteristicConfigurationDescriptorValue), // in GattClientCharacteristicConfigurationDescriptorValue
-		uintptr(unsafe.Pointer(&out)),                             // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)),                             // out IAsyncOperationGattCommunicationStatus
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic", "WriteClientCharacteristicConfigurationDescriptorAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGattCharacteristic) AddValueChanged(valueChangedHandler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGattCharacteristic) AddValueChanged(valueChangedHandler *foundation.TypedEventHandler[*GattCharacteristic, *GattValueChangedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddValueChanged,
 		uintptr(unsafe.Pointer(v)),                   // this
-		uintptr(unsafe.Pointer(valueChangedHandler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(valueChangedHandler)), // in foundation.TypedEventHandler[*GattCharacteristic, *GattValueChangedEventArgs]
 		uintptr(unsafe.Pointer(&out)),                // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic", "add_ValueChanged")
 	}
 
 	return out, nil
 }
 
+// OnValueChanged registers the given function as a handler of the event, using AddValueChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveValueChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGattCharacteristic) OnValueChanged(callback func(sender *GattCharacteristic, args *GattValueChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattCharacteristic, *GattValueChangedEventArgs](func(_ *foundation.TypedEventHandler[*GattCharacteristic, *GattValueChangedEventArgs], sender *GattCharacteristic, args *GattValueChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddValueChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveValueChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGattCharacteristic) RemoveValueChanged(valueChangedEventCookie foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveValueChanged,
-		uintptr(unsafe.Pointer(v)),                        // this
-		uintptr(unsafe.Pointer(&valueChangedEventCookie)), // in foundation.EventRegistrationToken
-	)
+	valueChangedEventCookieABI, err := winrt.NewInValue(valueChangedEventCookie)
+	if err != nil {
+		return err
+	}
+	defer valueChangedEventCookieABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	valueChangedEventCookieSlots := valueChangedEventCookieABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(valueChangedEventCookieSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v)))      // this
+	callArgs = append(callArgs, valueChangedEventCookieSlots...) // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveValueChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristic", "remove_ValueChanged")
 	}
 
 	return nil
@@ -259,10 +426,20 @@ func (v *iGattCharacteristic) RemoveValueChanged(valueChangedEventCookie foundat
 const GUIDiGattCharacteristic2 string = "ae1ab578-ec06-4764-b780-9835a1d35d6e"
 const SignatureiGattCharacteristic2 string = "{ae1ab578-ec06-4764-b780-9835a1d35d6e}"
 
+var IIDiGattCharacteristic2 = ole.GUID{Data1: 0xAE1AB578, Data2: 0xEC06, Data3: 0x4764, Data4: [8]byte{0xB7, 0x80, 0x98, 0x35, 0xA1, 0xD3, 0x5D, 0x6E}}
+
+const ContractNameiGattCharacteristic2 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattCharacteristic2 uint32 = 0x00010000
+
+// iGattCharacteristic2 was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iGattCharacteristic2 struct {
 	ole.IInspectable
 }
 
+func (v *iGattCharacteristic2) Signature() string {
+	return SignatureiGattCharacteristic2
+}
+
 type iGattCharacteristic2Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -277,10 +454,20 @@ func (v *iGattCharacteristic2) VTable() *iGattCharacteristic2Vtbl {
 const GUIDiGattCharacteristic3 string = "3f3c663e-93d4-406b-b817-db81f8ed53b3"
 const SignatureiGattCharacteristic3 string = "{3f3c663e-93d4-406b-b817-db81f8ed53b3}"
 
+var IIDiGattCharacteristic3 = ole.GUID{Data1: 0x3F3C663E, Data2: 0x93D4, Data3: 0x406B, Data4: [8]byte{0xB8, 0x17, 0xDB, 0x81, 0xF8, 0xED, 0x53, 0xB3}}
+
+const ContractNameiGattCharacteristic3 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattCharacteristic3 uint32 = 0x00040000
+
+// iGattCharacteristic3 was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattCharacteristic3 struct {
 	ole.IInspectable
 }
 
+func (v *iGattCharacteristic3) Signature() string {
+	return SignatureiGattCharacteristic3
+}
+
 type iGattCharacteristic3Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -5,10 +5,24 @@
 //nolint:all
 package genericattributeprofile
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const ContractNameGattCharacteristicProperties string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattCharacteristicProperties uint32 = 0x00010000
+
+// GattCharacteristicProperties was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type GattCharacteristicProperties uint32
 
 const SignatureGattCharacteristicProperties string = "enum(Windows.Devices.Bluetooth.GenericAttributeProfile.GattCharacteristicProperties;u4)"
 
+func (v GattCharacteristicProperties) Signature() string {
+	return SignatureGattCharacteristicProperties
+}
+
 const (
 	GattCharacteristicPropertiesNone                      GattCharacteristicProperties = 0
 	GattCharacteristicPropertiesBroadcast                 GattCharacteristicProperties = 1
@@ -22,3 +36,117 @@ const (
 	GattCharacteristicPropertiesReliableWrites            GattCharacteristicProperties = 256
 	GattCharacteristicPropertiesWritableAuxiliaries       GattCharacteristicProperties = 512
 )
+
+var namesGattCharacteristicProperties = map[GattCharacteristicProperties]string{
+	GattCharacteristicPropertiesNone:                      "None",
+	GattCharacteristicPropertiesBroadcast:                 "Broadcast",
+	GattCharacteristicPropertiesRead:                      "Read",
+	GattCharacteristicPropertiesWriteWithoutResponse:      "WriteWithoutResponse",
+	GattCharacteristicPropertiesWrite:                     "Write",
+	GattCharacteristicPropertiesNotify:                    "Notify",
+	GattCharacteristicPropertiesIndicate:                  "Indicate",
+	GattCharacteristicPropertiesAuthenticatedSignedWrites: "AuthenticatedSignedWrites",
+	GattCharacteristicPropertiesExtendedProperties:        "ExtendedProperties",
+	GattCharacteristicPropertiesReliableWrites:            "ReliableWrites",
+	GattCharacteristicPropertiesWritableAuxiliaries:       "WritableAuxiliaries",
+}
+
+var valuesGattCharacteristicProperties = map[string]GattCharacteristicProperties{
+	"None":                      GattCharacteristicPropertiesNone,
+	"Broadcast":                 GattCharacteristicPropertiesBroadcast,
+	"Read":                      GattCharacteristicPropertiesRead,
+	"WriteWithoutResponse":      GattCharacteristicPropertiesWriteWithoutResponse,
+	"Write":                     GattCharacteristicPropertiesWrite,
+	"Notify":                    GattCharacteristicPropertiesNotify,
+	"Indicate":                  GattCharacteristicPropertiesIndicate,
+	"AuthenticatedSignedWrites": GattCharacteristicPropertiesAuthenticatedSignedWrites,
+	"ExtendedProperties":        GattCharacteristicPropertiesExtendedProperties,
+	"ReliableWrites":            GattCharacteristicPropertiesReliableWrites,
+	"WritableAuxiliaries":       GattCharacteristicPropertiesWritableAuxiliaries,
+}
+
+var flagsGattCharacteristicProperties = []GattCharacteristicProperties{
+	GattCharacteristicPropertiesBroadcast,
+	GattCharacteristicPropertiesRead,
+	GattCharacteristicPropertiesWriteWithoutResponse,
+	GattCharacteristicPropertiesWrite,
+	GattCharacteristicPropertiesNotify,
+	GattCharacteristicPropertiesIndicate,
+	GattCharacteristicPropertiesAuthenticatedSignedWrites,
+	GattCharacteristicPropertiesExtendedProperties,
+	GattCharacteristicPropertiesReliableWrites,
+	GattCharacteristicPropertiesWritableAuxiliaries,
+}
+
+// String returns the names of the flags set in v separated by "|".
+// Bits that do not belong to any known flag are written as a number.
+func (v GattCharacteristicProperties) String() string {
+	if name, ok := namesGattCharacteristicProperties[v]; ok {
+		return name
+	}
+
+	var parts []string
+	remaining := v
+	for _, f := range flagsGattCharacteristicProperties {
+		if remaining&f == f {
+			parts = append(parts, namesGattCharacteristicProperties[f])
+			remaining &^= f
+		}
+	}
+	if remaining != 0 {
+		parts = append(parts, strconv.FormatInt(int64(remaining), 10))
+	}
+	if len(parts) == 0 {
+		// an empty set of flags
+		return "0"
+	}
+	return strings.Join(parts, "|")
+}
+
+// Has returns true if all the bits of f are set in v.
+func (v GattCharacteristicProperties) Has(f GattCharacteristicProperties) bool {
+	return v&f == f
+}
+
+// Set returns a copy of v with the bits of f set.
+func (v GattCharacteristicProperties) Set(f GattCharacteristicProperties) GattCharacteristicProperties {
+	return v | f
+}
+
+// Clear returns a copy of v with the bits of f cleared.
+func (v GattCharacteristicProperties) Clear(f GattCharacteristicProperties) GattCharacteristicProperties {
+	return v &^ f
+}
+
+// ParseGattCharacteristicProperties parses a list of flag names separated by "|", as returned by String.
+func ParseGattCharacteristicProperties(s string) (GattCharacteristicProperties, error) {
+	var v GattCharacteristicProperties
+	for _, part := range strings.Split(s, "|") {
+		part = strings.TrimSpace(part)
+		if f, ok := valuesGattCharacteristicProperties[part]; ok {
+			v |= f
+			continue
+		}
+		n, err := strconv.ParseUint(part, 0, 32)
+		if err != nil {
+			return 0, fmt.Errorf("invalid GattCharacteristicProperties %q", s)
+		}
+		v |= GattCharacteristicProperties(n)
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v GattCharacteristicProperties) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *GattCharacteristicProperties) UnmarshalText(text []byte) error {
+	parsed, err := ParseGattCharacteristicProperties(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
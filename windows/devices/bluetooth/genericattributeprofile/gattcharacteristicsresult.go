@@ -6,28 +6,60 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
-	"github.com/saltosystems/winrt-go/windows/foundation/collections"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattCharacteristicsResult string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattCharacteristicsResult;{1194945c-b257-4f3e-9db7-f68bc9a9aef2})"
 
+const ContractNameGattCharacteristicsResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattCharacteristicsResult uint32 = 0x00040000
+
+// GattCharacteristicsResult was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattCharacteristicsResult struct {
 	ole.IUnknown
 }
 
+func (impl *GattCharacteristicsResult) Signature() string {
+	return SignatureGattCharacteristicsResult
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattCharacteristicsResult class is available in the running version of Windows.
+func (impl *GattCharacteristicsResult) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattCharacteristicsResult")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattCharacteristicsResult, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattCharacteristicsResult) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetStatus", "GetCharacteristics":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristicsResult")
+	}
+	return false, fmt.Errorf("unknown method %q of GattCharacteristicsResult", name)
+}
+
 func (impl *GattCharacteristicsResult) GetStatus() (GattCommunicationStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattCharacteristicsResult))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattCharacteristicsResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristicsResult")
+	if err != nil {
+		return GattCommunicationStatusSuccess, err
+	}
 	defer itf.Release()
 	v := (*iGattCharacteristicsResult)(unsafe.Pointer(itf))
 	return v.GetStatus()
 }
 
-func (impl *GattCharacteristicsResult) GetCharacteristics() (*collections.IVectorView, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattCharacteristicsResult))
+func (impl *GattCharacteristicsResult) GetCharacteristics() (*IVectorViewGattCharacteristic, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattCharacteristicsResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristicsResult")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattCharacteristicsResult)(unsafe.Pointer(itf))
 	return v.GetCharacteristics()
@@ -36,10 +68,20 @@ func (impl *GattCharacteristicsResult) GetCharacteristics() (*collections.IVecto
 const GUIDiGattCharacteristicsResult string = "1194945c-b257-4f3e-9db7-f68bc9a9aef2"
 const SignatureiGattCharacteristicsResult string = "{1194945c-b257-4f3e-9db7-f68bc9a9aef2}"
 
+var IIDiGattCharacteristicsResult = ole.GUID{Data1: 0x1194945C, Data2: 0xB257, Data3: 0x4F3E, Data4: [8]byte{0x9D, 0xB7, 0xF6, 0x8B, 0xC9, 0xA9, 0xAE, 0xF2}}
+
+const ContractNameiGattCharacteristicsResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattCharacteristicsResult uint32 = 0x00040000
+
+// iGattCharacteristicsResult was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattCharacteristicsResult struct {
 	ole.IInspectable
 }
 
+func (v *iGattCharacteristicsResult) Signature() string {
+	return SignatureiGattCharacteristicsResult
+}
+
 type iGattCharacteristicsResultVtbl struct {
 	ole.IInspectableVtbl
 
@@ -54,6 +96,10 @@ func (v *iGattCharacteristicsResult) VTable() *iGattCharacteristicsResultVtbl {
 
 func (v *iGattCharacteristicsResult) GetStatus() (GattCommunicationStatus, error) {
 	var out GattCommunicationStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -61,22 +107,26 @@ func (v *iGattCharacteristicsResult) GetStatus() (GattCommunicationStatus, error
 	)
 
 	if hr != 0 {
-		return GattCommunicationStatusSuccess, ole.NewError(hr)
+		return GattCommunicationStatusSuccess, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristicsResult", "get_Status")
 	}
 
 	return out, nil
 }
 
-func (v *iGattCharacteristicsResult) GetCharacteristics() (*collections.IVectorView, error) {
-	var out *collections.IVectorView
+func (v *iGattCharacteristicsResult) GetCharacteristics() (*IVectorViewGattCharacteristic, error) {
+	var out *IVectorViewGattCharacteristic
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCharacteristics,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVectorView
+		uintptr(unsafe.Pointer(&out)), // out IVectorViewGattCharacteristic
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattCharacteristicsResult", "get_Characteristics")
 	}
 
 	return out, nil
@@ -5,12 +5,72 @@
 //nolint:all
 package genericattributeprofile
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameGattClientCharacteristicConfigurationDescriptorValue string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattClientCharacteristicConfigurationDescriptorValue uint32 = 0x00010000
+
+// GattClientCharacteristicConfigurationDescriptorValue was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type GattClientCharacteristicConfigurationDescriptorValue int32
 
 const SignatureGattClientCharacteristicConfigurationDescriptorValue string = "enum(Windows.Devices.Bluetooth.GenericAttributeProfile.GattClientCharacteristicConfigurationDescriptorValue;i4)"
 
+func (v GattClientCharacteristicConfigurationDescriptorValue) Signature() string {
+	return SignatureGattClientCharacteristicConfigurationDescriptorValue
+}
+
 const (
 	GattClientCharacteristicConfigurationDescriptorValueNone     GattClientCharacteristicConfigurationDescriptorValue = 0
 	GattClientCharacteristicConfigurationDescriptorValueNotify   GattClientCharacteristicConfigurationDescriptorValue = 1
 	GattClientCharacteristicConfigurationDescriptorValueIndicate GattClientCharacteristicConfigurationDescriptorValue = 2
 )
+
+var namesGattClientCharacteristicConfigurationDescriptorValue = map[GattClientCharacteristicConfigurationDescriptorValue]string{
+	GattClientCharacteristicConfigurationDescriptorValueNone:     "None",
+	GattClientCharacteristicConfigurationDescriptorValueNotify:   "Notify",
+	GattClientCharacteristicConfigurationDescriptorValueIndicate: "Indicate",
+}
+
+var valuesGattClientCharacteristicConfigurationDescriptorValue = map[string]GattClientCharacteristicConfigurationDescriptorValue{
+	"None":     GattClientCharacteristicConfigurationDescriptorValueNone,
+	"Notify":   GattClientCharacteristicConfigurationDescriptorValueNotify,
+	"Indicate": GattClientCharacteristicConfigurationDescriptorValueIndicate,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v GattClientCharacteristicConfigurationDescriptorValue) String() string {
+	if name, ok := namesGattClientCharacteristicConfigurationDescriptorValue[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseGattClientCharacteristicConfigurationDescriptorValue parses the name of a value, as returned by String.
+func ParseGattClientCharacteristicConfigurationDescriptorValue(s string) (GattClientCharacteristicConfigurationDescriptorValue, error) {
+	if v, ok := valuesGattClientCharacteristicConfigurationDescriptorValue[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid GattClientCharacteristicConfigurationDescriptorValue %q", s)
+	}
+	return GattClientCharacteristicConfigurationDescriptorValue(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v GattClientCharacteristicConfigurationDescriptorValue) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *GattClientCharacteristicConfigurationDescriptorValue) UnmarshalText(text []byte) error {
+	parsed, err := ParseGattClientCharacteristicConfigurationDescriptorValue(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -6,42 +6,82 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
-	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattClientNotificationResult string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattClientNotificationResult;{506d5599-0112-419a-8e3b-ae21afabd2c2})"
 
+const ContractNameGattClientNotificationResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattClientNotificationResult uint32 = 0x00040000
+
+// GattClientNotificationResult was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattClientNotificationResult struct {
 	ole.IUnknown
 }
 
+func (impl *GattClientNotificationResult) Signature() string {
+	return SignatureGattClientNotificationResult
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattClientNotificationResult class is available in the running version of Windows.
+func (impl *GattClientNotificationResult) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattClientNotificationResult")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattClientNotificationResult, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattClientNotificationResult) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetSubscribedClient", "GetStatus", "GetProtocolError":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattClientNotificationResult")
+	case "GetBytesSent":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattClientNotificationResult2")
+	}
+	return false, fmt.Errorf("unknown method %q of GattClientNotificationResult", name)
+}
+
 func (impl *GattClientNotificationResult) GetSubscribedClient() (*GattSubscribedClient, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattClientNotificationResult))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattClientNotificationResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattClientNotificationResult")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattClientNotificationResult)(unsafe.Pointer(itf))
 	return v.GetSubscribedClient()
 }
 
 func (impl *GattClientNotificationResult) GetStatus() (GattCommunicationStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattClientNotificationResult))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattClientNotificationResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattClientNotificationResult")
+	if err != nil {
+		return GattCommunicationStatusSuccess, err
+	}
 	defer itf.Release()
 	v := (*iGattClientNotificationResult)(unsafe.Pointer(itf))
 	return v.GetStatus()
 }
 
-func (impl *GattClientNotificationResult) GetProtocolError() (*foundation.IReference, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattClientNotificationResult))
+func (impl *GattClientNotificationResult) GetProtocolError() (*IReferenceUInt8, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattClientNotificationResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattClientNotificationResult")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattClientNotificationResult)(unsafe.Pointer(itf))
 	return v.GetProtocolError()
 }
 
 func (impl *GattClientNotificationResult) GetBytesSent() (uint16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattClientNotificationResult2))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattClientNotificationResult2, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattClientNotificationResult2")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iGattClientNotificationResult2)(unsafe.Pointer(itf))
 	return v.GetBytesSent()
@@ -50,10 +90,20 @@ func (impl *GattClientNotificationResult) GetBytesSent() (uint16, error) {
 const GUIDiGattClientNotificationResult string = "506d5599-0112-419a-8e3b-ae21afabd2c2"
 const SignatureiGattClientNotificationResult string = "{506d5599-0112-419a-8e3b-ae21afabd2c2}"
 
+var IIDiGattClientNotificationResult = ole.GUID{Data1: 0x506D5599, Data2: 0x0112, Data3: 0x419A, Data4: [8]byte{0x8E, 0x3B, 0xAE, 0x21, 0xAF, 0xAB, 0xD2, 0xC2}}
+
+const ContractNameiGattClientNotificationResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattClientNotificationResult uint32 = 0x00040000
+
+// iGattClientNotificationResult was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattClientNotificationResult struct {
 	ole.IInspectable
 }
 
+func (v *iGattClientNotificationResult) Signature() string {
+	return SignatureiGattClientNotificationResult
+}
+
 type iGattClientNotificationResultVtbl struct {
 	ole.IInspectableVtbl
 
@@ -68,6 +118,10 @@ func (v *iGattClientNotificationResult) VTable() *iGattClientNotificationResultV
 
 func (v *iGattClientNotificationResult) GetSubscribedClient() (*GattSubscribedClient, error) {
 	var out *GattSubscribedClient
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetSubscribedClient,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -75,7 +129,7 @@ func (v *iGattClientNotificationResult) GetSubscribedClient() (*GattSubscribedCl
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattClientNotificationResult", "get_SubscribedClient")
 	}
 
 	return out, nil
@@ -83,6 +137,10 @@ func (v *iGattClientNotificationResult) GetSubscribedClient() (*GattSubscribedCl
 
 func (v *iGattClientNotificationResult) GetStatus() (GattCommunicationStatus, error) {
 	var out GattCommunicationStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -90,22 +148,26 @@ func (v *iGattClientNotificationResult) GetStatus() (GattCommunicationStatus, er
 	)
 
 	if hr != 0 {
-		return GattCommunicationStatusSuccess, ole.NewError(hr)
+		return GattCommunicationStatusSuccess, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattClientNotificationResult", "get_Status")
 	}
 
 	return out, nil
 }
 
-func (v *iGattClientNotificationResult) GetProtocolError() (*foundation.IReference, error) {
-	var out *foundation.IReference
+func (v *iGattClientNotificationResult) GetProtocolError() (*IReferenceUInt8, error) {
+	var out *IReferenceUInt8
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetProtocolError,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IReference
+		uintptr(unsafe.Pointer(&out)), // out IReferenceUInt8
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattClientNotificationResult", "get_ProtocolError")
 	}
 
 	return out, nil
@@ -114,10 +176,20 @@ func (v *iGattClientNotificationResult) GetProtocolError() (*foundation.IReferen
 const GUIDiGattClientNotificationResult2 string = "8faec497-45e0-497e-9582-29a1fe281ad5"
 const SignatureiGattClientNotificationResult2 string = "{8faec497-45e0-497e-9582-29a1fe281ad5}"
 
+var IIDiGattClientNotificationResult2 = ole.GUID{Data1: 0x8FAEC497, Data2: 0x45E0, Data3: 0x497E, Data4: [8]byte{0x95, 0x82, 0x29, 0xA1, 0xFE, 0x28, 0x1A, 0xD5}}
+
+const ContractNameiGattClientNotificationResult2 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattClientNotificationResult2 uint32 = 0x00050000
+
+// iGattClientNotificationResult2 was introduced in Windows.Foundation.UniversalApiContract v5.0.
 type iGattClientNotificationResult2 struct {
 	ole.IInspectable
 }
 
+func (v *iGattClientNotificationResult2) Signature() string {
+	return SignatureiGattClientNotificationResult2
+}
+
 type iGattClientNotificationResult2Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -130,6 +202,10 @@ func (v *iGattClientNotificationResult2) VTable() *iGattClientNotificationResult
 
 func (v *iGattClientNotificationResult2) GetBytesSent() (uint16, error) {
 	var out uint16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetBytesSent,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -137,7 +213,7 @@ func (v *iGattClientNotificationResult2) GetBytesSent() (uint16, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattClientNotificationResult2", "get_BytesSent")
 	}
 
 	return out, nil
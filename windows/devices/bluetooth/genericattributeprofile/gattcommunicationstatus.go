@@ -5,13 +5,75 @@
 //nolint:all
 package genericattributeprofile
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameGattCommunicationStatus string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattCommunicationStatus uint32 = 0x00010000
+
+// GattCommunicationStatus was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type GattCommunicationStatus int32
 
 const SignatureGattCommunicationStatus string = "enum(Windows.Devices.Bluetooth.GenericAttributeProfile.GattCommunicationStatus;i4)"
 
+func (v GattCommunicationStatus) Signature() string {
+	return SignatureGattCommunicationStatus
+}
+
 const (
 	GattCommunicationStatusSuccess       GattCommunicationStatus = 0
 	GattCommunicationStatusUnreachable   GattCommunicationStatus = 1
 	GattCommunicationStatusProtocolError GattCommunicationStatus = 2
 	GattCommunicationStatusAccessDenied  GattCommunicationStatus = 3
 )
+
+var namesGattCommunicationStatus = map[GattCommunicationStatus]string{
+	GattCommunicationStatusSuccess:       "Success",
+	GattCommunicationStatusUnreachable:   "Unreachable",
+	GattCommunicationStatusProtocolError: "ProtocolError",
+	GattCommunicationStatusAccessDenied:  "AccessDenied",
+}
+
+var valuesGattCommunicationStatus = map[string]GattCommunicationStatus{
+	"Success":       GattCommunicationStatusSuccess,
+	"Unreachable":   GattCommunicationStatusUnreachable,
+	"ProtocolError": GattCommunicationStatusProtocolError,
+	"AccessDenied":  GattCommunicationStatusAccessDenied,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v GattCommunicationStatus) String() string {
+	if name, ok := namesGattCommunicationStatus[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseGattCommunicationStatus parses the name of a value, as returned by String.
+func ParseGattCommunicationStatus(s string) (GattCommunicationStatus, error) {
+	if v, ok := valuesGattCommunicationStatus[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid GattCommunicationStatus %q", s)
+	}
+	return GattCommunicationStatus(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v GattCommunicationStatus) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *GattCommunicationStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseGattCommunicationStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
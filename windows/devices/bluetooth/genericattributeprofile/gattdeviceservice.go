@@ -6,43 +6,86 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/devices/bluetooth"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattDeviceService string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattDeviceService;{ac7b7c05-b33c-47cf-990f-6b8f5577df71})"
 
+const ContractNameGattDeviceService string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattDeviceService uint32 = 0x00010000
+
+// GattDeviceService was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type GattDeviceService struct {
 	ole.IUnknown
 }
 
+func (impl *GattDeviceService) Signature() string {
+	return SignatureGattDeviceService
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattDeviceService class is available in the running version of Windows.
+func (impl *GattDeviceService) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattDeviceService")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattDeviceService, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattDeviceService) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetUuid":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceService")
+	case "Close":
+		return metadata.ApiInformationIsTypePresent("Windows.Foundation.IClosable")
+	case "GetCharacteristicsAsync", "GetCharacteristicsWithCacheModeAsync":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceService3")
+	}
+	return false, fmt.Errorf("unknown method %q of GattDeviceService", name)
+}
+
 func (impl *GattDeviceService) GetUuid() (syscall.GUID, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattDeviceService))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattDeviceService, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceService")
+	if err != nil {
+		return syscall.GUID{}, err
+	}
 	defer itf.Release()
 	v := (*iGattDeviceService)(unsafe.Pointer(itf))
 	return v.GetUuid()
 }
 
 func (impl *GattDeviceService) Close() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(foundation.GUIDIClosable))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &foundation.IIDIClosable, "Windows.Foundation.IClosable")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*foundation.IClosable)(unsafe.Pointer(itf))
 	return v.Close()
 }
 
-func (impl *GattDeviceService) GetCharacteristicsAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattDeviceService3))
+func (impl *GattDeviceService) GetCharacteristicsAsync() (*IAsyncOperationGattCharacteristicsResult, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattDeviceService3, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceService3")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattDeviceService3)(unsafe.Pointer(itf))
 	return v.GetCharacteristicsAsync()
 }
 
-func (impl *GattDeviceService) GetCharacteristicsWithCacheModeAsync(cacheMode bluetooth.BluetoothCacheMode) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattDeviceService3))
+func (impl *GattDeviceService) GetCharacteristicsWithCacheModeAsync(cacheMode bluetooth.BluetoothCacheMode) (*IAsyncOperationGattCharacteristicsResult, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattDeviceService3, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceService3")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattDeviceService3)(unsafe.Pointer(itf))
 	return v.GetCharacteristicsWithCacheModeAsync(cacheMode)
@@ -51,10 +94,20 @@ func (impl *GattDeviceService) GetCharacteristicsWithCacheModeAsync(cacheMode bl
 const GUIDiGattDeviceService string = "ac7b7c05-b33c-47cf-990f-6b8f5577df71"
 const SignatureiGattDeviceService string = "{ac7b7c05-b33c-47cf-990f-6b8f5577df71}"
 
+var IIDiGattDeviceService = ole.GUID{Data1: 0xAC7B7C05, Data2: 0xB33C, Data3: 0x47CF, Data4: [8]byte{0x99, 0x0F, 0x6B, 0x8F, 0x55, 0x77, 0xDF, 0x71}}
+
+const ContractNameiGattDeviceService string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattDeviceService uint32 = 0x00010000
+
+// iGattDeviceService was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iGattDeviceService struct {
 	ole.IInspectable
 }
 
+func (v *iGattDeviceService) Signature() string {
+	return SignatureiGattDeviceService
+}
+
 type iGattDeviceServiceVtbl struct {
 	ole.IInspectableVtbl
 
@@ -71,6 +124,10 @@ func (v *iGattDeviceService) VTable() *iGattDeviceServiceVtbl {
 
 func (v *iGattDeviceService) GetUuid() (syscall.GUID, error) {
 	var out syscall.GUID
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetUuid,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -78,7 +135,7 @@ func (v *iGattDeviceService) GetUuid() (syscall.GUID, error) {
 	)
 
 	if hr != 0 {
-		return syscall.GUID{}, ole.NewError(hr)
+		return syscall.GUID{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceService", "get_Uuid")
 	}
 
 	return out, nil
@@ -87,10 +144,20 @@ func (v *iGattDeviceService) GetUuid() (syscall.GUID, error) {
 const GUIDiGattDeviceService2 string = "fc54520b-0b0d-4708-bae0-9ffd9489bc59"
 const SignatureiGattDeviceService2 string = "{fc54520b-0b0d-4708-bae0-9ffd9489bc59}"
 
+var IIDiGattDeviceService2 = ole.GUID{Data1: 0xFC54520B, Data2: 0x0B0D, Data3: 0x4708, Data4: [8]byte{0xBA, 0xE0, 0x9F, 0xFD, 0x94, 0x89, 0xBC, 0x59}}
+
+const ContractNameiGattDeviceService2 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattDeviceService2 uint32 = 0x00010000
+
+// iGattDeviceService2 was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iGattDeviceService2 struct {
 	ole.IInspectable
 }
 
+func (v *iGattDeviceService2) Signature() string {
+	return SignatureiGattDeviceService2
+}
+
 type iGattDeviceService2Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -107,10 +174,20 @@ func (v *iGattDeviceService2) VTable() *iGattDeviceService2Vtbl {
 const GUIDiGattDeviceService3 string = "b293a950-0c53-437c-a9b3-5c3210c6e569"
 const SignatureiGattDeviceService3 string = "{b293a950-0c53-437c-a9b3-5c3210c6e569}"
 
+var IIDiGattDeviceService3 = ole.GUID{Data1: 0xB293A950, Data2: 0x0C53, Data3: 0x437C, Data4: [8]byte{0xA9, 0xB3, 0x5C, 0x32, 0x10, 0xC6, 0xE5, 0x69}}
+
+const ContractNameiGattDeviceService3 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattDeviceService3 uint32 = 0x00040000
+
+// iGattDeviceService3 was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattDeviceService3 struct {
 	ole.IInspectable
 }
 
+func (v *iGattDeviceService3) Signature() string {
+	return SignatureiGattDeviceService3
+}
+
 type iGattDeviceService3Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -133,32 +210,40 @@ func (v *iGattDeviceService3) VTable() *iGattDeviceService3Vtbl {
 	return (*iGattDeviceService3Vtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func (v *iGattDeviceService3) GetCharacteristicsAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGattDeviceService3) GetCharacteristicsAsync() (*IAsyncOperationGattCharacteristicsResult, error) {
+	var out *IAsyncOperationGattCharacteristicsResult
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCharacteristicsAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationGattCharacteristicsResult
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceService3", "GetCharacteristicsAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGattDeviceService3) GetCharacteristicsWithCacheModeAsync(cacheMode bluetooth.BluetoothCacheMode) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGattDeviceService3) GetCharacteristicsWithCacheModeAsync(cacheMode bluetooth.BluetoothCacheMode) (*IAsyncOperationGattCharacteristicsResult, error) {
+	var out *IAsyncOperationGattCharacteristicsResult
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCharacteristicsWithCacheModeAsync,
 		uintptr(unsafe.Pointer(v)),    // this
 		uintptr(cacheMode),            // in bluetooth.BluetoothCacheMode
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationGattCharacteristicsResult
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceService3", "GetCharacteristicsWithCacheModeAsync")
 	}
 
 	return out, nil
@@ -6,28 +6,60 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
-	"github.com/saltosystems/winrt-go/windows/foundation/collections"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattDeviceServicesResult string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattDeviceServicesResult;{171dd3ee-016d-419d-838a-576cf475a3d8})"
 
+const ContractNameGattDeviceServicesResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattDeviceServicesResult uint32 = 0x00040000
+
+// GattDeviceServicesResult was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattDeviceServicesResult struct {
 	ole.IUnknown
 }
 
+func (impl *GattDeviceServicesResult) Signature() string {
+	return SignatureGattDeviceServicesResult
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattDeviceServicesResult class is available in the running version of Windows.
+func (impl *GattDeviceServicesResult) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattDeviceServicesResult")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattDeviceServicesResult, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattDeviceServicesResult) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetStatus", "GetServices":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceServicesResult")
+	}
+	return false, fmt.Errorf("unknown method %q of GattDeviceServicesResult", name)
+}
+
 func (impl *GattDeviceServicesResult) GetStatus() (GattCommunicationStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattDeviceServicesResult))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattDeviceServicesResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceServicesResult")
+	if err != nil {
+		return GattCommunicationStatusSuccess, err
+	}
 	defer itf.Release()
 	v := (*iGattDeviceServicesResult)(unsafe.Pointer(itf))
 	return v.GetStatus()
 }
 
-func (impl *GattDeviceServicesResult) GetServices() (*collections.IVectorView, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattDeviceServicesResult))
+func (impl *GattDeviceServicesResult) GetServices() (*IVectorViewGattDeviceService, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattDeviceServicesResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceServicesResult")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattDeviceServicesResult)(unsafe.Pointer(itf))
 	return v.GetServices()
@@ -36,10 +68,20 @@ func (impl *GattDeviceServicesResult) GetServices() (*collections.IVectorView, e
 const GUIDiGattDeviceServicesResult string = "171dd3ee-016d-419d-838a-576cf475a3d8"
 const SignatureiGattDeviceServicesResult string = "{171dd3ee-016d-419d-838a-576cf475a3d8}"
 
+var IIDiGattDeviceServicesResult = ole.GUID{Data1: 0x171DD3EE, Data2: 0x016D, Data3: 0x419D, Data4: [8]byte{0x83, 0x8A, 0x57, 0x6C, 0xF4, 0x75, 0xA3, 0xD8}}
+
+const ContractNameiGattDeviceServicesResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattDeviceServicesResult uint32 = 0x00040000
+
+// iGattDeviceServicesResult was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattDeviceServicesResult struct {
 	ole.IInspectable
 }
 
+func (v *iGattDeviceServicesResult) Signature() string {
+	return SignatureiGattDeviceServicesResult
+}
+
 type iGattDeviceServicesResultVtbl struct {
 	ole.IInspectableVtbl
 
@@ -54,6 +96,10 @@ func (v *iGattDeviceServicesResult) VTable() *iGattDeviceServicesResultVtbl {
 
 func (v *iGattDeviceServicesResult) GetStatus() (GattCommunicationStatus, error) {
 	var out GattCommunicationStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -61,22 +107,26 @@ func (v *iGattDeviceServicesResult) GetStatus() (GattCommunicationStatus, error)
 	)
 
 	if hr != 0 {
-		return GattCommunicationStatusSuccess, ole.NewError(hr)
+		return GattCommunicationStatusSuccess, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceServicesResult", "get_Status")
 	}
 
 	return out, nil
 }
 
-func (v *iGattDeviceServicesResult) GetServices() (*collections.IVectorView, error) {
-	var out *collections.IVectorView
+func (v *iGattDeviceServicesResult) GetServices() (*IVectorViewGattDeviceService, error) {
+	var out *IVectorViewGattDeviceService
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetServices,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVectorView
+		uintptr(unsafe.Pointer(&out)), // out IVectorViewGattDeviceService
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattDeviceServicesResult", "get_Services")
 	}
 
 	return out, nil
@@ -6,142 +6,313 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
-	"github.com/saltosystems/winrt-go/windows/foundation/collections"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/storage/streams"
 )
 
 const SignatureGattLocalCharacteristic string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalCharacteristic;{aede376d-5412-4d74-92a8-8deb8526829c})"
 
+const ContractNameGattLocalCharacteristic string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattLocalCharacteristic uint32 = 0x00040000
+
+// GattLocalCharacteristic was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattLocalCharacteristic struct {
 	ole.IUnknown
 }
 
+func (impl *GattLocalCharacteristic) Signature() string {
+	return SignatureGattLocalCharacteristic
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalCharacteristic class is available in the running version of Windows.
+func (impl *GattLocalCharacteristic) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalCharacteristic")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattLocalCharacteristic, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattLocalCharacteristic) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetUuid", "GetStaticValue", "GetCharacteristicProperties", "GetReadProtectionLevel", "GetWriteProtectionLevel", "CreateDescriptorAsync", "GetDescriptors", "GetUserDescription", "GetPresentationFormats", "GetSubscribedClients", "AddSubscribedClientsChanged", "OnSubscribedClientsChanged", "RemoveSubscribedClientsChanged", "AddReadRequested", "OnReadRequested", "RemoveReadRequested", "AddWriteRequested", "OnWriteRequested", "RemoveWriteRequested", "NotifyValueAsync", "NotifyValueForSubscribedClientAsync":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	}
+	return false, fmt.Errorf("unknown method %q of GattLocalCharacteristic", name)
+}
+
 func (impl *GattLocalCharacteristic) GetUuid() (syscall.GUID, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return syscall.GUID{}, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.GetUuid()
 }
 
 func (impl *GattLocalCharacteristic) GetStaticValue() (*streams.IBuffer, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.GetStaticValue()
 }
 
 func (impl *GattLocalCharacteristic) GetCharacteristicProperties() (GattCharacteristicProperties, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return GattCharacteristicPropertiesNone, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.GetCharacteristicProperties()
 }
 
 func (impl *GattLocalCharacteristic) GetReadProtectionLevel() (GattProtectionLevel, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return GattProtectionLevelPlain, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.GetReadProtectionLevel()
 }
 
 func (impl *GattLocalCharacteristic) GetWriteProtectionLevel() (GattProtectionLevel, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return GattProtectionLevelPlain, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.GetWriteProtectionLevel()
 }
 
-func (impl *GattLocalCharacteristic) CreateDescriptorAsync(descriptorUuid syscall.GUID, parameters *GattLocalDescriptorParameters) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+func (impl *GattLocalCharacteristic) CreateDescriptorAsync(descriptorUuid syscall.GUID, parameters *GattLocalDescriptorParameters) (*IAsyncOperationGattLocalDescriptorResult, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.CreateDescriptorAsync(descriptorUuid, parameters)
 }
 
-func (impl *GattLocalCharacteristic) GetDescriptors() (*collections.IVectorView, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+func (impl *GattLocalCharacteristic) GetDescriptors() (*IVectorViewGattLocalDescriptor, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.GetDescriptors()
 }
 
 func (impl *GattLocalCharacteristic) GetUserDescription() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return "", err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.GetUserDescription()
 }
 
-func (impl *GattLocalCharacteristic) GetPresentationFormats() (*collections.IVectorView, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+func (impl *GattLocalCharacteristic) GetPresentationFormats() (*IVectorViewGattPresentationFormat, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.GetPresentationFormats()
 }
 
-func (impl *GattLocalCharacteristic) GetSubscribedClients() (*collections.IVectorView, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+func (impl *GattLocalCharacteristic) GetSubscribedClients() (*IVectorViewGattSubscribedClient, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.GetSubscribedClients()
 }
 
-func (impl *GattLocalCharacteristic) AddSubscribedClientsChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+func (impl *GattLocalCharacteristic) AddSubscribedClientsChanged(handler *foundation.TypedEventHandler[*GattLocalCharacteristic, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.AddSubscribedClientsChanged(handler)
 }
 
+// OnSubscribedClientsChanged registers the given function as a handler of the event, using AddSubscribedClientsChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveSubscribedClientsChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GattLocalCharacteristic) OnSubscribedClientsChanged(callback func(sender *GattLocalCharacteristic, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattLocalCharacteristic, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*GattLocalCharacteristic, unsafe.Pointer], sender *GattLocalCharacteristic, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddSubscribedClientsChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveSubscribedClientsChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GattLocalCharacteristic) RemoveSubscribedClientsChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.RemoveSubscribedClientsChanged(token)
 }
 
-func (impl *GattLocalCharacteristic) AddReadRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+func (impl *GattLocalCharacteristic) AddReadRequested(handler *foundation.TypedEventHandler[*GattLocalCharacteristic, *GattReadRequestedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.AddReadRequested(handler)
 }
 
+// OnReadRequested registers the given function as a handler of the event, using AddReadRequested. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveReadRequested, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GattLocalCharacteristic) OnReadRequested(callback func(sender *GattLocalCharacteristic, args *GattReadRequestedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattLocalCharacteristic, *GattReadRequestedEventArgs](func(_ *foundation.TypedEventHandler[*GattLocalCharacteristic, *GattReadRequestedEventArgs], sender *GattLocalCharacteristic, args *GattReadRequestedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddReadRequested(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveReadRequested(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GattLocalCharacteristic) RemoveReadRequested(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.RemoveReadRequested(token)
 }
 
-func (impl *GattLocalCharacteristic) AddWriteRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+func (impl *GattLocalCharacteristic) AddWriteRequested(handler *foundation.TypedEventHandler[*GattLocalCharacteristic, *GattWriteRequestedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.AddWriteRequested(handler)
 }
 
+// OnWriteRequested registers the given function as a handler of the event, using AddWriteRequested. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveWriteRequested, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GattLocalCharacteristic) OnWriteRequested(callback func(sender *GattLocalCharacteristic, args *GattWriteRequestedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattLocalCharacteristic, *GattWriteRequestedEventArgs](func(_ *foundation.TypedEventHandler[*GattLocalCharacteristic, *GattWriteRequestedEventArgs], sender *GattLocalCharacteristic, args *GattWriteRequestedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddWriteRequested(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveWriteRequested(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GattLocalCharacteristic) RemoveWriteRequested(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.RemoveWriteRequested(token)
 }
 
-func (impl *GattLocalCharacteristic) NotifyValueAsync(value *streams.IBuffer) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+func (impl *GattLocalCharacteristic) NotifyValueAsync(value *streams.IBuffer) (*IAsyncOperationIVectorViewGattClientNotificationResult, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.NotifyValueAsync(value)
 }
 
-func (impl *GattLocalCharacteristic) NotifyValueForSubscribedClientAsync(value *streams.IBuffer, subscribedClient *GattSubscribedClient) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristic))
+func (impl *GattLocalCharacteristic) NotifyValueForSubscribedClientAsync(value *streams.IBuffer, subscribedClient *GattSubscribedClient) (*IAsyncOperationGattClientNotificationResult, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristic, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristic)(unsafe.Pointer(itf))
 	return v.NotifyValueForSubscribedClientAsync(value, subscribedClient)
@@ -150,10 +321,20 @@ func (impl *GattLocalCharacteristic) NotifyValueForSubscribedClientAsync(value *
 const GUIDiGattLocalCharacteristic string = "aede376d-5412-4d74-92a8-8deb8526829c"
 const SignatureiGattLocalCharacteristic string = "{aede376d-5412-4d74-92a8-8deb8526829c}"
 
+var IIDiGattLocalCharacteristic = ole.GUID{Data1: 0xAEDE376D, Data2: 0x5412, Data3: 0x4D74, Data4: [8]byte{0x92, 0xA8, 0x8D, 0xEB, 0x85, 0x26, 0x82, 0x9C}}
+
+const ContractNameiGattLocalCharacteristic string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattLocalCharacteristic uint32 = 0x00040000
+
+// iGattLocalCharacteristic was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattLocalCharacteristic struct {
 	ole.IInspectable
 }
 
+func (v *iGattLocalCharacteristic) Signature() string {
+	return SignatureiGattLocalCharacteristic
+}
+
 type iGattLocalCharacteristicVtbl struct {
 	ole.IInspectableVtbl
 
@@ -183,6 +364,10 @@ func (v *iGattLocalCharacteristic) VTable() *iGattLocalCharacteristicVtbl {
 
 func (v *iGattLocalCharacteristic) GetUuid() (syscall.GUID, error) {
 	var out syscall.GUID
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetUuid,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -190,7 +375,7 @@ func (v *iGattLocalCharacteristic) GetUuid() (syscall.GUID, error) {
 	)
 
 	if hr != 0 {
-		return syscall.GUID{}, ole.NewError(hr)
+		return syscall.GUID{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "get_Uuid")
 	}
 
 	return out, nil
@@ -198,6 +383,10 @@ func (v *iGattLocalCharacteristic) GetUuid() (syscall.GUID, error) {
 
 func (v *iGattLocalCharacteristic) GetStaticValue() (*streams.IBuffer, error) {
 	var out *streams.IBuffer
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStaticValue,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -205,7 +394,7 @@ func (v *iGattLocalCharacteristic) GetStaticValue() (*streams.IBuffer, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "get_StaticValue")
 	}
 
 	return out, nil
@@ -213,6 +402,10 @@ func (v *iGattLocalCharacteristic) GetStaticValue() (*streams.IBuffer, error) {
 
 func (v *iGattLocalCharacteristic) GetCharacteristicProperties() (GattCharacteristicProperties, error) {
 	var out GattCharacteristicProperties
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCharacteristicProperties,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -220,7 +413,7 @@ func (v *iGattLocalCharacteristic) GetCharacteristicProperties() (GattCharacteri
 	)
 
 	if hr != 0 {
-		return GattCharacteristicPropertiesNone, ole.NewError(hr)
+		return GattCharacteristicPropertiesNone, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "get_CharacteristicProperties")
 	}
 
 	return out, nil
@@ -228,6 +421,10 @@ func (v *iGattLocalCharacteristic) GetCharacteristicProperties() (GattCharacteri
 
 func (v *iGattLocalCharacteristic) GetReadProtectionLevel() (GattProtectionLevel, error) {
 	var out GattProtectionLevel
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetReadProtectionLevel,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -235,7 +432,7 @@ func (v *iGattLocalCharacteristic) GetReadProtectionLevel() (GattProtectionLevel
 	)
 
 	if hr != 0 {
-		return GattProtectionLevelPlain, ole.NewError(hr)
+		return GattProtectionLevelPlain, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "get_ReadProtectionLevel")
 	}
 
 	return out, nil
@@ -243,6 +440,10 @@ func (v *iGattLocalCharacteristic) GetReadProtectionLevel() (GattProtectionLevel
 
 func (v *iGattLocalCharacteristic) GetWriteProtectionLevel() (GattProtectionLevel, error) {
 	var out GattProtectionLevel
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetWriteProtectionLevel,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -250,39 +451,53 @@ func (v *iGattLocalCharacteristic) GetWriteProtectionLevel() (GattProtectionLeve
 	)
 
 	if hr != 0 {
-		return GattProtectionLevelPlain, ole.NewError(hr)
+		return GattProtectionLevelPlain, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "get_WriteProtectionLevel")
 	}
 
 	return out, nil
 }
 
-func (v *iGattLocalCharacteristic) CreateDescriptorAsync(descriptorUuid syscall.GUID, parameters *GattLocalDescriptorParameters) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().CreateDescriptorAsync,
-		uintptr(unsafe.Pointer(v)),               // this
-		uintptr(unsafe.Pointer(&descriptorUuid)), // in syscall.GUID
-		uintptr(unsafe.Pointer(parameters)),      // in GattLocalDescriptorParameters
-		uintptr(unsafe.Pointer(&out)),            // out foundation.IAsyncOperation
-	)
+func (v *iGattLocalCharacteristic) CreateDescriptorAsync(descriptorUuid syscall.GUID, parameters *GattLocalDescriptorParameters) (*IAsyncOperationGattLocalDescriptorResult, error) {
+	var out *IAsyncOperationGattLocalDescriptorResult
+	descriptorUuidABI, err := winrt.NewInValue(descriptorUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer descriptorUuidABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	descriptorUuidSlots := descriptorUuidABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 3+len(descriptorUuidSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v)))          // this
+	callArgs = append(callArgs, descriptorUuidSlots...)              // in syscall.GUID
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(parameters))) // in GattLocalDescriptorParameters
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(&out)))       // out IAsyncOperationGattLocalDescriptorResult
+	hr, _, _ := syscall.SyscallN(v.VTable().CreateDescriptorAsync, callArgs...)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "CreateDescriptorAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGattLocalCharacteristic) GetDescriptors() (*collections.IVectorView, error) {
-	var out *collections.IVectorView
+func (v *iGattLocalCharacteristic) GetDescriptors() (*IVectorViewGattLocalDescriptor, error) {
+	var out *IVectorViewGattLocalDescriptor
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetDescriptors,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVectorView
+		uintptr(unsafe.Pointer(&out)), // out IVectorViewGattLocalDescriptor
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "get_Descriptors")
 	}
 
 	return out, nil
@@ -290,6 +505,10 @@ func (v *iGattLocalCharacteristic) GetDescriptors() (*collections.IVectorView, e
 
 func (v *iGattLocalCharacteristic) GetUserDescription() (string, error) {
 	var outHStr ole.HString
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetUserDescription,
 		uintptr(unsafe.Pointer(v)),        // this
@@ -297,7 +516,7 @@ func (v *iGattLocalCharacteristic) GetUserDescription() (string, error) {
 	)
 
 	if hr != 0 {
-		return "", ole.NewError(hr)
+		return "", winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "get_UserDescription")
 	}
 
 	out := outHStr.String()
@@ -305,154 +524,302 @@ func (v *iGattLocalCharacteristic) GetUserDescription() (string, error) {
 	return out, nil
 }
 
-func (v *iGattLocalCharacteristic) GetPresentationFormats() (*collections.IVectorView, error) {
-	var out *collections.IVectorView
+func (v *iGattLocalCharacteristic) GetPresentationFormats() (*IVectorViewGattPresentationFormat, error) {
+	var out *IVectorViewGattPresentationFormat
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetPresentationFormats,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVectorView
+		uintptr(unsafe.Pointer(&out)), // out IVectorViewGattPresentationFormat
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "get_PresentationFormats")
 	}
 
 	return out, nil
 }
 
-func (v *iGattLocalCharacteristic) GetSubscribedClients() (*collections.IVectorView, error) {
-	var out *collections.IVectorView
+func (v *iGattLocalCharacteristic) GetSubscribedClients() (*IVectorViewGattSubscribedClient, error) {
+	var out *IVectorViewGattSubscribedClient
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetSubscribedClients,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVectorView
+		uintptr(unsafe.Pointer(&out)), // out IVectorViewGattSubscribedClient
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "get_SubscribedClients")
 	}
 
 	return out, nil
 }
 
-func (v *iGattLocalCharacteristic) AddSubscribedClientsChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGattLocalCharacteristic) AddSubscribedClientsChanged(handler *foundation.TypedEventHandler[*GattLocalCharacteristic, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddSubscribedClientsChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GattLocalCharacteristic, unsafe.Pointer]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "add_SubscribedClientsChanged")
 	}
 
 	return out, nil
 }
 
+// OnSubscribedClientsChanged registers the given function as a handler of the event, using AddSubscribedClientsChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveSubscribedClientsChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGattLocalCharacteristic) OnSubscribedClientsChanged(callback func(sender *GattLocalCharacteristic, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattLocalCharacteristic, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*GattLocalCharacteristic, unsafe.Pointer], sender *GattLocalCharacteristic, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddSubscribedClientsChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveSubscribedClientsChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGattLocalCharacteristic) RemoveSubscribedClientsChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveSubscribedClientsChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveSubscribedClientsChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "remove_SubscribedClientsChanged")
 	}
 
 	return nil
 }
 
-func (v *iGattLocalCharacteristic) AddReadRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGattLocalCharacteristic) AddReadRequested(handler *foundation.TypedEventHandler[*GattLocalCharacteristic, *GattReadRequestedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddReadRequested,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GattLocalCharacteristic, *GattReadRequestedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "add_ReadRequested")
 	}
 
 	return out, nil
 }
 
+// OnReadRequested registers the given function as a handler of the event, using AddReadRequested. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveReadRequested, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGattLocalCharacteristic) OnReadRequested(callback func(sender *GattLocalCharacteristic, args *GattReadRequestedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattLocalCharacteristic, *GattReadRequestedEventArgs](func(_ *foundation.TypedEventHandler[*GattLocalCharacteristic, *GattReadRequestedEventArgs], sender *GattLocalCharacteristic, args *GattReadRequestedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddReadRequested(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveReadRequested(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGattLocalCharacteristic) RemoveReadRequested(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveReadRequested,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveReadRequested, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "remove_ReadRequested")
 	}
 
 	return nil
 }
 
-func (v *iGattLocalCharacteristic) AddWriteRequested(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGattLocalCharacteristic) AddWriteRequested(handler *foundation.TypedEventHandler[*GattLocalCharacteristic, *GattWriteRequestedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddWriteRequested,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GattLocalCharacteristic, *GattWriteRequestedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "add_WriteRequested")
 	}
 
 	return out, nil
 }
 
+// OnWriteRequested registers the given function as a handler of the event, using AddWriteRequested. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveWriteRequested, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGattLocalCharacteristic) OnWriteRequested(callback func(sender *GattLocalCharacteristic, args *GattWriteRequestedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattLocalCharacteristic, *GattWriteRequestedEventArgs](func(_ *foundation.TypedEventHandler[*GattLocalCharacteristic, *GattWriteRequestedEventArgs], sender *GattLocalCharacteristic, args *GattWriteRequestedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddWriteRequested(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveWriteRequested(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGattLocalCharacteristic) RemoveWriteRequested(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveWriteRequested,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveWriteRequested, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "remove_WriteRequested")
 	}
 
 	return nil
 }
 
-func (v *iGattLocalCharacteristic) NotifyValueAsync(value *streams.IBuffer) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGattLocalCharacteristic) NotifyValueAsync(value *streams.IBuffer) (*IAsyncOperationIVectorViewGattClientNotificationResult, error) {
+	var out *IAsyncOperationIVectorViewGattClientNotificationResult
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().NotifyValueAsync,
 		uintptr(unsafe.Pointer(v)),     // this
 		uintptr(unsafe.Pointer(value)), // in streams.IBuffer
-		uintptr(unsafe.Pointer(&out)),  // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)),  // out IAsyncOperationIVectorViewGattClientNotificationResult
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "NotifyValueAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGattLocalCharacteristic) NotifyValueForSubscribedClientAsync(value *streams.IBuffer, subscribedClient *GattSubscribedClient) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGattLocalCharacteristic) NotifyValueForSubscribedClientAsync(value *streams.IBuffer, subscribedClient *GattSubscribedClient) (*IAsyncOperationGattClientNotificationResult, error) {
+	var out *IAsyncOperationGattClientNotificationResult
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().NotifyValueForSubscribedClientAsync,
 		uintptr(unsafe.Pointer(v)),                // this
 		uintptr(unsafe.Pointer(value)),            // in streams.IBuffer
 		uintptr(unsafe.Pointer(subscribedClient)), // in GattSubscribedClient
-		uintptr(unsafe.Pointer(&out)),             // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)),             // out IAsyncOperationGattClientNotificationResult
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristic", "NotifyValueForSubscribedClientAsync")
 	}
 
 	return out, nil
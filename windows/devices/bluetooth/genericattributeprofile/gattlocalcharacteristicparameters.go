@@ -6,22 +6,48 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
-	"github.com/saltosystems/winrt-go/windows/foundation/collections"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/storage/streams"
 )
 
 const SignatureGattLocalCharacteristicParameters string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalCharacteristicParameters;{faf73db4-4cff-44c7-8445-040e6ead0063})"
 
+const ContractNameGattLocalCharacteristicParameters string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattLocalCharacteristicParameters uint32 = 0x00040000
+
+// GattLocalCharacteristicParameters was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattLocalCharacteristicParameters struct {
 	ole.IUnknown
 }
 
+func (impl *GattLocalCharacteristicParameters) Signature() string {
+	return SignatureGattLocalCharacteristicParameters
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalCharacteristicParameters class is available in the running version of Windows.
+func (impl *GattLocalCharacteristicParameters) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalCharacteristicParameters")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattLocalCharacteristicParameters, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattLocalCharacteristicParameters) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "SetStaticValue", "GetStaticValue", "SetCharacteristicProperties", "GetCharacteristicProperties", "SetReadProtectionLevel", "GetReadProtectionLevel", "SetWriteProtectionLevel", "GetWriteProtectionLevel", "SetUserDescription", "GetUserDescription", "GetPresentationFormats":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	}
+	return false, fmt.Errorf("unknown method %q of GattLocalCharacteristicParameters", name)
+}
+
 func NewGattLocalCharacteristicParameters() (*GattLocalCharacteristicParameters, error) {
-	inspectable, err := ole.RoActivateInstance("Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalCharacteristicParameters")
+	inspectable, err := winrt.ActivateInstance("Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalCharacteristicParameters")
 	if err != nil {
 		return nil, err
 	}
@@ -29,77 +55,110 @@ func NewGattLocalCharacteristicParameters() (*GattLocalCharacteristicParameters,
 }
 
 func (impl *GattLocalCharacteristicParameters) SetStaticValue(value *streams.IBuffer) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicParameters)(unsafe.Pointer(itf))
 	return v.SetStaticValue(value)
 }
 
 func (impl *GattLocalCharacteristicParameters) GetStaticValue() (*streams.IBuffer, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicParameters)(unsafe.Pointer(itf))
 	return v.GetStaticValue()
 }
 
 func (impl *GattLocalCharacteristicParameters) SetCharacteristicProperties(value GattCharacteristicProperties) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicParameters)(unsafe.Pointer(itf))
 	return v.SetCharacteristicProperties(value)
 }
 
 func (impl *GattLocalCharacteristicParameters) GetCharacteristicProperties() (GattCharacteristicProperties, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	if err != nil {
+		return GattCharacteristicPropertiesNone, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicParameters)(unsafe.Pointer(itf))
 	return v.GetCharacteristicProperties()
 }
 
 func (impl *GattLocalCharacteristicParameters) SetReadProtectionLevel(value GattProtectionLevel) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicParameters)(unsafe.Pointer(itf))
 	return v.SetReadProtectionLevel(value)
 }
 
 func (impl *GattLocalCharacteristicParameters) GetReadProtectionLevel() (GattProtectionLevel, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	if err != nil {
+		return GattProtectionLevelPlain, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicParameters)(unsafe.Pointer(itf))
 	return v.GetReadProtectionLevel()
 }
 
 func (impl *GattLocalCharacteristicParameters) SetWriteProtectionLevel(value GattProtectionLevel) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicParameters)(unsafe.Pointer(itf))
 	return v.SetWriteProtectionLevel(value)
 }
 
 func (impl *GattLocalCharacteristicParameters) GetWriteProtectionLevel() (GattProtectionLevel, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	if err != nil {
+		return GattProtectionLevelPlain, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicParameters)(unsafe.Pointer(itf))
 	return v.GetWriteProtectionLevel()
 }
 
 func (impl *GattLocalCharacteristicParameters) SetUserDescription(value string) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicParameters)(unsafe.Pointer(itf))
 	return v.SetUserDescription(value)
 }
 
 func (impl *GattLocalCharacteristicParameters) GetUserDescription() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	if err != nil {
+		return "", err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicParameters)(unsafe.Pointer(itf))
 	return v.GetUserDescription()
 }
 
-func (impl *GattLocalCharacteristicParameters) GetPresentationFormats() (*collections.IVector, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicParameters))
+func (impl *GattLocalCharacteristicParameters) GetPresentationFormats() (*IVectorGattPresentationFormat, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicParameters)(unsafe.Pointer(itf))
 	return v.GetPresentationFormats()
@@ -108,10 +167,20 @@ func (impl *GattLocalCharacteristicParameters) GetPresentationFormats() (*collec
 const GUIDiGattLocalCharacteristicParameters string = "faf73db4-4cff-44c7-8445-040e6ead0063"
 const SignatureiGattLocalCharacteristicParameters string = "{faf73db4-4cff-44c7-8445-040e6ead0063}"
 
+var IIDiGattLocalCharacteristicParameters = ole.GUID{Data1: 0xFAF73DB4, Data2: 0x4CFF, Data3: 0x44C7, Data4: [8]byte{0x84, 0x45, 0x04, 0x0E, 0x6E, 0xAD, 0x00, 0x63}}
+
+const ContractNameiGattLocalCharacteristicParameters string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattLocalCharacteristicParameters uint32 = 0x00040000
+
+// iGattLocalCharacteristicParameters was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattLocalCharacteristicParameters struct {
 	ole.IInspectable
 }
 
+func (v *iGattLocalCharacteristicParameters) Signature() string {
+	return SignatureiGattLocalCharacteristicParameters
+}
+
 type iGattLocalCharacteristicParametersVtbl struct {
 	ole.IInspectableVtbl
 
@@ -133,6 +202,10 @@ func (v *iGattLocalCharacteristicParameters) VTable() *iGattLocalCharacteristicP
 }
 
 func (v *iGattLocalCharacteristicParameters) SetStaticValue(value *streams.IBuffer) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetStaticValue,
 		uintptr(unsafe.Pointer(v)),     // this
@@ -140,7 +213,7 @@ func (v *iGattLocalCharacteristicParameters) SetStaticValue(value *streams.IBuff
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters", "put_StaticValue")
 	}
 
 	return nil
@@ -148,6 +221,10 @@ func (v *iGattLocalCharacteristicParameters) SetStaticValue(value *streams.IBuff
 
 func (v *iGattLocalCharacteristicParameters) GetStaticValue() (*streams.IBuffer, error) {
 	var out *streams.IBuffer
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStaticValue,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -155,13 +232,17 @@ func (v *iGattLocalCharacteristicParameters) GetStaticValue() (*streams.IBuffer,
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters", "get_StaticValue")
 	}
 
 	return out, nil
 }
 
 func (v *iGattLocalCharacteristicParameters) SetCharacteristicProperties(value GattCharacteristicProperties) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetCharacteristicProperties,
 		uintptr(unsafe.Pointer(v)), // this
@@ -169,7 +250,7 @@ func (v *iGattLocalCharacteristicParameters) SetCharacteristicProperties(value G
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters", "put_CharacteristicProperties")
 	}
 
 	return nil
@@ -177,6 +258,10 @@ func (v *iGattLocalCharacteristicParameters) SetCharacteristicProperties(value G
 
 func (v *iGattLocalCharacteristicParameters) GetCharacteristicProperties() (GattCharacteristicProperties, error) {
 	var out GattCharacteristicProperties
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCharacteristicProperties,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -184,13 +269,17 @@ func (v *iGattLocalCharacteristicParameters) GetCharacteristicProperties() (Gatt
 	)
 
 	if hr != 0 {
-		return GattCharacteristicPropertiesNone, ole.NewError(hr)
+		return GattCharacteristicPropertiesNone, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters", "get_CharacteristicProperties")
 	}
 
 	return out, nil
 }
 
 func (v *iGattLocalCharacteristicParameters) SetReadProtectionLevel(value GattProtectionLevel) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetReadProtectionLevel,
 		uintptr(unsafe.Pointer(v)), // this
@@ -198,7 +287,7 @@ func (v *iGattLocalCharacteristicParameters) SetReadProtectionLevel(value GattPr
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters", "put_ReadProtectionLevel")
 	}
 
 	return nil
@@ -206,6 +295,10 @@ func (v *iGattLocalCharacteristicParameters) SetReadProtectionLevel(value GattPr
 
 func (v *iGattLocalCharacteristicParameters) GetReadProtectionLevel() (GattProtectionLevel, error) {
 	var out GattProtectionLevel
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetReadProtectionLevel,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -213,13 +306,17 @@ func (v *iGattLocalCharacteristicParameters) GetReadProtectionLevel() (GattProte
 	)
 
 	if hr != 0 {
-		return GattProtectionLevelPlain, ole.NewError(hr)
+		return GattProtectionLevelPlain, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters", "get_ReadProtectionLevel")
 	}
 
 	return out, nil
 }
 
 func (v *iGattLocalCharacteristicParameters) SetWriteProtectionLevel(value GattProtectionLevel) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetWriteProtectionLevel,
 		uintptr(unsafe.Pointer(v)), // this
@@ -227,7 +324,7 @@ func (v *iGattLocalCharacteristicParameters) SetWriteProtectionLevel(value GattP
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters", "put_WriteProtectionLevel")
 	}
 
 	return nil
@@ -235,6 +332,10 @@ func (v *iGattLocalCharacteristicParameters) SetWriteProtectionLevel(value GattP
 
 func (v *iGattLocalCharacteristicParameters) GetWriteProtectionLevel() (GattProtectionLevel, error) {
 	var out GattProtectionLevel
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetWriteProtectionLevel,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -242,7 +343,7 @@ func (v *iGattLocalCharacteristicParameters) GetWriteProtectionLevel() (GattProt
 	)
 
 	if hr != 0 {
-		return GattProtectionLevelPlain, ole.NewError(hr)
+		return GattProtectionLevelPlain, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters", "get_WriteProtectionLevel")
 	}
 
 	return out, nil
@@ -253,6 +354,10 @@ func (v *iGattLocalCharacteristicParameters) SetUserDescription(value string) er
 	if err != nil {
 		return err
 	}
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetUserDescription,
 		uintptr(unsafe.Pointer(v)), // this
@@ -260,7 +365,7 @@ func (v *iGattLocalCharacteristicParameters) SetUserDescription(value string) er
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters", "put_UserDescription")
 	}
 
 	return nil
@@ -268,6 +373,10 @@ func (v *iGattLocalCharacteristicParameters) SetUserDescription(value string) er
 
 func (v *iGattLocalCharacteristicParameters) GetUserDescription() (string, error) {
 	var outHStr ole.HString
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetUserDescription,
 		uintptr(unsafe.Pointer(v)),        // this
@@ -275,7 +384,7 @@ func (v *iGattLocalCharacteristicParameters) GetUserDescription() (string, error
 	)
 
 	if hr != 0 {
-		return "", ole.NewError(hr)
+		return "", winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters", "get_UserDescription")
 	}
 
 	out := outHStr.String()
@@ -283,16 +392,20 @@ func (v *iGattLocalCharacteristicParameters) GetUserDescription() (string, error
 	return out, nil
 }
 
-func (v *iGattLocalCharacteristicParameters) GetPresentationFormats() (*collections.IVector, error) {
-	var out *collections.IVector
+func (v *iGattLocalCharacteristicParameters) GetPresentationFormats() (*IVectorGattPresentationFormat, error) {
+	var out *IVectorGattPresentationFormat
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetPresentationFormats,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVector
+		uintptr(unsafe.Pointer(&out)), // out IVectorGattPresentationFormat
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicParameters", "get_PresentationFormats")
 	}
 
 	return out, nil
@@ -6,28 +6,61 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/devices/bluetooth"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattLocalCharacteristicResult string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalCharacteristicResult;{7975de9b-0170-4397-9666-92f863f12ee6})"
 
+const ContractNameGattLocalCharacteristicResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattLocalCharacteristicResult uint32 = 0x00040000
+
+// GattLocalCharacteristicResult was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattLocalCharacteristicResult struct {
 	ole.IUnknown
 }
 
+func (impl *GattLocalCharacteristicResult) Signature() string {
+	return SignatureGattLocalCharacteristicResult
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalCharacteristicResult class is available in the running version of Windows.
+func (impl *GattLocalCharacteristicResult) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalCharacteristicResult")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattLocalCharacteristicResult, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattLocalCharacteristicResult) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetCharacteristic", "GetError":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicResult")
+	}
+	return false, fmt.Errorf("unknown method %q of GattLocalCharacteristicResult", name)
+}
+
 func (impl *GattLocalCharacteristicResult) GetCharacteristic() (*GattLocalCharacteristic, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicResult))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicResult")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicResult)(unsafe.Pointer(itf))
 	return v.GetCharacteristic()
 }
 
 func (impl *GattLocalCharacteristicResult) GetError() (bluetooth.BluetoothError, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalCharacteristicResult))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalCharacteristicResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicResult")
+	if err != nil {
+		return bluetooth.BluetoothErrorSuccess, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalCharacteristicResult)(unsafe.Pointer(itf))
 	return v.GetError()
@@ -36,10 +69,20 @@ func (impl *GattLocalCharacteristicResult) GetError() (bluetooth.BluetoothError,
 const GUIDiGattLocalCharacteristicResult string = "7975de9b-0170-4397-9666-92f863f12ee6"
 const SignatureiGattLocalCharacteristicResult string = "{7975de9b-0170-4397-9666-92f863f12ee6}"
 
+var IIDiGattLocalCharacteristicResult = ole.GUID{Data1: 0x7975DE9B, Data2: 0x0170, Data3: 0x4397, Data4: [8]byte{0x96, 0x66, 0x92, 0xF8, 0x63, 0xF1, 0x2E, 0xE6}}
+
+const ContractNameiGattLocalCharacteristicResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattLocalCharacteristicResult uint32 = 0x00040000
+
+// iGattLocalCharacteristicResult was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattLocalCharacteristicResult struct {
 	ole.IInspectable
 }
 
+func (v *iGattLocalCharacteristicResult) Signature() string {
+	return SignatureiGattLocalCharacteristicResult
+}
+
 type iGattLocalCharacteristicResultVtbl struct {
 	ole.IInspectableVtbl
 
@@ -53,6 +96,10 @@ func (v *iGattLocalCharacteristicResult) VTable() *iGattLocalCharacteristicResul
 
 func (v *iGattLocalCharacteristicResult) GetCharacteristic() (*GattLocalCharacteristic, error) {
 	var out *GattLocalCharacteristic
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCharacteristic,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -60,7 +107,7 @@ func (v *iGattLocalCharacteristicResult) GetCharacteristic() (*GattLocalCharacte
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicResult", "get_Characteristic")
 	}
 
 	return out, nil
@@ -68,6 +115,10 @@ func (v *iGattLocalCharacteristicResult) GetCharacteristic() (*GattLocalCharacte
 
 func (v *iGattLocalCharacteristicResult) GetError() (bluetooth.BluetoothError, error) {
 	var out bluetooth.BluetoothError
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetError,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -75,7 +126,7 @@ func (v *iGattLocalCharacteristicResult) GetError() (bluetooth.BluetoothError, e
 	)
 
 	if hr != 0 {
-		return bluetooth.BluetoothErrorSuccess, ole.NewError(hr)
+		return bluetooth.BluetoothErrorSuccess, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalCharacteristicResult", "get_Error")
 	}
 
 	return out, nil
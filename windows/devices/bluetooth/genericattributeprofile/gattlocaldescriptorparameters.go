@@ -6,21 +6,48 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/storage/streams"
 )
 
 const SignatureGattLocalDescriptorParameters string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalDescriptorParameters;{5fdede6a-f3c1-4b66-8c4b-e3d2293b40e9})"
 
+const ContractNameGattLocalDescriptorParameters string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattLocalDescriptorParameters uint32 = 0x00040000
+
+// GattLocalDescriptorParameters was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattLocalDescriptorParameters struct {
 	ole.IUnknown
 }
 
+func (impl *GattLocalDescriptorParameters) Signature() string {
+	return SignatureGattLocalDescriptorParameters
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalDescriptorParameters class is available in the running version of Windows.
+func (impl *GattLocalDescriptorParameters) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalDescriptorParameters")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattLocalDescriptorParameters, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattLocalDescriptorParameters) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "SetStaticValue", "GetStaticValue", "SetReadProtectionLevel", "GetReadProtectionLevel", "SetWriteProtectionLevel", "GetWriteProtectionLevel":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters")
+	}
+	return false, fmt.Errorf("unknown method %q of GattLocalDescriptorParameters", name)
+}
+
 func NewGattLocalDescriptorParameters() (*GattLocalDescriptorParameters, error) {
-	inspectable, err := ole.RoActivateInstance("Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalDescriptorParameters")
+	inspectable, err := winrt.ActivateInstance("Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalDescriptorParameters")
 	if err != nil {
 		return nil, err
 	}
@@ -28,42 +55,60 @@ func NewGattLocalDescriptorParameters() (*GattLocalDescriptorParameters, error)
 }
 
 func (impl *GattLocalDescriptorParameters) SetStaticValue(value *streams.IBuffer) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalDescriptorParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalDescriptorParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattLocalDescriptorParameters)(unsafe.Pointer(itf))
 	return v.SetStaticValue(value)
 }
 
 func (impl *GattLocalDescriptorParameters) GetStaticValue() (*streams.IBuffer, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalDescriptorParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalDescriptorParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalDescriptorParameters)(unsafe.Pointer(itf))
 	return v.GetStaticValue()
 }
 
 func (impl *GattLocalDescriptorParameters) SetReadProtectionLevel(value GattProtectionLevel) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalDescriptorParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalDescriptorParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattLocalDescriptorParameters)(unsafe.Pointer(itf))
 	return v.SetReadProtectionLevel(value)
 }
 
 func (impl *GattLocalDescriptorParameters) GetReadProtectionLevel() (GattProtectionLevel, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalDescriptorParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalDescriptorParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters")
+	if err != nil {
+		return GattProtectionLevelPlain, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalDescriptorParameters)(unsafe.Pointer(itf))
 	return v.GetReadProtectionLevel()
 }
 
 func (impl *GattLocalDescriptorParameters) SetWriteProtectionLevel(value GattProtectionLevel) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalDescriptorParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalDescriptorParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattLocalDescriptorParameters)(unsafe.Pointer(itf))
 	return v.SetWriteProtectionLevel(value)
 }
 
 func (impl *GattLocalDescriptorParameters) GetWriteProtectionLevel() (GattProtectionLevel, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalDescriptorParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalDescriptorParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters")
+	if err != nil {
+		return GattProtectionLevelPlain, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalDescriptorParameters)(unsafe.Pointer(itf))
 	return v.GetWriteProtectionLevel()
@@ -72,10 +117,20 @@ func (impl *GattLocalDescriptorParameters) GetWriteProtectionLevel() (GattProtec
 const GUIDiGattLocalDescriptorParameters string = "5fdede6a-f3c1-4b66-8c4b-e3d2293b40e9"
 const SignatureiGattLocalDescriptorParameters string = "{5fdede6a-f3c1-4b66-8c4b-e3d2293b40e9}"
 
+var IIDiGattLocalDescriptorParameters = ole.GUID{Data1: 0x5FDEDE6A, Data2: 0xF3C1, Data3: 0x4B66, Data4: [8]byte{0x8C, 0x4B, 0xE3, 0xD2, 0x29, 0x3B, 0x40, 0xE9}}
+
+const ContractNameiGattLocalDescriptorParameters string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattLocalDescriptorParameters uint32 = 0x00040000
+
+// iGattLocalDescriptorParameters was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattLocalDescriptorParameters struct {
 	ole.IInspectable
 }
 
+func (v *iGattLocalDescriptorParameters) Signature() string {
+	return SignatureiGattLocalDescriptorParameters
+}
+
 type iGattLocalDescriptorParametersVtbl struct {
 	ole.IInspectableVtbl
 
@@ -92,6 +147,10 @@ func (v *iGattLocalDescriptorParameters) VTable() *iGattLocalDescriptorParameter
 }
 
 func (v *iGattLocalDescriptorParameters) SetStaticValue(value *streams.IBuffer) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetStaticValue,
 		uintptr(unsafe.Pointer(v)),     // this
@@ -99,7 +158,7 @@ func (v *iGattLocalDescriptorParameters) SetStaticValue(value *streams.IBuffer)
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters", "put_StaticValue")
 	}
 
 	return nil
@@ -107,6 +166,10 @@ func (v *iGattLocalDescriptorParameters) SetStaticValue(value *streams.IBuffer)
 
 func (v *iGattLocalDescriptorParameters) GetStaticValue() (*streams.IBuffer, error) {
 	var out *streams.IBuffer
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStaticValue,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -114,13 +177,17 @@ func (v *iGattLocalDescriptorParameters) GetStaticValue() (*streams.IBuffer, err
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters", "get_StaticValue")
 	}
 
 	return out, nil
 }
 
 func (v *iGattLocalDescriptorParameters) SetReadProtectionLevel(value GattProtectionLevel) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetReadProtectionLevel,
 		uintptr(unsafe.Pointer(v)), // this
@@ -128,7 +195,7 @@ func (v *iGattLocalDescriptorParameters) SetReadProtectionLevel(value GattProtec
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters", "put_ReadProtectionLevel")
 	}
 
 	return nil
@@ -136,6 +203,10 @@ func (v *iGattLocalDescriptorParameters) SetReadProtectionLevel(value GattProtec
 
 func (v *iGattLocalDescriptorParameters) GetReadProtectionLevel() (GattProtectionLevel, error) {
 	var out GattProtectionLevel
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetReadProtectionLevel,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -143,13 +214,17 @@ func (v *iGattLocalDescriptorParameters) GetReadProtectionLevel() (GattProtectio
 	)
 
 	if hr != 0 {
-		return GattProtectionLevelPlain, ole.NewError(hr)
+		return GattProtectionLevelPlain, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters", "get_ReadProtectionLevel")
 	}
 
 	return out, nil
 }
 
 func (v *iGattLocalDescriptorParameters) SetWriteProtectionLevel(value GattProtectionLevel) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetWriteProtectionLevel,
 		uintptr(unsafe.Pointer(v)), // this
@@ -157,7 +232,7 @@ func (v *iGattLocalDescriptorParameters) SetWriteProtectionLevel(value GattProte
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters", "put_WriteProtectionLevel")
 	}
 
 	return nil
@@ -165,6 +240,10 @@ func (v *iGattLocalDescriptorParameters) SetWriteProtectionLevel(value GattProte
 
 func (v *iGattLocalDescriptorParameters) GetWriteProtectionLevel() (GattProtectionLevel, error) {
 	var out GattProtectionLevel
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetWriteProtectionLevel,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -172,7 +251,7 @@ func (v *iGattLocalDescriptorParameters) GetWriteProtectionLevel() (GattProtecti
 	)
 
 	if hr != 0 {
-		return GattProtectionLevelPlain, ole.NewError(hr)
+		return GattProtectionLevelPlain, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalDescriptorParameters", "get_WriteProtectionLevel")
 	}
 
 	return out, nil
@@ -6,36 +6,70 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
-	"github.com/saltosystems/winrt-go/windows/foundation"
-	"github.com/saltosystems/winrt-go/windows/foundation/collections"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattLocalService string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalService;{f513e258-f7f7-4902-b803-57fcc7d6fe83})"
 
+const ContractNameGattLocalService string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattLocalService uint32 = 0x00040000
+
+// GattLocalService was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattLocalService struct {
 	ole.IUnknown
 }
 
+func (impl *GattLocalService) Signature() string {
+	return SignatureGattLocalService
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalService class is available in the running version of Windows.
+func (impl *GattLocalService) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattLocalService")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattLocalService, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattLocalService) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetUuid", "CreateCharacteristicAsync", "GetCharacteristics":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalService")
+	}
+	return false, fmt.Errorf("unknown method %q of GattLocalService", name)
+}
+
 func (impl *GattLocalService) GetUuid() (syscall.GUID, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalService))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalService, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalService")
+	if err != nil {
+		return syscall.GUID{}, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalService)(unsafe.Pointer(itf))
 	return v.GetUuid()
 }
 
-func (impl *GattLocalService) CreateCharacteristicAsync(characteristicUuid syscall.GUID, parameters *GattLocalCharacteristicParameters) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalService))
+func (impl *GattLocalService) CreateCharacteristicAsync(characteristicUuid syscall.GUID, parameters *GattLocalCharacteristicParameters) (*IAsyncOperationGattLocalCharacteristicResult, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalService, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalService")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalService)(unsafe.Pointer(itf))
 	return v.CreateCharacteristicAsync(characteristicUuid, parameters)
 }
 
-func (impl *GattLocalService) GetCharacteristics() (*collections.IVectorView, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattLocalService))
+func (impl *GattLocalService) GetCharacteristics() (*IVectorViewGattLocalCharacteristic, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattLocalService, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalService")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattLocalService)(unsafe.Pointer(itf))
 	return v.GetCharacteristics()
@@ -44,10 +78,20 @@ func (impl *GattLocalService) GetCharacteristics() (*collections.IVectorView, er
 const GUIDiGattLocalService string = "f513e258-f7f7-4902-b803-57fcc7d6fe83"
 const SignatureiGattLocalService string = "{f513e258-f7f7-4902-b803-57fcc7d6fe83}"
 
+var IIDiGattLocalService = ole.GUID{Data1: 0xF513E258, Data2: 0xF7F7, Data3: 0x4902, Data4: [8]byte{0xB8, 0x03, 0x57, 0xFC, 0xC7, 0xD6, 0xFE, 0x83}}
+
+const ContractNameiGattLocalService string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattLocalService uint32 = 0x00040000
+
+// iGattLocalService was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattLocalService struct {
 	ole.IInspectable
 }
 
+func (v *iGattLocalService) Signature() string {
+	return SignatureiGattLocalService
+}
+
 type iGattLocalServiceVtbl struct {
 	ole.IInspectableVtbl
 
@@ -62,6 +106,10 @@ func (v *iGattLocalService) VTable() *iGattLocalServiceVtbl {
 
 func (v *iGattLocalService) GetUuid() (syscall.GUID, error) {
 	var out syscall.GUID
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetUuid,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -69,39 +117,53 @@ func (v *iGattLocalService) GetUuid() (syscall.GUID, error) {
 	)
 
 	if hr != 0 {
-		return syscall.GUID{}, ole.NewError(hr)
+		return syscall.GUID{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalService", "get_Uuid")
 	}
 
 	return out, nil
 }
 
-func (v *iGattLocalService) CreateCharacteristicAsync(characteristicUuid syscall.GUID, parameters *GattLocalCharacteristicParameters) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().CreateCharacteristicAsync,
-		uintptr(unsafe.Pointer(v)),                   // this
-		uintptr(unsafe.Pointer(&characteristicUuid)), // in syscall.GUID
-		uintptr(unsafe.Pointer(parameters)),          // in GattLocalCharacteristicParameters
-		uintptr(unsafe.Pointer(&out)),                // out foundation.IAsyncOperation
-	)
+func (v *iGattLocalService) CreateCharacteristicAsync(characteristicUuid syscall.GUID, parameters *GattLocalCharacteristicParameters) (*IAsyncOperationGattLocalCharacteristicResult, error) {
+	var out *IAsyncOperationGattLocalCharacteristicResult
+	characteristicUuidABI, err := winrt.NewInValue(characteristicUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer characteristicUuidABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	characteristicUuidSlots := characteristicUuidABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 3+len(characteristicUuidSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v)))          // this
+	callArgs = append(callArgs, characteristicUuidSlots...)          // in syscall.GUID
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(parameters))) // in GattLocalCharacteristicParameters
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(&out)))       // out IAsyncOperationGattLocalCharacteristicResult
+	hr, _, _ := syscall.SyscallN(v.VTable().CreateCharacteristicAsync, callArgs...)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalService", "CreateCharacteristicAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGattLocalService) GetCharacteristics() (*collections.IVectorView, error) {
-	var out *collections.IVectorView
+func (v *iGattLocalService) GetCharacteristics() (*IVectorViewGattLocalCharacteristic, error) {
+	var out *IVectorViewGattLocalCharacteristic
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCharacteristics,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVectorView
+		uintptr(unsafe.Pointer(&out)), // out IVectorViewGattLocalCharacteristic
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattLocalService", "get_Characteristics")
 	}
 
 	return out, nil
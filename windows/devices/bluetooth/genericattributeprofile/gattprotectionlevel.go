@@ -5,13 +5,75 @@
 //nolint:all
 package genericattributeprofile
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameGattProtectionLevel string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattProtectionLevel uint32 = 0x00010000
+
+// GattProtectionLevel was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type GattProtectionLevel int32
 
 const SignatureGattProtectionLevel string = "enum(Windows.Devices.Bluetooth.GenericAttributeProfile.GattProtectionLevel;i4)"
 
+func (v GattProtectionLevel) Signature() string {
+	return SignatureGattProtectionLevel
+}
+
 const (
 	GattProtectionLevelPlain                               GattProtectionLevel = 0
 	GattProtectionLevelAuthenticationRequired              GattProtectionLevel = 1
 	GattProtectionLevelEncryptionRequired                  GattProtectionLevel = 2
 	GattProtectionLevelEncryptionAndAuthenticationRequired GattProtectionLevel = 3
 )
+
+var namesGattProtectionLevel = map[GattProtectionLevel]string{
+	GattProtectionLevelPlain:                               "Plain",
+	GattProtectionLevelAuthenticationRequired:              "AuthenticationRequired",
+	GattProtectionLevelEncryptionRequired:                  "EncryptionRequired",
+	GattProtectionLevelEncryptionAndAuthenticationRequired: "EncryptionAndAuthenticationRequired",
+}
+
+var valuesGattProtectionLevel = map[string]GattProtectionLevel{
+	"Plain":                               GattProtectionLevelPlain,
+	"AuthenticationRequired":              GattProtectionLevelAuthenticationRequired,
+	"EncryptionRequired":                  GattProtectionLevelEncryptionRequired,
+	"EncryptionAndAuthenticationRequired": GattProtectionLevelEncryptionAndAuthenticationRequired,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v GattProtectionLevel) String() string {
+	if name, ok := namesGattProtectionLevel[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseGattProtectionLevel parses the name of a value, as returned by String.
+func ParseGattProtectionLevel(s string) (GattProtectionLevel, error) {
+	if v, ok := valuesGattProtectionLevel[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid GattProtectionLevel %q", s)
+	}
+	return GattProtectionLevel(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v GattProtectionLevel) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *GattProtectionLevel) UnmarshalText(text []byte) error {
+	parsed, err := ParseGattProtectionLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
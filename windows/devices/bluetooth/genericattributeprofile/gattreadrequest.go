@@ -6,64 +6,143 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/storage/streams"
 )
 
 const SignatureGattReadRequest string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattReadRequest;{f1dd6535-6acd-42a6-a4bb-d789dae0043e})"
 
+const ContractNameGattReadRequest string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattReadRequest uint32 = 0x00040000
+
+// GattReadRequest was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattReadRequest struct {
 	ole.IUnknown
 }
 
+func (impl *GattReadRequest) Signature() string {
+	return SignatureGattReadRequest
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattReadRequest class is available in the running version of Windows.
+func (impl *GattReadRequest) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattReadRequest")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattReadRequest, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattReadRequest) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetOffset", "GetLength", "GetState", "AddStateChanged", "OnStateChanged", "RemoveStateChanged", "RespondWithValue", "RespondWithProtocolError":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest")
+	}
+	return false, fmt.Errorf("unknown method %q of GattReadRequest", name)
+}
+
 func (impl *GattReadRequest) GetOffset() (uint32, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iGattReadRequest)(unsafe.Pointer(itf))
 	return v.GetOffset()
 }
 
 func (impl *GattReadRequest) GetLength() (uint32, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iGattReadRequest)(unsafe.Pointer(itf))
 	return v.GetLength()
 }
 
 func (impl *GattReadRequest) GetState() (GattRequestState, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest")
+	if err != nil {
+		return GattRequestStatePending, err
+	}
 	defer itf.Release()
 	v := (*iGattReadRequest)(unsafe.Pointer(itf))
 	return v.GetState()
 }
 
-func (impl *GattReadRequest) AddStateChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadRequest))
+func (impl *GattReadRequest) AddStateChanged(handler *foundation.TypedEventHandler[*GattReadRequest, *GattRequestStateChangedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGattReadRequest)(unsafe.Pointer(itf))
 	return v.AddStateChanged(handler)
 }
 
+// OnStateChanged registers the given function as a handler of the event, using AddStateChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveStateChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GattReadRequest) OnStateChanged(callback func(sender *GattReadRequest, args *GattRequestStateChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattReadRequest, *GattRequestStateChangedEventArgs](func(_ *foundation.TypedEventHandler[*GattReadRequest, *GattRequestStateChangedEventArgs], sender *GattReadRequest, args *GattRequestStateChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddStateChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveStateChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GattReadRequest) RemoveStateChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattReadRequest)(unsafe.Pointer(itf))
 	return v.RemoveStateChanged(token)
 }
 
 func (impl *GattReadRequest) RespondWithValue(value *streams.IBuffer) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattReadRequest)(unsafe.Pointer(itf))
 	return v.RespondWithValue(value)
 }
 
 func (impl *GattReadRequest) RespondWithProtocolError(protocolError uint8) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattReadRequest)(unsafe.Pointer(itf))
 	return v.RespondWithProtocolError(protocolError)
@@ -72,10 +151,20 @@ func (impl *GattReadRequest) RespondWithProtocolError(protocolError uint8) error
 const GUIDiGattReadRequest string = "f1dd6535-6acd-42a6-a4bb-d789dae0043e"
 const SignatureiGattReadRequest string = "{f1dd6535-6acd-42a6-a4bb-d789dae0043e}"
 
+var IIDiGattReadRequest = ole.GUID{Data1: 0xF1DD6535, Data2: 0x6ACD, Data3: 0x42A6, Data4: [8]byte{0xA4, 0xBB, 0xD7, 0x89, 0xDA, 0xE0, 0x04, 0x3E}}
+
+const ContractNameiGattReadRequest string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattReadRequest uint32 = 0x00040000
+
+// iGattReadRequest was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattReadRequest struct {
 	ole.IInspectable
 }
 
+func (v *iGattReadRequest) Signature() string {
+	return SignatureiGattReadRequest
+}
+
 type iGattReadRequestVtbl struct {
 	ole.IInspectableVtbl
 
@@ -94,6 +183,10 @@ func (v *iGattReadRequest) VTable() *iGattReadRequestVtbl {
 
 func (v *iGattReadRequest) GetOffset() (uint32, error) {
 	var out uint32
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetOffset,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -101,7 +194,7 @@ func (v *iGattReadRequest) GetOffset() (uint32, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest", "get_Offset")
 	}
 
 	return out, nil
@@ -109,6 +202,10 @@ func (v *iGattReadRequest) GetOffset() (uint32, error) {
 
 func (v *iGattReadRequest) GetLength() (uint32, error) {
 	var out uint32
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetLength,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -116,7 +213,7 @@ func (v *iGattReadRequest) GetLength() (uint32, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest", "get_Length")
 	}
 
 	return out, nil
@@ -124,6 +221,10 @@ func (v *iGattReadRequest) GetLength() (uint32, error) {
 
 func (v *iGattReadRequest) GetState() (GattRequestState, error) {
 	var out GattRequestState
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetState,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -131,43 +232,91 @@ func (v *iGattReadRequest) GetState() (GattRequestState, error) {
 	)
 
 	if hr != 0 {
-		return GattRequestStatePending, ole.NewError(hr)
+		return GattRequestStatePending, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest", "get_State")
 	}
 
 	return out, nil
 }
 
-func (v *iGattReadRequest) AddStateChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGattReadRequest) AddStateChanged(handler *foundation.TypedEventHandler[*GattReadRequest, *GattRequestStateChangedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddStateChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GattReadRequest, *GattRequestStateChangedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest", "add_StateChanged")
 	}
 
 	return out, nil
 }
 
+// OnStateChanged registers the given function as a handler of the event, using AddStateChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveStateChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGattReadRequest) OnStateChanged(callback func(sender *GattReadRequest, args *GattRequestStateChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattReadRequest, *GattRequestStateChangedEventArgs](func(_ *foundation.TypedEventHandler[*GattReadRequest, *GattRequestStateChangedEventArgs], sender *GattReadRequest, args *GattRequestStateChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddStateChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveStateChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGattReadRequest) RemoveStateChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveStateChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveStateChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest", "remove_StateChanged")
 	}
 
 	return nil
 }
 
 func (v *iGattReadRequest) RespondWithValue(value *streams.IBuffer) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().RespondWithValue,
 		uintptr(unsafe.Pointer(v)),     // this
@@ -175,13 +324,17 @@ func (v *iGattReadRequest) RespondWithValue(value *streams.IBuffer) error {
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest", "RespondWithValue")
 	}
 
 	return nil
 }
 
 func (v *iGattReadRequest) RespondWithProtocolError(protocolError uint8) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().RespondWithProtocolError,
 		uintptr(unsafe.Pointer(v)), // this
@@ -189,7 +342,7 @@ func (v *iGattReadRequest) RespondWithProtocolError(protocolError uint8) error {
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequest", "RespondWithProtocolError")
 	}
 
 	return nil
@@ -6,35 +6,71 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattReadRequestedEventArgs string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattReadRequestedEventArgs;{93497243-f39c-484b-8ab6-996ba486cfa3})"
 
+const ContractNameGattReadRequestedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattReadRequestedEventArgs uint32 = 0x00040000
+
+// GattReadRequestedEventArgs was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattReadRequestedEventArgs struct {
 	ole.IUnknown
 }
 
+func (impl *GattReadRequestedEventArgs) Signature() string {
+	return SignatureGattReadRequestedEventArgs
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattReadRequestedEventArgs class is available in the running version of Windows.
+func (impl *GattReadRequestedEventArgs) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattReadRequestedEventArgs")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattReadRequestedEventArgs, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattReadRequestedEventArgs) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetSession", "GetDeferral", "GetRequestAsync":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequestedEventArgs")
+	}
+	return false, fmt.Errorf("unknown method %q of GattReadRequestedEventArgs", name)
+}
+
 func (impl *GattReadRequestedEventArgs) GetSession() (*GattSession, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadRequestedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadRequestedEventArgs, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequestedEventArgs")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattReadRequestedEventArgs)(unsafe.Pointer(itf))
 	return v.GetSession()
 }
 
 func (impl *GattReadRequestedEventArgs) GetDeferral() (*foundation.Deferral, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadRequestedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadRequestedEventArgs, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequestedEventArgs")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattReadRequestedEventArgs)(unsafe.Pointer(itf))
 	return v.GetDeferral()
 }
 
-func (impl *GattReadRequestedEventArgs) GetRequestAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadRequestedEventArgs))
+func (impl *GattReadRequestedEventArgs) GetRequestAsync() (*IAsyncOperationGattReadRequest, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadRequestedEventArgs, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequestedEventArgs")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattReadRequestedEventArgs)(unsafe.Pointer(itf))
 	return v.GetRequestAsync()
@@ -43,10 +79,20 @@ func (impl *GattReadRequestedEventArgs) GetRequestAsync() (*foundation.IAsyncOpe
 const GUIDiGattReadRequestedEventArgs string = "93497243-f39c-484b-8ab6-996ba486cfa3"
 const SignatureiGattReadRequestedEventArgs string = "{93497243-f39c-484b-8ab6-996ba486cfa3}"
 
+var IIDiGattReadRequestedEventArgs = ole.GUID{Data1: 0x93497243, Data2: 0xF39C, Data3: 0x484B, Data4: [8]byte{0x8A, 0xB6, 0x99, 0x6B, 0xA4, 0x86, 0xCF, 0xA3}}
+
+const ContractNameiGattReadRequestedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattReadRequestedEventArgs uint32 = 0x00040000
+
+// iGattReadRequestedEventArgs was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattReadRequestedEventArgs struct {
 	ole.IInspectable
 }
 
+func (v *iGattReadRequestedEventArgs) Signature() string {
+	return SignatureiGattReadRequestedEventArgs
+}
+
 type iGattReadRequestedEventArgsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -61,6 +107,10 @@ func (v *iGattReadRequestedEventArgs) VTable() *iGattReadRequestedEventArgsVtbl
 
 func (v *iGattReadRequestedEventArgs) GetSession() (*GattSession, error) {
 	var out *GattSession
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetSession,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -68,7 +118,7 @@ func (v *iGattReadRequestedEventArgs) GetSession() (*GattSession, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequestedEventArgs", "get_Session")
 	}
 
 	return out, nil
@@ -76,6 +126,10 @@ func (v *iGattReadRequestedEventArgs) GetSession() (*GattSession, error) {
 
 func (v *iGattReadRequestedEventArgs) GetDeferral() (*foundation.Deferral, error) {
 	var out *foundation.Deferral
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetDeferral,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -83,22 +137,26 @@ func (v *iGattReadRequestedEventArgs) GetDeferral() (*foundation.Deferral, error
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequestedEventArgs", "GetDeferral")
 	}
 
 	return out, nil
 }
 
-func (v *iGattReadRequestedEventArgs) GetRequestAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGattReadRequestedEventArgs) GetRequestAsync() (*IAsyncOperationGattReadRequest, error) {
+	var out *IAsyncOperationGattReadRequest
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetRequestAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationGattReadRequest
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadRequestedEventArgs", "GetRequestAsync")
 	}
 
 	return out, nil
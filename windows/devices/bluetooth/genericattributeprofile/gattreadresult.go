@@ -6,28 +6,61 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/storage/streams"
 )
 
 const SignatureGattReadResult string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattReadResult;{63a66f08-1aea-4c4c-a50f-97bae474b348})"
 
+const ContractNameGattReadResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattReadResult uint32 = 0x00010000
+
+// GattReadResult was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type GattReadResult struct {
 	ole.IUnknown
 }
 
+func (impl *GattReadResult) Signature() string {
+	return SignatureGattReadResult
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattReadResult class is available in the running version of Windows.
+func (impl *GattReadResult) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattReadResult")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattReadResult, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattReadResult) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetStatus", "GetValue":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadResult")
+	}
+	return false, fmt.Errorf("unknown method %q of GattReadResult", name)
+}
+
 func (impl *GattReadResult) GetStatus() (GattCommunicationStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadResult))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadResult")
+	if err != nil {
+		return GattCommunicationStatusSuccess, err
+	}
 	defer itf.Release()
 	v := (*iGattReadResult)(unsafe.Pointer(itf))
 	return v.GetStatus()
 }
 
 func (impl *GattReadResult) GetValue() (*streams.IBuffer, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattReadResult))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattReadResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadResult")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattReadResult)(unsafe.Pointer(itf))
 	return v.GetValue()
@@ -36,10 +69,20 @@ func (impl *GattReadResult) GetValue() (*streams.IBuffer, error) {
 const GUIDiGattReadResult string = "63a66f08-1aea-4c4c-a50f-97bae474b348"
 const SignatureiGattReadResult string = "{63a66f08-1aea-4c4c-a50f-97bae474b348}"
 
+var IIDiGattReadResult = ole.GUID{Data1: 0x63A66F08, Data2: 0x1AEA, Data3: 0x4C4C, Data4: [8]byte{0xA5, 0x0F, 0x97, 0xBA, 0xE4, 0x74, 0xB3, 0x48}}
+
+const ContractNameiGattReadResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattReadResult uint32 = 0x00010000
+
+// iGattReadResult was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iGattReadResult struct {
 	ole.IInspectable
 }
 
+func (v *iGattReadResult) Signature() string {
+	return SignatureiGattReadResult
+}
+
 type iGattReadResultVtbl struct {
 	ole.IInspectableVtbl
 
@@ -53,6 +96,10 @@ func (v *iGattReadResult) VTable() *iGattReadResultVtbl {
 
 func (v *iGattReadResult) GetStatus() (GattCommunicationStatus, error) {
 	var out GattCommunicationStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -60,7 +107,7 @@ func (v *iGattReadResult) GetStatus() (GattCommunicationStatus, error) {
 	)
 
 	if hr != 0 {
-		return GattCommunicationStatusSuccess, ole.NewError(hr)
+		return GattCommunicationStatusSuccess, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadResult", "get_Status")
 	}
 
 	return out, nil
@@ -68,6 +115,10 @@ func (v *iGattReadResult) GetStatus() (GattCommunicationStatus, error) {
 
 func (v *iGattReadResult) GetValue() (*streams.IBuffer, error) {
 	var out *streams.IBuffer
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetValue,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -75,7 +126,7 @@ func (v *iGattReadResult) GetValue() (*streams.IBuffer, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattReadResult", "get_Value")
 	}
 
 	return out, nil
@@ -84,10 +135,20 @@ func (v *iGattReadResult) GetValue() (*streams.IBuffer, error) {
 const GUIDiGattReadResult2 string = "a10f50a0-fb43-48af-baaa-638a5c6329fe"
 const SignatureiGattReadResult2 string = "{a10f50a0-fb43-48af-baaa-638a5c6329fe}"
 
+var IIDiGattReadResult2 = ole.GUID{Data1: 0xA10F50A0, Data2: 0xFB43, Data3: 0x48AF, Data4: [8]byte{0xBA, 0xAA, 0x63, 0x8A, 0x5C, 0x63, 0x29, 0xFE}}
+
+const ContractNameiGattReadResult2 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattReadResult2 uint32 = 0x00040000
+
+// iGattReadResult2 was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattReadResult2 struct {
 	ole.IInspectable
 }
 
+func (v *iGattReadResult2) Signature() string {
+	return SignatureiGattReadResult2
+}
+
 type iGattReadResult2Vtbl struct {
 	ole.IInspectableVtbl
 
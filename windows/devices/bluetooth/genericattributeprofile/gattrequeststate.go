@@ -5,12 +5,72 @@
 //nolint:all
 package genericattributeprofile
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameGattRequestState string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattRequestState uint32 = 0x00040000
+
+// GattRequestState was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattRequestState int32
 
 const SignatureGattRequestState string = "enum(Windows.Devices.Bluetooth.GenericAttributeProfile.GattRequestState;i4)"
 
+func (v GattRequestState) Signature() string {
+	return SignatureGattRequestState
+}
+
 const (
 	GattRequestStatePending   GattRequestState = 0
 	GattRequestStateCompleted GattRequestState = 1
 	GattRequestStateCanceled  GattRequestState = 2
 )
+
+var namesGattRequestState = map[GattRequestState]string{
+	GattRequestStatePending:   "Pending",
+	GattRequestStateCompleted: "Completed",
+	GattRequestStateCanceled:  "Canceled",
+}
+
+var valuesGattRequestState = map[string]GattRequestState{
+	"Pending":   GattRequestStatePending,
+	"Completed": GattRequestStateCompleted,
+	"Canceled":  GattRequestStateCanceled,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v GattRequestState) String() string {
+	if name, ok := namesGattRequestState[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseGattRequestState parses the name of a value, as returned by String.
+func ParseGattRequestState(s string) (GattRequestState, error) {
+	if v, ok := valuesGattRequestState[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid GattRequestState %q", s)
+	}
+	return GattRequestState(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v GattRequestState) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *GattRequestState) UnmarshalText(text []byte) error {
+	parsed, err := ParseGattRequestState(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -6,63 +6,144 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattServiceProvider string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProvider;{7822b3cd-2889-4f86-a051-3f0aed1c2760})"
 
+const ContractNameGattServiceProvider string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattServiceProvider uint32 = 0x00040000
+
+// GattServiceProvider was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattServiceProvider struct {
 	ole.IUnknown
 }
 
+func (impl *GattServiceProvider) Signature() string {
+	return SignatureGattServiceProvider
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProvider class is available in the running version of Windows.
+func (impl *GattServiceProvider) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProvider")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattServiceProvider, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattServiceProvider) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetService", "GetAdvertisementStatus", "AddAdvertisementStatusChanged", "OnAdvertisementStatusChanged", "RemoveAdvertisementStatusChanged", "StartAdvertising", "StartAdvertisingWithParameters", "StopAdvertising":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider")
+	case "GattServiceProviderCreateAsync":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderStatics")
+	}
+	return false, fmt.Errorf("unknown method %q of GattServiceProvider", name)
+}
+
 func (impl *GattServiceProvider) GetService() (*GattLocalService, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProvider))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProvider, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProvider)(unsafe.Pointer(itf))
 	return v.GetService()
 }
 
 func (impl *GattServiceProvider) GetAdvertisementStatus() (GattServiceProviderAdvertisementStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProvider))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProvider, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider")
+	if err != nil {
+		return GattServiceProviderAdvertisementStatusCreated, err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProvider)(unsafe.Pointer(itf))
 	return v.GetAdvertisementStatus()
 }
 
-func (impl *GattServiceProvider) AddAdvertisementStatusChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProvider))
+func (impl *GattServiceProvider) AddAdvertisementStatusChanged(handler *foundation.TypedEventHandler[*GattServiceProvider, *GattServiceProviderAdvertisementStatusChangedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProvider, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProvider)(unsafe.Pointer(itf))
 	return v.AddAdvertisementStatusChanged(handler)
 }
 
+// OnAdvertisementStatusChanged registers the given function as a handler of the event, using AddAdvertisementStatusChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveAdvertisementStatusChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GattServiceProvider) OnAdvertisementStatusChanged(callback func(sender *GattServiceProvider, args *GattServiceProviderAdvertisementStatusChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattServiceProvider, *GattServiceProviderAdvertisementStatusChangedEventArgs](func(_ *foundation.TypedEventHandler[*GattServiceProvider, *GattServiceProviderAdvertisementStatusChangedEventArgs], sender *GattServiceProvider, args *GattServiceProviderAdvertisementStatusChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddAdvertisementStatusChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveAdvertisementStatusChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GattServiceProvider) RemoveAdvertisementStatusChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProvider))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProvider, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProvider)(unsafe.Pointer(itf))
 	return v.RemoveAdvertisementStatusChanged(token)
 }
 
 func (impl *GattServiceProvider) StartAdvertising() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProvider))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProvider, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProvider)(unsafe.Pointer(itf))
 	return v.StartAdvertising()
 }
 
 func (impl *GattServiceProvider) StartAdvertisingWithParameters(parameters *GattServiceProviderAdvertisingParameters) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProvider))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProvider, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProvider)(unsafe.Pointer(itf))
 	return v.StartAdvertisingWithParameters(parameters)
 }
 
 func (impl *GattServiceProvider) StopAdvertising() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProvider))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProvider, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProvider)(unsafe.Pointer(itf))
 	return v.StopAdvertising()
@@ -71,10 +152,20 @@ func (impl *GattServiceProvider) StopAdvertising() error {
 const GUIDiGattServiceProvider string = "7822b3cd-2889-4f86-a051-3f0aed1c2760"
 const SignatureiGattServiceProvider string = "{7822b3cd-2889-4f86-a051-3f0aed1c2760}"
 
+var IIDiGattServiceProvider = ole.GUID{Data1: 0x7822B3CD, Data2: 0x2889, Data3: 0x4F86, Data4: [8]byte{0xA0, 0x51, 0x3F, 0x0A, 0xED, 0x1C, 0x27, 0x60}}
+
+const ContractNameiGattServiceProvider string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattServiceProvider uint32 = 0x00040000
+
+// iGattServiceProvider was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattServiceProvider struct {
 	ole.IInspectable
 }
 
+func (v *iGattServiceProvider) Signature() string {
+	return SignatureiGattServiceProvider
+}
+
 type iGattServiceProviderVtbl struct {
 	ole.IInspectableVtbl
 
@@ -93,6 +184,10 @@ func (v *iGattServiceProvider) VTable() *iGattServiceProviderVtbl {
 
 func (v *iGattServiceProvider) GetService() (*GattLocalService, error) {
 	var out *GattLocalService
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetService,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -100,7 +195,7 @@ func (v *iGattServiceProvider) GetService() (*GattLocalService, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider", "get_Service")
 	}
 
 	return out, nil
@@ -108,6 +203,10 @@ func (v *iGattServiceProvider) GetService() (*GattLocalService, error) {
 
 func (v *iGattServiceProvider) GetAdvertisementStatus() (GattServiceProviderAdvertisementStatus, error) {
 	var out GattServiceProviderAdvertisementStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetAdvertisementStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -115,56 +214,108 @@ func (v *iGattServiceProvider) GetAdvertisementStatus() (GattServiceProviderAdve
 	)
 
 	if hr != 0 {
-		return GattServiceProviderAdvertisementStatusCreated, ole.NewError(hr)
+		return GattServiceProviderAdvertisementStatusCreated, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider", "get_AdvertisementStatus")
 	}
 
 	return out, nil
 }
 
-func (v *iGattServiceProvider) AddAdvertisementStatusChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGattServiceProvider) AddAdvertisementStatusChanged(handler *foundation.TypedEventHandler[*GattServiceProvider, *GattServiceProviderAdvertisementStatusChangedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddAdvertisementStatusChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GattServiceProvider, *GattServiceProviderAdvertisementStatusChangedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider", "add_AdvertisementStatusChanged")
 	}
 
 	return out, nil
 }
 
+// OnAdvertisementStatusChanged registers the given function as a handler of the event, using AddAdvertisementStatusChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveAdvertisementStatusChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGattServiceProvider) OnAdvertisementStatusChanged(callback func(sender *GattServiceProvider, args *GattServiceProviderAdvertisementStatusChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattServiceProvider, *GattServiceProviderAdvertisementStatusChangedEventArgs](func(_ *foundation.TypedEventHandler[*GattServiceProvider, *GattServiceProviderAdvertisementStatusChangedEventArgs], sender *GattServiceProvider, args *GattServiceProviderAdvertisementStatusChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddAdvertisementStatusChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveAdvertisementStatusChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGattServiceProvider) RemoveAdvertisementStatusChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveAdvertisementStatusChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveAdvertisementStatusChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider", "remove_AdvertisementStatusChanged")
 	}
 
 	return nil
 }
 
 func (v *iGattServiceProvider) StartAdvertising() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().StartAdvertising,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider", "StartAdvertising")
 	}
 
 	return nil
 }
 
 func (v *iGattServiceProvider) StartAdvertisingWithParameters(parameters *GattServiceProviderAdvertisingParameters) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().StartAdvertisingWithParameters,
 		uintptr(unsafe.Pointer(v)),          // this
@@ -172,20 +323,24 @@ func (v *iGattServiceProvider) StartAdvertisingWithParameters(parameters *GattSe
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider", "StartAdvertisingWithParameters")
 	}
 
 	return nil
 }
 
 func (v *iGattServiceProvider) StopAdvertising() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().StopAdvertising,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProvider", "StopAdvertising")
 	}
 
 	return nil
@@ -194,10 +349,20 @@ func (v *iGattServiceProvider) StopAdvertising() error {
 const GUIDiGattServiceProviderStatics string = "31794063-5256-4054-a4f4-7bbe7755a57e"
 const SignatureiGattServiceProviderStatics string = "{31794063-5256-4054-a4f4-7bbe7755a57e}"
 
+var IIDiGattServiceProviderStatics = ole.GUID{Data1: 0x31794063, Data2: 0x5256, Data3: 0x4054, Data4: [8]byte{0xA4, 0xF4, 0x7B, 0xBE, 0x77, 0x55, 0xA5, 0x7E}}
+
+const ContractNameiGattServiceProviderStatics string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattServiceProviderStatics uint32 = 0x00040000
+
+// iGattServiceProviderStatics was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattServiceProviderStatics struct {
 	ole.IInspectable
 }
 
+func (v *iGattServiceProviderStatics) Signature() string {
+	return SignatureiGattServiceProviderStatics
+}
+
 type iGattServiceProviderStaticsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -208,23 +373,34 @@ func (v *iGattServiceProviderStatics) VTable() *iGattServiceProviderStaticsVtbl
 	return (*iGattServiceProviderStaticsVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func GattServiceProviderCreateAsync(serviceUuid syscall.GUID) (*foundation.IAsyncOperation, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProvider", ole.NewGUID(GUIDiGattServiceProviderStatics))
+func GattServiceProviderCreateAsync(serviceUuid syscall.GUID) (*IAsyncOperationGattServiceProviderResult, error) {
+	inspectable, err := winrt.GetActivationFactory("Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProvider", &IIDiGattServiceProviderStatics)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iGattServiceProviderStatics)(unsafe.Pointer(inspectable))
 
-	var out *foundation.IAsyncOperation
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().GattServiceProviderCreateAsync,
-		uintptr(unsafe.Pointer(v)),            // this
-		uintptr(unsafe.Pointer(&serviceUuid)), // in syscall.GUID
-		uintptr(unsafe.Pointer(&out)),         // out foundation.IAsyncOperation
-	)
+	var out *IAsyncOperationGattServiceProviderResult
+	serviceUuidABI, err := winrt.NewInValue(serviceUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer serviceUuidABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	serviceUuidSlots := serviceUuidABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 2+len(serviceUuidSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v)))    // this
+	callArgs = append(callArgs, serviceUuidSlots...)           // in syscall.GUID
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(&out))) // out IAsyncOperationGattServiceProviderResult
+	hr, _, _ := syscall.SyscallN(v.VTable().GattServiceProviderCreateAsync, callArgs...)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderStatics", "CreateAsync")
 	}
 
 	return out, nil
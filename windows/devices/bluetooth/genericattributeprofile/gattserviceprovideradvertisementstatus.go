@@ -5,10 +5,23 @@
 //nolint:all
 package genericattributeprofile
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameGattServiceProviderAdvertisementStatus string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattServiceProviderAdvertisementStatus uint32 = 0x00040000
+
+// GattServiceProviderAdvertisementStatus was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattServiceProviderAdvertisementStatus int32
 
 const SignatureGattServiceProviderAdvertisementStatus string = "enum(Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProviderAdvertisementStatus;i4)"
 
+func (v GattServiceProviderAdvertisementStatus) Signature() string {
+	return SignatureGattServiceProviderAdvertisementStatus
+}
+
 const (
 	GattServiceProviderAdvertisementStatusCreated                            GattServiceProviderAdvertisementStatus = 0
 	GattServiceProviderAdvertisementStatusStopped                            GattServiceProviderAdvertisementStatus = 1
@@ -16,3 +29,54 @@ const (
 	GattServiceProviderAdvertisementStatusAborted                            GattServiceProviderAdvertisementStatus = 3
 	GattServiceProviderAdvertisementStatusStartedWithoutAllAdvertisementData GattServiceProviderAdvertisementStatus = 4
 )
+
+var namesGattServiceProviderAdvertisementStatus = map[GattServiceProviderAdvertisementStatus]string{
+	GattServiceProviderAdvertisementStatusCreated:                            "Created",
+	GattServiceProviderAdvertisementStatusStopped:                            "Stopped",
+	GattServiceProviderAdvertisementStatusStarted:                            "Started",
+	GattServiceProviderAdvertisementStatusAborted:                            "Aborted",
+	GattServiceProviderAdvertisementStatusStartedWithoutAllAdvertisementData: "StartedWithoutAllAdvertisementData",
+}
+
+var valuesGattServiceProviderAdvertisementStatus = map[string]GattServiceProviderAdvertisementStatus{
+	"Created":                            GattServiceProviderAdvertisementStatusCreated,
+	"Stopped":                            GattServiceProviderAdvertisementStatusStopped,
+	"Started":                            GattServiceProviderAdvertisementStatusStarted,
+	"Aborted":                            GattServiceProviderAdvertisementStatusAborted,
+	"StartedWithoutAllAdvertisementData": GattServiceProviderAdvertisementStatusStartedWithoutAllAdvertisementData,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v GattServiceProviderAdvertisementStatus) String() string {
+	if name, ok := namesGattServiceProviderAdvertisementStatus[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseGattServiceProviderAdvertisementStatus parses the name of a value, as returned by String.
+func ParseGattServiceProviderAdvertisementStatus(s string) (GattServiceProviderAdvertisementStatus, error) {
+	if v, ok := valuesGattServiceProviderAdvertisementStatus[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid GattServiceProviderAdvertisementStatus %q", s)
+	}
+	return GattServiceProviderAdvertisementStatus(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v GattServiceProviderAdvertisementStatus) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *GattServiceProviderAdvertisementStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseGattServiceProviderAdvertisementStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -6,21 +6,50 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/storage/streams"
 )
 
 const SignatureGattServiceProviderAdvertisingParameters string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProviderAdvertisingParameters;{e2ce31ab-6315-4c22-9bd7-781dbc3d8d82})"
 
+const ContractNameGattServiceProviderAdvertisingParameters string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattServiceProviderAdvertisingParameters uint32 = 0x00040000
+
+// GattServiceProviderAdvertisingParameters was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattServiceProviderAdvertisingParameters struct {
 	ole.IUnknown
 }
 
+func (impl *GattServiceProviderAdvertisingParameters) Signature() string {
+	return SignatureGattServiceProviderAdvertisingParameters
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProviderAdvertisingParameters class is available in the running version of Windows.
+func (impl *GattServiceProviderAdvertisingParameters) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProviderAdvertisingParameters")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattServiceProviderAdvertisingParameters, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattServiceProviderAdvertisingParameters) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "SetIsConnectable", "GetIsConnectable", "SetIsDiscoverable", "GetIsDiscoverable":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters")
+	case "SetServiceData", "GetServiceData":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters2")
+	}
+	return false, fmt.Errorf("unknown method %q of GattServiceProviderAdvertisingParameters", name)
+}
+
 func NewGattServiceProviderAdvertisingParameters() (*GattServiceProviderAdvertisingParameters, error) {
-	inspectable, err := ole.RoActivateInstance("Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProviderAdvertisingParameters")
+	inspectable, err := winrt.ActivateInstance("Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProviderAdvertisingParameters")
 	if err != nil {
 		return nil, err
 	}
@@ -28,42 +57,60 @@ func NewGattServiceProviderAdvertisingParameters() (*GattServiceProviderAdvertis
 }
 
 func (impl *GattServiceProviderAdvertisingParameters) SetIsConnectable(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProviderAdvertisingParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProviderAdvertisingParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProviderAdvertisingParameters)(unsafe.Pointer(itf))
 	return v.SetIsConnectable(value)
 }
 
 func (impl *GattServiceProviderAdvertisingParameters) GetIsConnectable() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProviderAdvertisingParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProviderAdvertisingParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProviderAdvertisingParameters)(unsafe.Pointer(itf))
 	return v.GetIsConnectable()
 }
 
 func (impl *GattServiceProviderAdvertisingParameters) SetIsDiscoverable(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProviderAdvertisingParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProviderAdvertisingParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProviderAdvertisingParameters)(unsafe.Pointer(itf))
 	return v.SetIsDiscoverable(value)
 }
 
 func (impl *GattServiceProviderAdvertisingParameters) GetIsDiscoverable() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProviderAdvertisingParameters))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProviderAdvertisingParameters, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProviderAdvertisingParameters)(unsafe.Pointer(itf))
 	return v.GetIsDiscoverable()
 }
 
 func (impl *GattServiceProviderAdvertisingParameters) SetServiceData(value *streams.IBuffer) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProviderAdvertisingParameters2))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProviderAdvertisingParameters2, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters2")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProviderAdvertisingParameters2)(unsafe.Pointer(itf))
 	return v.SetServiceData(value)
 }
 
 func (impl *GattServiceProviderAdvertisingParameters) GetServiceData() (*streams.IBuffer, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProviderAdvertisingParameters2))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProviderAdvertisingParameters2, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters2")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProviderAdvertisingParameters2)(unsafe.Pointer(itf))
 	return v.GetServiceData()
@@ -72,10 +119,20 @@ func (impl *GattServiceProviderAdvertisingParameters) GetServiceData() (*streams
 const GUIDiGattServiceProviderAdvertisingParameters string = "e2ce31ab-6315-4c22-9bd7-781dbc3d8d82"
 const SignatureiGattServiceProviderAdvertisingParameters string = "{e2ce31ab-6315-4c22-9bd7-781dbc3d8d82}"
 
+var IIDiGattServiceProviderAdvertisingParameters = ole.GUID{Data1: 0xE2CE31AB, Data2: 0x6315, Data3: 0x4C22, Data4: [8]byte{0x9B, 0xD7, 0x78, 0x1D, 0xBC, 0x3D, 0x8D, 0x82}}
+
+const ContractNameiGattServiceProviderAdvertisingParameters string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattServiceProviderAdvertisingParameters uint32 = 0x00040000
+
+// iGattServiceProviderAdvertisingParameters was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattServiceProviderAdvertisingParameters struct {
 	ole.IInspectable
 }
 
+func (v *iGattServiceProviderAdvertisingParameters) Signature() string {
+	return SignatureiGattServiceProviderAdvertisingParameters
+}
+
 type iGattServiceProviderAdvertisingParametersVtbl struct {
 	ole.IInspectableVtbl
 
@@ -90,6 +147,10 @@ func (v *iGattServiceProviderAdvertisingParameters) VTable() *iGattServiceProvid
 }
 
 func (v *iGattServiceProviderAdvertisingParameters) SetIsConnectable(value bool) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetIsConnectable,
 		uintptr(unsafe.Pointer(v)),                // this
@@ -97,7 +158,7 @@ func (v *iGattServiceProviderAdvertisingParameters) SetIsConnectable(value bool)
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters", "put_IsConnectable")
 	}
 
 	return nil
@@ -105,6 +166,10 @@ func (v *iGattServiceProviderAdvertisingParameters) SetIsConnectable(value bool)
 
 func (v *iGattServiceProviderAdvertisingParameters) GetIsConnectable() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsConnectable,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -112,13 +177,17 @@ func (v *iGattServiceProviderAdvertisingParameters) GetIsConnectable() (bool, er
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters", "get_IsConnectable")
 	}
 
 	return out, nil
 }
 
 func (v *iGattServiceProviderAdvertisingParameters) SetIsDiscoverable(value bool) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetIsDiscoverable,
 		uintptr(unsafe.Pointer(v)),                // this
@@ -126,7 +195,7 @@ func (v *iGattServiceProviderAdvertisingParameters) SetIsDiscoverable(value bool
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters", "put_IsDiscoverable")
 	}
 
 	return nil
@@ -134,6 +203,10 @@ func (v *iGattServiceProviderAdvertisingParameters) SetIsDiscoverable(value bool
 
 func (v *iGattServiceProviderAdvertisingParameters) GetIsDiscoverable() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsDiscoverable,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -141,7 +214,7 @@ func (v *iGattServiceProviderAdvertisingParameters) GetIsDiscoverable() (bool, e
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters", "get_IsDiscoverable")
 	}
 
 	return out, nil
@@ -150,10 +223,20 @@ func (v *iGattServiceProviderAdvertisingParameters) GetIsDiscoverable() (bool, e
 const GUIDiGattServiceProviderAdvertisingParameters2 string = "ff68468d-ca92-4434-9743-0e90988ad879"
 const SignatureiGattServiceProviderAdvertisingParameters2 string = "{ff68468d-ca92-4434-9743-0e90988ad879}"
 
+var IIDiGattServiceProviderAdvertisingParameters2 = ole.GUID{Data1: 0xFF68468D, Data2: 0xCA92, Data3: 0x4434, Data4: [8]byte{0x97, 0x43, 0x0E, 0x90, 0x98, 0x8A, 0xD8, 0x79}}
+
+const ContractNameiGattServiceProviderAdvertisingParameters2 string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattServiceProviderAdvertisingParameters2 uint32 = 0x00080000
+
+// iGattServiceProviderAdvertisingParameters2 was introduced in Windows.Foundation.UniversalApiContract v8.0.
 type iGattServiceProviderAdvertisingParameters2 struct {
 	ole.IInspectable
 }
 
+func (v *iGattServiceProviderAdvertisingParameters2) Signature() string {
+	return SignatureiGattServiceProviderAdvertisingParameters2
+}
+
 type iGattServiceProviderAdvertisingParameters2Vtbl struct {
 	ole.IInspectableVtbl
 
@@ -166,6 +249,10 @@ func (v *iGattServiceProviderAdvertisingParameters2) VTable() *iGattServiceProvi
 }
 
 func (v *iGattServiceProviderAdvertisingParameters2) SetServiceData(value *streams.IBuffer) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetServiceData,
 		uintptr(unsafe.Pointer(v)),     // this
@@ -173,7 +260,7 @@ func (v *iGattServiceProviderAdvertisingParameters2) SetServiceData(value *strea
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters2", "put_ServiceData")
 	}
 
 	return nil
@@ -181,6 +268,10 @@ func (v *iGattServiceProviderAdvertisingParameters2) SetServiceData(value *strea
 
 func (v *iGattServiceProviderAdvertisingParameters2) GetServiceData() (*streams.IBuffer, error) {
 	var out *streams.IBuffer
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetServiceData,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -188,7 +279,7 @@ func (v *iGattServiceProviderAdvertisingParameters2) GetServiceData() (*streams.
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderAdvertisingParameters2", "get_ServiceData")
 	}
 
 	return out, nil
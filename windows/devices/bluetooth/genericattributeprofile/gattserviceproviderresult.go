@@ -6,28 +6,61 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/devices/bluetooth"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattServiceProviderResult string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProviderResult;{764696d8-c53e-428c-8a48-67afe02c3ae6})"
 
+const ContractNameGattServiceProviderResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattServiceProviderResult uint32 = 0x00040000
+
+// GattServiceProviderResult was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattServiceProviderResult struct {
 	ole.IUnknown
 }
 
+func (impl *GattServiceProviderResult) Signature() string {
+	return SignatureGattServiceProviderResult
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProviderResult class is available in the running version of Windows.
+func (impl *GattServiceProviderResult) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattServiceProviderResult")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattServiceProviderResult, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattServiceProviderResult) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetError", "GetServiceProvider":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderResult")
+	}
+	return false, fmt.Errorf("unknown method %q of GattServiceProviderResult", name)
+}
+
 func (impl *GattServiceProviderResult) GetError() (bluetooth.BluetoothError, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProviderResult))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProviderResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderResult")
+	if err != nil {
+		return bluetooth.BluetoothErrorSuccess, err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProviderResult)(unsafe.Pointer(itf))
 	return v.GetError()
 }
 
 func (impl *GattServiceProviderResult) GetServiceProvider() (*GattServiceProvider, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattServiceProviderResult))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattServiceProviderResult, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderResult")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattServiceProviderResult)(unsafe.Pointer(itf))
 	return v.GetServiceProvider()
@@ -36,10 +69,20 @@ func (impl *GattServiceProviderResult) GetServiceProvider() (*GattServiceProvide
 const GUIDiGattServiceProviderResult string = "764696d8-c53e-428c-8a48-67afe02c3ae6"
 const SignatureiGattServiceProviderResult string = "{764696d8-c53e-428c-8a48-67afe02c3ae6}"
 
+var IIDiGattServiceProviderResult = ole.GUID{Data1: 0x764696D8, Data2: 0xC53E, Data3: 0x428C, Data4: [8]byte{0x8A, 0x48, 0x67, 0xAF, 0xE0, 0x2C, 0x3A, 0xE6}}
+
+const ContractNameiGattServiceProviderResult string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattServiceProviderResult uint32 = 0x00040000
+
+// iGattServiceProviderResult was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattServiceProviderResult struct {
 	ole.IInspectable
 }
 
+func (v *iGattServiceProviderResult) Signature() string {
+	return SignatureiGattServiceProviderResult
+}
+
 type iGattServiceProviderResultVtbl struct {
 	ole.IInspectableVtbl
 
@@ -53,6 +96,10 @@ func (v *iGattServiceProviderResult) VTable() *iGattServiceProviderResultVtbl {
 
 func (v *iGattServiceProviderResult) GetError() (bluetooth.BluetoothError, error) {
 	var out bluetooth.BluetoothError
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetError,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -60,7 +107,7 @@ func (v *iGattServiceProviderResult) GetError() (bluetooth.BluetoothError, error
 	)
 
 	if hr != 0 {
-		return bluetooth.BluetoothErrorSuccess, ole.NewError(hr)
+		return bluetooth.BluetoothErrorSuccess, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderResult", "get_Error")
 	}
 
 	return out, nil
@@ -68,6 +115,10 @@ func (v *iGattServiceProviderResult) GetError() (bluetooth.BluetoothError, error
 
 func (v *iGattServiceProviderResult) GetServiceProvider() (*GattServiceProvider, error) {
 	var out *GattServiceProvider
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetServiceProvider,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -75,7 +126,7 @@ func (v *iGattServiceProviderResult) GetServiceProvider() (*GattServiceProvider,
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattServiceProviderResult", "get_ServiceProvider")
 	}
 
 	return out, nil
@@ -6,85 +6,207 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/devices/bluetooth"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattSession string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattSession;{d23b5143-e04e-4c24-999c-9c256f9856b1})"
 
+const ContractNameGattSession string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattSession uint32 = 0x00040000
+
+// GattSession was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattSession struct {
 	ole.IUnknown
 }
 
+func (impl *GattSession) Signature() string {
+	return SignatureGattSession
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattSession class is available in the running version of Windows.
+func (impl *GattSession) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattSession")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattSession, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattSession) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetCanMaintainConnection", "SetMaintainConnection", "GetMaintainConnection", "GetMaxPduSize", "GetSessionStatus", "AddMaxPduSizeChanged", "OnMaxPduSizeChanged", "RemoveMaxPduSizeChanged", "AddSessionStatusChanged", "OnSessionStatusChanged", "RemoveSessionStatusChanged":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession")
+	case "Close":
+		return metadata.ApiInformationIsTypePresent("Windows.Foundation.IClosable")
+	case "GattSessionFromDeviceIdAsync":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSessionStatics")
+	}
+	return false, fmt.Errorf("unknown method %q of GattSession", name)
+}
+
 func (impl *GattSession) GetCanMaintainConnection() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSession, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGattSession)(unsafe.Pointer(itf))
 	return v.GetCanMaintainConnection()
 }
 
 func (impl *GattSession) SetMaintainConnection(value bool) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSession, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattSession)(unsafe.Pointer(itf))
 	return v.SetMaintainConnection(value)
 }
 
 func (impl *GattSession) GetMaintainConnection() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSession, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGattSession)(unsafe.Pointer(itf))
 	return v.GetMaintainConnection()
 }
 
 func (impl *GattSession) GetMaxPduSize() (uint16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSession, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iGattSession)(unsafe.Pointer(itf))
 	return v.GetMaxPduSize()
 }
 
 func (impl *GattSession) GetSessionStatus() (GattSessionStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSession, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession")
+	if err != nil {
+		return GattSessionStatusClosed, err
+	}
 	defer itf.Release()
 	v := (*iGattSession)(unsafe.Pointer(itf))
 	return v.GetSessionStatus()
 }
 
-func (impl *GattSession) AddMaxPduSizeChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSession))
+func (impl *GattSession) AddMaxPduSizeChanged(handler *foundation.TypedEventHandler[*GattSession, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSession, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGattSession)(unsafe.Pointer(itf))
 	return v.AddMaxPduSizeChanged(handler)
 }
 
+// OnMaxPduSizeChanged registers the given function as a handler of the event, using AddMaxPduSizeChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveMaxPduSizeChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GattSession) OnMaxPduSizeChanged(callback func(sender *GattSession, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattSession, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*GattSession, unsafe.Pointer], sender *GattSession, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddMaxPduSizeChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveMaxPduSizeChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GattSession) RemoveMaxPduSizeChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSession, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattSession)(unsafe.Pointer(itf))
 	return v.RemoveMaxPduSizeChanged(token)
 }
 
-func (impl *GattSession) AddSessionStatusChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSession))
+func (impl *GattSession) AddSessionStatusChanged(handler *foundation.TypedEventHandler[*GattSession, *GattSessionStatusChangedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSession, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGattSession)(unsafe.Pointer(itf))
 	return v.AddSessionStatusChanged(handler)
 }
 
+// OnSessionStatusChanged registers the given function as a handler of the event, using AddSessionStatusChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveSessionStatusChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GattSession) OnSessionStatusChanged(callback func(sender *GattSession, args *GattSessionStatusChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattSession, *GattSessionStatusChangedEventArgs](func(_ *foundation.TypedEventHandler[*GattSession, *GattSessionStatusChangedEventArgs], sender *GattSession, args *GattSessionStatusChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddSessionStatusChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveSessionStatusChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GattSession) RemoveSessionStatusChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSession, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattSession)(unsafe.Pointer(itf))
 	return v.RemoveSessionStatusChanged(token)
 }
 
 func (impl *GattSession) Close() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(foundation.GUIDIClosable))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &foundation.IIDIClosable, "Windows.Foundation.IClosable")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*foundation.IClosable)(unsafe.Pointer(itf))
 	return v.Close()
@@ -93,10 +215,20 @@ func (impl *GattSession) Close() error {
 const GUIDiGattSession string = "d23b5143-e04e-4c24-999c-9c256f9856b1"
 const SignatureiGattSession string = "{d23b5143-e04e-4c24-999c-9c256f9856b1}"
 
+var IIDiGattSession = ole.GUID{Data1: 0xD23B5143, Data2: 0xE04E, Data3: 0x4C24, Data4: [8]byte{0x99, 0x9C, 0x9C, 0x25, 0x6F, 0x98, 0x56, 0xB1}}
+
+const ContractNameiGattSession string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattSession uint32 = 0x00040000
+
+// iGattSession was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattSession struct {
 	ole.IInspectable
 }
 
+func (v *iGattSession) Signature() string {
+	return SignatureiGattSession
+}
+
 type iGattSessionVtbl struct {
 	ole.IInspectableVtbl
 
@@ -118,6 +250,10 @@ func (v *iGattSession) VTable() *iGattSessionVtbl {
 
 func (v *iGattSession) GetCanMaintainConnection() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCanMaintainConnection,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -125,13 +261,17 @@ func (v *iGattSession) GetCanMaintainConnection() (bool, error) {
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession", "get_CanMaintainConnection")
 	}
 
 	return out, nil
 }
 
 func (v *iGattSession) SetMaintainConnection(value bool) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetMaintainConnection,
 		uintptr(unsafe.Pointer(v)),                // this
@@ -139,7 +279,7 @@ func (v *iGattSession) SetMaintainConnection(value bool) error {
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession", "put_MaintainConnection")
 	}
 
 	return nil
@@ -147,6 +287,10 @@ func (v *iGattSession) SetMaintainConnection(value bool) error {
 
 func (v *iGattSession) GetMaintainConnection() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetMaintainConnection,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -154,7 +298,7 @@ func (v *iGattSession) GetMaintainConnection() (bool, error) {
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession", "get_MaintainConnection")
 	}
 
 	return out, nil
@@ -162,6 +306,10 @@ func (v *iGattSession) GetMaintainConnection() (bool, error) {
 
 func (v *iGattSession) GetMaxPduSize() (uint16, error) {
 	var out uint16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetMaxPduSize,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -169,7 +317,7 @@ func (v *iGattSession) GetMaxPduSize() (uint16, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession", "get_MaxPduSize")
 	}
 
 	return out, nil
@@ -177,6 +325,10 @@ func (v *iGattSession) GetMaxPduSize() (uint16, error) {
 
 func (v *iGattSession) GetSessionStatus() (GattSessionStatus, error) {
 	var out GattSessionStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetSessionStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -184,67 +336,155 @@ func (v *iGattSession) GetSessionStatus() (GattSessionStatus, error) {
 	)
 
 	if hr != 0 {
-		return GattSessionStatusClosed, ole.NewError(hr)
+		return GattSessionStatusClosed, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession", "get_SessionStatus")
 	}
 
 	return out, nil
 }
 
-func (v *iGattSession) AddMaxPduSizeChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGattSession) AddMaxPduSizeChanged(handler *foundation.TypedEventHandler[*GattSession, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddMaxPduSizeChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GattSession, unsafe.Pointer]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession", "add_MaxPduSizeChanged")
 	}
 
 	return out, nil
 }
 
+// OnMaxPduSizeChanged registers the given function as a handler of the event, using AddMaxPduSizeChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveMaxPduSizeChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGattSession) OnMaxPduSizeChanged(callback func(sender *GattSession, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattSession, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*GattSession, unsafe.Pointer], sender *GattSession, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddMaxPduSizeChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveMaxPduSizeChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGattSession) RemoveMaxPduSizeChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveMaxPduSizeChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveMaxPduSizeChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession", "remove_MaxPduSizeChanged")
 	}
 
 	return nil
 }
 
-func (v *iGattSession) AddSessionStatusChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGattSession) AddSessionStatusChanged(handler *foundation.TypedEventHandler[*GattSession, *GattSessionStatusChangedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddSessionStatusChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GattSession, *GattSessionStatusChangedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession", "add_SessionStatusChanged")
 	}
 
 	return out, nil
 }
 
+// OnSessionStatusChanged registers the given function as a handler of the event, using AddSessionStatusChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveSessionStatusChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGattSession) OnSessionStatusChanged(callback func(sender *GattSession, args *GattSessionStatusChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattSession, *GattSessionStatusChangedEventArgs](func(_ *foundation.TypedEventHandler[*GattSession, *GattSessionStatusChangedEventArgs], sender *GattSession, args *GattSessionStatusChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddSessionStatusChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveSessionStatusChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGattSession) RemoveSessionStatusChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveSessionStatusChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveSessionStatusChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSession", "remove_SessionStatusChanged")
 	}
 
 	return nil
@@ -253,10 +493,20 @@ func (v *iGattSession) RemoveSessionStatusChanged(token foundation.EventRegistra
 const GUIDiGattSessionStatics string = "2e65b95c-539f-4db7-82a8-73bdbbf73ebf"
 const SignatureiGattSessionStatics string = "{2e65b95c-539f-4db7-82a8-73bdbbf73ebf}"
 
+var IIDiGattSessionStatics = ole.GUID{Data1: 0x2E65B95C, Data2: 0x539F, Data3: 0x4DB7, Data4: [8]byte{0x82, 0xA8, 0x73, 0xBD, 0xBB, 0xF7, 0x3E, 0xBF}}
+
+const ContractNameiGattSessionStatics string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattSessionStatics uint32 = 0x00040000
+
+// iGattSessionStatics was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattSessionStatics struct {
 	ole.IInspectable
 }
 
+func (v *iGattSessionStatics) Signature() string {
+	return SignatureiGattSessionStatics
+}
+
 type iGattSessionStaticsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -267,23 +517,28 @@ func (v *iGattSessionStatics) VTable() *iGattSessionStaticsVtbl {
 	return (*iGattSessionStaticsVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func GattSessionFromDeviceIdAsync(deviceId *bluetooth.BluetoothDeviceId) (*foundation.IAsyncOperation, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Devices.Bluetooth.GenericAttributeProfile.GattSession", ole.NewGUID(GUIDiGattSessionStatics))
+func GattSessionFromDeviceIdAsync(deviceId *bluetooth.BluetoothDeviceId) (*IAsyncOperationGattSession, error) {
+	inspectable, err := winrt.GetActivationFactory("Windows.Devices.Bluetooth.GenericAttributeProfile.GattSession", &IIDiGattSessionStatics)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iGattSessionStatics)(unsafe.Pointer(inspectable))
 
-	var out *foundation.IAsyncOperation
+	var out *IAsyncOperationGattSession
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GattSessionFromDeviceIdAsync,
 		uintptr(unsafe.Pointer(v)),        // this
 		uintptr(unsafe.Pointer(deviceId)), // in bluetooth.BluetoothDeviceId
-		uintptr(unsafe.Pointer(&out)),     // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)),     // out IAsyncOperationGattSession
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSessionStatics", "FromDeviceIdAsync")
 	}
 
 	return out, nil
@@ -5,11 +5,69 @@
 //nolint:all
 package genericattributeprofile
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameGattSessionStatus string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattSessionStatus uint32 = 0x00040000
+
+// GattSessionStatus was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattSessionStatus int32
 
 const SignatureGattSessionStatus string = "enum(Windows.Devices.Bluetooth.GenericAttributeProfile.GattSessionStatus;i4)"
 
+func (v GattSessionStatus) Signature() string {
+	return SignatureGattSessionStatus
+}
+
 const (
 	GattSessionStatusClosed GattSessionStatus = 0
 	GattSessionStatusActive GattSessionStatus = 1
 )
+
+var namesGattSessionStatus = map[GattSessionStatus]string{
+	GattSessionStatusClosed: "Closed",
+	GattSessionStatusActive: "Active",
+}
+
+var valuesGattSessionStatus = map[string]GattSessionStatus{
+	"Closed": GattSessionStatusClosed,
+	"Active": GattSessionStatusActive,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v GattSessionStatus) String() string {
+	if name, ok := namesGattSessionStatus[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseGattSessionStatus parses the name of a value, as returned by String.
+func ParseGattSessionStatus(s string) (GattSessionStatus, error) {
+	if v, ok := valuesGattSessionStatus[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid GattSessionStatus %q", s)
+	}
+	return GattSessionStatus(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v GattSessionStatus) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *GattSessionStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseGattSessionStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
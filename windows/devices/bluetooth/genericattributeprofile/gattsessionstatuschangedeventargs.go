@@ -6,28 +6,61 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/devices/bluetooth"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattSessionStatusChangedEventArgs string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattSessionStatusChangedEventArgs;{7605b72e-837f-404c-ab34-3163f39ddf32})"
 
+const ContractNameGattSessionStatusChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattSessionStatusChangedEventArgs uint32 = 0x00040000
+
+// GattSessionStatusChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattSessionStatusChangedEventArgs struct {
 	ole.IUnknown
 }
 
+func (impl *GattSessionStatusChangedEventArgs) Signature() string {
+	return SignatureGattSessionStatusChangedEventArgs
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattSessionStatusChangedEventArgs class is available in the running version of Windows.
+func (impl *GattSessionStatusChangedEventArgs) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattSessionStatusChangedEventArgs")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattSessionStatusChangedEventArgs, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattSessionStatusChangedEventArgs) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetError", "GetStatus":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSessionStatusChangedEventArgs")
+	}
+	return false, fmt.Errorf("unknown method %q of GattSessionStatusChangedEventArgs", name)
+}
+
 func (impl *GattSessionStatusChangedEventArgs) GetError() (bluetooth.BluetoothError, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSessionStatusChangedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSessionStatusChangedEventArgs, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSessionStatusChangedEventArgs")
+	if err != nil {
+		return bluetooth.BluetoothErrorSuccess, err
+	}
 	defer itf.Release()
 	v := (*iGattSessionStatusChangedEventArgs)(unsafe.Pointer(itf))
 	return v.GetError()
 }
 
 func (impl *GattSessionStatusChangedEventArgs) GetStatus() (GattSessionStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSessionStatusChangedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSessionStatusChangedEventArgs, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSessionStatusChangedEventArgs")
+	if err != nil {
+		return GattSessionStatusClosed, err
+	}
 	defer itf.Release()
 	v := (*iGattSessionStatusChangedEventArgs)(unsafe.Pointer(itf))
 	return v.GetStatus()
@@ -36,10 +69,20 @@ func (impl *GattSessionStatusChangedEventArgs) GetStatus() (GattSessionStatus, e
 const GUIDiGattSessionStatusChangedEventArgs string = "7605b72e-837f-404c-ab34-3163f39ddf32"
 const SignatureiGattSessionStatusChangedEventArgs string = "{7605b72e-837f-404c-ab34-3163f39ddf32}"
 
+var IIDiGattSessionStatusChangedEventArgs = ole.GUID{Data1: 0x7605B72E, Data2: 0x837F, Data3: 0x404C, Data4: [8]byte{0xAB, 0x34, 0x31, 0x63, 0xF3, 0x9D, 0xDF, 0x32}}
+
+const ContractNameiGattSessionStatusChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattSessionStatusChangedEventArgs uint32 = 0x00040000
+
+// iGattSessionStatusChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattSessionStatusChangedEventArgs struct {
 	ole.IInspectable
 }
 
+func (v *iGattSessionStatusChangedEventArgs) Signature() string {
+	return SignatureiGattSessionStatusChangedEventArgs
+}
+
 type iGattSessionStatusChangedEventArgsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -53,6 +96,10 @@ func (v *iGattSessionStatusChangedEventArgs) VTable() *iGattSessionStatusChanged
 
 func (v *iGattSessionStatusChangedEventArgs) GetError() (bluetooth.BluetoothError, error) {
 	var out bluetooth.BluetoothError
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetError,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -60,7 +107,7 @@ func (v *iGattSessionStatusChangedEventArgs) GetError() (bluetooth.BluetoothErro
 	)
 
 	if hr != 0 {
-		return bluetooth.BluetoothErrorSuccess, ole.NewError(hr)
+		return bluetooth.BluetoothErrorSuccess, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSessionStatusChangedEventArgs", "get_Error")
 	}
 
 	return out, nil
@@ -68,6 +115,10 @@ func (v *iGattSessionStatusChangedEventArgs) GetError() (bluetooth.BluetoothErro
 
 func (v *iGattSessionStatusChangedEventArgs) GetStatus() (GattSessionStatus, error) {
 	var out GattSessionStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -75,7 +126,7 @@ func (v *iGattSessionStatusChangedEventArgs) GetStatus() (GattSessionStatus, err
 	)
 
 	if hr != 0 {
-		return GattSessionStatusClosed, ole.NewError(hr)
+		return GattSessionStatusClosed, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSessionStatusChangedEventArgs", "get_Status")
 	}
 
 	return out, nil
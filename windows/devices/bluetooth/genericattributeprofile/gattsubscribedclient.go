@@ -6,42 +6,112 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattSubscribedClient string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattSubscribedClient;{736e9001-15a4-4ec2-9248-e3f20d463be9})"
 
+const ContractNameGattSubscribedClient string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattSubscribedClient uint32 = 0x00040000
+
+// GattSubscribedClient was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattSubscribedClient struct {
 	ole.IUnknown
 }
 
+func (impl *GattSubscribedClient) Signature() string {
+	return SignatureGattSubscribedClient
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattSubscribedClient class is available in the running version of Windows.
+func (impl *GattSubscribedClient) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattSubscribedClient")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattSubscribedClient, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattSubscribedClient) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetSession", "GetMaxNotificationSize", "AddMaxNotificationSizeChanged", "OnMaxNotificationSizeChanged", "RemoveMaxNotificationSizeChanged":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSubscribedClient")
+	}
+	return false, fmt.Errorf("unknown method %q of GattSubscribedClient", name)
+}
+
 func (impl *GattSubscribedClient) GetSession() (*GattSession, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSubscribedClient))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSubscribedClient, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSubscribedClient")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattSubscribedClient)(unsafe.Pointer(itf))
 	return v.GetSession()
 }
 
 func (impl *GattSubscribedClient) GetMaxNotificationSize() (uint16, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSubscribedClient))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSubscribedClient, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSubscribedClient")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iGattSubscribedClient)(unsafe.Pointer(itf))
 	return v.GetMaxNotificationSize()
 }
 
-func (impl *GattSubscribedClient) AddMaxNotificationSizeChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSubscribedClient))
+func (impl *GattSubscribedClient) AddMaxNotificationSizeChanged(handler *foundation.TypedEventHandler[*GattSubscribedClient, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSubscribedClient, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSubscribedClient")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGattSubscribedClient)(unsafe.Pointer(itf))
 	return v.AddMaxNotificationSizeChanged(handler)
 }
 
+// OnMaxNotificationSizeChanged registers the given function as a handler of the event, using AddMaxNotificationSizeChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveMaxNotificationSizeChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GattSubscribedClient) OnMaxNotificationSizeChanged(callback func(sender *GattSubscribedClient, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattSubscribedClient, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*GattSubscribedClient, unsafe.Pointer], sender *GattSubscribedClient, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddMaxNotificationSizeChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveMaxNotificationSizeChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GattSubscribedClient) RemoveMaxNotificationSizeChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattSubscribedClient))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattSubscribedClient, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSubscribedClient")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattSubscribedClient)(unsafe.Pointer(itf))
 	return v.RemoveMaxNotificationSizeChanged(token)
@@ -50,10 +120,20 @@ func (impl *GattSubscribedClient) RemoveMaxNotificationSizeChanged(token foundat
 const GUIDiGattSubscribedClient string = "736e9001-15a4-4ec2-9248-e3f20d463be9"
 const SignatureiGattSubscribedClient string = "{736e9001-15a4-4ec2-9248-e3f20d463be9}"
 
+var IIDiGattSubscribedClient = ole.GUID{Data1: 0x736E9001, Data2: 0x15A4, Data3: 0x4EC2, Data4: [8]byte{0x92, 0x48, 0xE3, 0xF2, 0x0D, 0x46, 0x3B, 0xE9}}
+
+const ContractNameiGattSubscribedClient string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattSubscribedClient uint32 = 0x00040000
+
+// iGattSubscribedClient was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattSubscribedClient struct {
 	ole.IInspectable
 }
 
+func (v *iGattSubscribedClient) Signature() string {
+	return SignatureiGattSubscribedClient
+}
+
 type iGattSubscribedClientVtbl struct {
 	ole.IInspectableVtbl
 
@@ -69,6 +149,10 @@ func (v *iGattSubscribedClient) VTable() *iGattSubscribedClientVtbl {
 
 func (v *iGattSubscribedClient) GetSession() (*GattSession, error) {
 	var out *GattSession
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetSession,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -76,7 +160,7 @@ func (v *iGattSubscribedClient) GetSession() (*GattSession, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSubscribedClient", "get_Session")
 	}
 
 	return out, nil
@@ -84,6 +168,10 @@ func (v *iGattSubscribedClient) GetSession() (*GattSession, error) {
 
 func (v *iGattSubscribedClient) GetMaxNotificationSize() (uint16, error) {
 	var out uint16
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetMaxNotificationSize,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -91,37 +179,81 @@ func (v *iGattSubscribedClient) GetMaxNotificationSize() (uint16, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSubscribedClient", "get_MaxNotificationSize")
 	}
 
 	return out, nil
 }
 
-func (v *iGattSubscribedClient) AddMaxNotificationSizeChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGattSubscribedClient) AddMaxNotificationSizeChanged(handler *foundation.TypedEventHandler[*GattSubscribedClient, unsafe.Pointer]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddMaxNotificationSizeChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GattSubscribedClient, unsafe.Pointer]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSubscribedClient", "add_MaxNotificationSizeChanged")
 	}
 
 	return out, nil
 }
 
+// OnMaxNotificationSizeChanged registers the given function as a handler of the event, using AddMaxNotificationSizeChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveMaxNotificationSizeChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGattSubscribedClient) OnMaxNotificationSizeChanged(callback func(sender *GattSubscribedClient, args unsafe.Pointer)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattSubscribedClient, unsafe.Pointer](func(_ *foundation.TypedEventHandler[*GattSubscribedClient, unsafe.Pointer], sender *GattSubscribedClient, args unsafe.Pointer) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddMaxNotificationSizeChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveMaxNotificationSizeChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGattSubscribedClient) RemoveMaxNotificationSizeChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveMaxNotificationSizeChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveMaxNotificationSizeChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattSubscribedClient", "remove_MaxNotificationSizeChanged")
 	}
 
 	return nil
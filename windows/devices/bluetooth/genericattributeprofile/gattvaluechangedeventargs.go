@@ -6,29 +6,62 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/storage/streams"
 )
 
 const SignatureGattValueChangedEventArgs string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattValueChangedEventArgs;{d21bdb54-06e3-4ed8-a263-acfac8ba7313})"
 
+const ContractNameGattValueChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattValueChangedEventArgs uint32 = 0x00010000
+
+// GattValueChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type GattValueChangedEventArgs struct {
 	ole.IUnknown
 }
 
+func (impl *GattValueChangedEventArgs) Signature() string {
+	return SignatureGattValueChangedEventArgs
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattValueChangedEventArgs class is available in the running version of Windows.
+func (impl *GattValueChangedEventArgs) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattValueChangedEventArgs")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattValueChangedEventArgs, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattValueChangedEventArgs) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetCharacteristicValue", "GetTimestamp":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattValueChangedEventArgs")
+	}
+	return false, fmt.Errorf("unknown method %q of GattValueChangedEventArgs", name)
+}
+
 func (impl *GattValueChangedEventArgs) GetCharacteristicValue() (*streams.IBuffer, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattValueChangedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattValueChangedEventArgs, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattValueChangedEventArgs")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattValueChangedEventArgs)(unsafe.Pointer(itf))
 	return v.GetCharacteristicValue()
 }
 
 func (impl *GattValueChangedEventArgs) GetTimestamp() (foundation.DateTime, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattValueChangedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattValueChangedEventArgs, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattValueChangedEventArgs")
+	if err != nil {
+		return foundation.DateTime{}, err
+	}
 	defer itf.Release()
 	v := (*iGattValueChangedEventArgs)(unsafe.Pointer(itf))
 	return v.GetTimestamp()
@@ -37,10 +70,20 @@ func (impl *GattValueChangedEventArgs) GetTimestamp() (foundation.DateTime, erro
 const GUIDiGattValueChangedEventArgs string = "d21bdb54-06e3-4ed8-a263-acfac8ba7313"
 const SignatureiGattValueChangedEventArgs string = "{d21bdb54-06e3-4ed8-a263-acfac8ba7313}"
 
+var IIDiGattValueChangedEventArgs = ole.GUID{Data1: 0xD21BDB54, Data2: 0x06E3, Data3: 0x4ED8, Data4: [8]byte{0xA2, 0x63, 0xAC, 0xFA, 0xC8, 0xBA, 0x73, 0x13}}
+
+const ContractNameiGattValueChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattValueChangedEventArgs uint32 = 0x00010000
+
+// iGattValueChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iGattValueChangedEventArgs struct {
 	ole.IInspectable
 }
 
+func (v *iGattValueChangedEventArgs) Signature() string {
+	return SignatureiGattValueChangedEventArgs
+}
+
 type iGattValueChangedEventArgsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -54,6 +97,10 @@ func (v *iGattValueChangedEventArgs) VTable() *iGattValueChangedEventArgsVtbl {
 
 func (v *iGattValueChangedEventArgs) GetCharacteristicValue() (*streams.IBuffer, error) {
 	var out *streams.IBuffer
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCharacteristicValue,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -61,7 +108,7 @@ func (v *iGattValueChangedEventArgs) GetCharacteristicValue() (*streams.IBuffer,
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattValueChangedEventArgs", "get_CharacteristicValue")
 	}
 
 	return out, nil
@@ -69,6 +116,10 @@ func (v *iGattValueChangedEventArgs) GetCharacteristicValue() (*streams.IBuffer,
 
 func (v *iGattValueChangedEventArgs) GetTimestamp() (foundation.DateTime, error) {
 	var out foundation.DateTime
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetTimestamp,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -76,7 +127,7 @@ func (v *iGattValueChangedEventArgs) GetTimestamp() (foundation.DateTime, error)
 	)
 
 	if hr != 0 {
-		return foundation.DateTime{}, ole.NewError(hr)
+		return foundation.DateTime{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattValueChangedEventArgs", "get_Timestamp")
 	}
 
 	return out, nil
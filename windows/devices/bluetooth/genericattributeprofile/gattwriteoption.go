@@ -5,11 +5,69 @@
 //nolint:all
 package genericattributeprofile
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameGattWriteOption string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattWriteOption uint32 = 0x00010000
+
+// GattWriteOption was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type GattWriteOption int32
 
 const SignatureGattWriteOption string = "enum(Windows.Devices.Bluetooth.GenericAttributeProfile.GattWriteOption;i4)"
 
+func (v GattWriteOption) Signature() string {
+	return SignatureGattWriteOption
+}
+
 const (
 	GattWriteOptionWriteWithResponse    GattWriteOption = 0
 	GattWriteOptionWriteWithoutResponse GattWriteOption = 1
 )
+
+var namesGattWriteOption = map[GattWriteOption]string{
+	GattWriteOptionWriteWithResponse:    "WriteWithResponse",
+	GattWriteOptionWriteWithoutResponse: "WriteWithoutResponse",
+}
+
+var valuesGattWriteOption = map[string]GattWriteOption{
+	"WriteWithResponse":    GattWriteOptionWriteWithResponse,
+	"WriteWithoutResponse": GattWriteOptionWriteWithoutResponse,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v GattWriteOption) String() string {
+	if name, ok := namesGattWriteOption[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseGattWriteOption parses the name of a value, as returned by String.
+func ParseGattWriteOption(s string) (GattWriteOption, error) {
+	if v, ok := valuesGattWriteOption[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid GattWriteOption %q", s)
+	}
+	return GattWriteOption(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v GattWriteOption) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *GattWriteOption) UnmarshalText(text []byte) error {
+	parsed, err := ParseGattWriteOption(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -6,71 +6,153 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/storage/streams"
 )
 
 const SignatureGattWriteRequest string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattWriteRequest;{aeb6a9ed-de2f-4fc2-a9a8-94ea7844f13d})"
 
+const ContractNameGattWriteRequest string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattWriteRequest uint32 = 0x00040000
+
+// GattWriteRequest was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattWriteRequest struct {
 	ole.IUnknown
 }
 
+func (impl *GattWriteRequest) Signature() string {
+	return SignatureGattWriteRequest
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattWriteRequest class is available in the running version of Windows.
+func (impl *GattWriteRequest) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattWriteRequest")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattWriteRequest, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattWriteRequest) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetValue", "GetOffset", "GetOption", "GetState", "AddStateChanged", "OnStateChanged", "RemoveStateChanged", "Respond", "RespondWithProtocolError":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest")
+	}
+	return false, fmt.Errorf("unknown method %q of GattWriteRequest", name)
+}
+
 func (impl *GattWriteRequest) GetValue() (*streams.IBuffer, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattWriteRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattWriteRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattWriteRequest)(unsafe.Pointer(itf))
 	return v.GetValue()
 }
 
 func (impl *GattWriteRequest) GetOffset() (uint32, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattWriteRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattWriteRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iGattWriteRequest)(unsafe.Pointer(itf))
 	return v.GetOffset()
 }
 
 func (impl *GattWriteRequest) GetOption() (GattWriteOption, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattWriteRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattWriteRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest")
+	if err != nil {
+		return GattWriteOptionWriteWithResponse, err
+	}
 	defer itf.Release()
 	v := (*iGattWriteRequest)(unsafe.Pointer(itf))
 	return v.GetOption()
 }
 
 func (impl *GattWriteRequest) GetState() (GattRequestState, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattWriteRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattWriteRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest")
+	if err != nil {
+		return GattRequestStatePending, err
+	}
 	defer itf.Release()
 	v := (*iGattWriteRequest)(unsafe.Pointer(itf))
 	return v.GetState()
 }
 
-func (impl *GattWriteRequest) AddStateChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattWriteRequest))
+func (impl *GattWriteRequest) AddStateChanged(handler *foundation.TypedEventHandler[*GattWriteRequest, *GattRequestStateChangedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattWriteRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGattWriteRequest)(unsafe.Pointer(itf))
 	return v.AddStateChanged(handler)
 }
 
+// OnStateChanged registers the given function as a handler of the event, using AddStateChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveStateChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GattWriteRequest) OnStateChanged(callback func(sender *GattWriteRequest, args *GattRequestStateChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattWriteRequest, *GattRequestStateChangedEventArgs](func(_ *foundation.TypedEventHandler[*GattWriteRequest, *GattRequestStateChangedEventArgs], sender *GattWriteRequest, args *GattRequestStateChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddStateChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveStateChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GattWriteRequest) RemoveStateChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattWriteRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattWriteRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattWriteRequest)(unsafe.Pointer(itf))
 	return v.RemoveStateChanged(token)
 }
 
 func (impl *GattWriteRequest) Respond() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattWriteRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattWriteRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattWriteRequest)(unsafe.Pointer(itf))
 	return v.Respond()
 }
 
 func (impl *GattWriteRequest) RespondWithProtocolError(protocolError uint8) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattWriteRequest))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattWriteRequest, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGattWriteRequest)(unsafe.Pointer(itf))
 	return v.RespondWithProtocolError(protocolError)
@@ -79,10 +161,20 @@ func (impl *GattWriteRequest) RespondWithProtocolError(protocolError uint8) erro
 const GUIDiGattWriteRequest string = "aeb6a9ed-de2f-4fc2-a9a8-94ea7844f13d"
 const SignatureiGattWriteRequest string = "{aeb6a9ed-de2f-4fc2-a9a8-94ea7844f13d}"
 
+var IIDiGattWriteRequest = ole.GUID{Data1: 0xAEB6A9ED, Data2: 0xDE2F, Data3: 0x4FC2, Data4: [8]byte{0xA9, 0xA8, 0x94, 0xEA, 0x78, 0x44, 0xF1, 0x3D}}
+
+const ContractNameiGattWriteRequest string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattWriteRequest uint32 = 0x00040000
+
+// iGattWriteRequest was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattWriteRequest struct {
 	ole.IInspectable
 }
 
+func (v *iGattWriteRequest) Signature() string {
+	return SignatureiGattWriteRequest
+}
+
 type iGattWriteRequestVtbl struct {
 	ole.IInspectableVtbl
 
@@ -102,6 +194,10 @@ func (v *iGattWriteRequest) VTable() *iGattWriteRequestVtbl {
 
 func (v *iGattWriteRequest) GetValue() (*streams.IBuffer, error) {
 	var out *streams.IBuffer
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetValue,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -109,7 +205,7 @@ func (v *iGattWriteRequest) GetValue() (*streams.IBuffer, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest", "get_Value")
 	}
 
 	return out, nil
@@ -117,6 +213,10 @@ func (v *iGattWriteRequest) GetValue() (*streams.IBuffer, error) {
 
 func (v *iGattWriteRequest) GetOffset() (uint32, error) {
 	var out uint32
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetOffset,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -124,7 +224,7 @@ func (v *iGattWriteRequest) GetOffset() (uint32, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest", "get_Offset")
 	}
 
 	return out, nil
@@ -132,6 +232,10 @@ func (v *iGattWriteRequest) GetOffset() (uint32, error) {
 
 func (v *iGattWriteRequest) GetOption() (GattWriteOption, error) {
 	var out GattWriteOption
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetOption,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -139,7 +243,7 @@ func (v *iGattWriteRequest) GetOption() (GattWriteOption, error) {
 	)
 
 	if hr != 0 {
-		return GattWriteOptionWriteWithResponse, ole.NewError(hr)
+		return GattWriteOptionWriteWithResponse, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest", "get_Option")
 	}
 
 	return out, nil
@@ -147,6 +251,10 @@ func (v *iGattWriteRequest) GetOption() (GattWriteOption, error) {
 
 func (v *iGattWriteRequest) GetState() (GattRequestState, error) {
 	var out GattRequestState
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetState,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -154,56 +262,108 @@ func (v *iGattWriteRequest) GetState() (GattRequestState, error) {
 	)
 
 	if hr != 0 {
-		return GattRequestStatePending, ole.NewError(hr)
+		return GattRequestStatePending, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest", "get_State")
 	}
 
 	return out, nil
 }
 
-func (v *iGattWriteRequest) AddStateChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGattWriteRequest) AddStateChanged(handler *foundation.TypedEventHandler[*GattWriteRequest, *GattRequestStateChangedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddStateChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GattWriteRequest, *GattRequestStateChangedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest", "add_StateChanged")
 	}
 
 	return out, nil
 }
 
+// OnStateChanged registers the given function as a handler of the event, using AddStateChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveStateChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGattWriteRequest) OnStateChanged(callback func(sender *GattWriteRequest, args *GattRequestStateChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GattWriteRequest, *GattRequestStateChangedEventArgs](func(_ *foundation.TypedEventHandler[*GattWriteRequest, *GattRequestStateChangedEventArgs], sender *GattWriteRequest, args *GattRequestStateChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddStateChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveStateChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGattWriteRequest) RemoveStateChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveStateChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveStateChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest", "remove_StateChanged")
 	}
 
 	return nil
 }
 
 func (v *iGattWriteRequest) Respond() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().Respond,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest", "Respond")
 	}
 
 	return nil
 }
 
 func (v *iGattWriteRequest) RespondWithProtocolError(protocolError uint8) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().RespondWithProtocolError,
 		uintptr(unsafe.Pointer(v)), // this
@@ -211,7 +371,7 @@ func (v *iGattWriteRequest) RespondWithProtocolError(protocolError uint8) error
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequest", "RespondWithProtocolError")
 	}
 
 	return nil
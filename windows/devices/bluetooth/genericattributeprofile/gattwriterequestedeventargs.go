@@ -6,35 +6,71 @@
 package genericattributeprofile
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGattWriteRequestedEventArgs string = "rc(Windows.Devices.Bluetooth.GenericAttributeProfile.GattWriteRequestedEventArgs;{2dec8bbe-a73a-471a-94d5-037deadd0806})"
 
+const ContractNameGattWriteRequestedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGattWriteRequestedEventArgs uint32 = 0x00040000
+
+// GattWriteRequestedEventArgs was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type GattWriteRequestedEventArgs struct {
 	ole.IUnknown
 }
 
+func (impl *GattWriteRequestedEventArgs) Signature() string {
+	return SignatureGattWriteRequestedEventArgs
+}
+
+// IsPresent reports whether the Windows.Devices.Bluetooth.GenericAttributeProfile.GattWriteRequestedEventArgs class is available in the running version of Windows.
+func (impl *GattWriteRequestedEventArgs) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.GattWriteRequestedEventArgs")
+}
+
+// IsMethodPresent reports whether the given method or static function of GattWriteRequestedEventArgs, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GattWriteRequestedEventArgs) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetSession", "GetDeferral", "GetRequestAsync":
+		return metadata.ApiInformationIsTypePresent("Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequestedEventArgs")
+	}
+	return false, fmt.Errorf("unknown method %q of GattWriteRequestedEventArgs", name)
+}
+
 func (impl *GattWriteRequestedEventArgs) GetSession() (*GattSession, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattWriteRequestedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattWriteRequestedEventArgs, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequestedEventArgs")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattWriteRequestedEventArgs)(unsafe.Pointer(itf))
 	return v.GetSession()
 }
 
 func (impl *GattWriteRequestedEventArgs) GetDeferral() (*foundation.Deferral, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattWriteRequestedEventArgs))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattWriteRequestedEventArgs, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequestedEventArgs")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattWriteRequestedEventArgs)(unsafe.Pointer(itf))
 	return v.GetDeferral()
 }
 
-func (impl *GattWriteRequestedEventArgs) GetRequestAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGattWriteRequestedEventArgs))
+func (impl *GattWriteRequestedEventArgs) GetRequestAsync() (*IAsyncOperationGattWriteRequest, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGattWriteRequestedEventArgs, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequestedEventArgs")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGattWriteRequestedEventArgs)(unsafe.Pointer(itf))
 	return v.GetRequestAsync()
@@ -43,10 +79,20 @@ func (impl *GattWriteRequestedEventArgs) GetRequestAsync() (*foundation.IAsyncOp
 const GUIDiGattWriteRequestedEventArgs string = "2dec8bbe-a73a-471a-94d5-037deadd0806"
 const SignatureiGattWriteRequestedEventArgs string = "{2dec8bbe-a73a-471a-94d5-037deadd0806}"
 
+var IIDiGattWriteRequestedEventArgs = ole.GUID{Data1: 0x2DEC8BBE, Data2: 0xA73A, Data3: 0x471A, Data4: [8]byte{0x94, 0xD5, 0x03, 0x7D, 0xEA, 0xDD, 0x08, 0x06}}
+
+const ContractNameiGattWriteRequestedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGattWriteRequestedEventArgs uint32 = 0x00040000
+
+// iGattWriteRequestedEventArgs was introduced in Windows.Foundation.UniversalApiContract v4.0.
 type iGattWriteRequestedEventArgs struct {
 	ole.IInspectable
 }
 
+func (v *iGattWriteRequestedEventArgs) Signature() string {
+	return SignatureiGattWriteRequestedEventArgs
+}
+
 type iGattWriteRequestedEventArgsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -61,6 +107,10 @@ func (v *iGattWriteRequestedEventArgs) VTable() *iGattWriteRequestedEventArgsVtb
 
 func (v *iGattWriteRequestedEventArgs) GetSession() (*GattSession, error) {
 	var out *GattSession
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetSession,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -68,7 +118,7 @@ func (v *iGattWriteRequestedEventArgs) GetSession() (*GattSession, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequestedEventArgs", "get_Session")
 	}
 
 	return out, nil
@@ -76,6 +126,10 @@ func (v *iGattWriteRequestedEventArgs) GetSession() (*GattSession, error) {
 
 func (v *iGattWriteRequestedEventArgs) GetDeferral() (*foundation.Deferral, error) {
 	var out *foundation.Deferral
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetDeferral,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -83,22 +137,26 @@ func (v *iGattWriteRequestedEventArgs) GetDeferral() (*foundation.Deferral, erro
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequestedEventArgs", "GetDeferral")
 	}
 
 	return out, nil
 }
 
-func (v *iGattWriteRequestedEventArgs) GetRequestAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGattWriteRequestedEventArgs) GetRequestAsync() (*IAsyncOperationGattWriteRequest, error) {
+	var out *IAsyncOperationGattWriteRequest
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetRequestAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationGattWriteRequest
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Devices.Bluetooth.GenericAttributeProfile.IGattWriteRequestedEventArgs", "GetRequestAsync")
 	}
 
 	return out, nil
@@ -11,14 +11,18 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/internal/delegate"
 	"github.com/saltosystems/winrt-go/internal/kernel32"
 )
 
 const GUIDAsyncOperationCompletedHandler string = "fcdcf02c-e5d8-4478-915a-4d90b74b83a5"
 const SignatureAsyncOperationCompletedHandler string = "delegate({fcdcf02c-e5d8-4478-915a-4d90b74b83a5})"
+const ContractNameAsyncOperationCompletedHandler string = "Windows.Foundation.FoundationContract"
+const ContractVersionAsyncOperationCompletedHandler uint32 = 0x00010000
 
-type AsyncOperationCompletedHandler struct {
+// AsyncOperationCompletedHandler was introduced in Windows.Foundation.FoundationContract v1.0.
+type AsyncOperationCompletedHandler[TResult any] struct {
 	ole.IUnknown
 	sync.Mutex
 	refs uintptr
@@ -30,11 +34,12 @@ type AsyncOperationCompletedHandlerVtbl struct {
 	Invoke uintptr
 }
 
-type AsyncOperationCompletedHandlerCallback func(instance *AsyncOperationCompletedHandler, asyncInfo *IAsyncOperation, asyncStatus AsyncStatus)
+// AsyncOperationCompletedHandlerCallback is called when the delegate is invoked. A non-nil error is reported to the caller as an HRESULT.
+type AsyncOperationCompletedHandlerCallback[TResult any] func(instance *AsyncOperationCompletedHandler[TResult], asyncInfo *IAsyncOperation[TResult], asyncStatus AsyncStatus) error
 
 var callbacksAsyncOperationCompletedHandler = &asyncOperationCompletedHandlerCallbacks{
 	mu:        &sync.Mutex{},
-	callbacks: make(map[unsafe.Pointer]AsyncOperationCompletedHandlerCallback),
+	callbacks: make(map[unsafe.Pointer]interface{}),
 }
 
 var releaseChannelsAsyncOperationCompletedHandler = &asyncOperationCompletedHandlerReleaseChannels{
@@ -42,11 +47,15 @@ var releaseChannelsAsyncOperationCompletedHandler = &asyncOperationCompletedHand
 	chans: make(map[unsafe.Pointer]chan struct{}),
 }
 
-func NewAsyncOperationCompletedHandler(iid *ole.GUID, callback AsyncOperationCompletedHandlerCallback) *AsyncOperationCompletedHandler {
+// NewAsyncOperationCompletedHandler panics if the delegate can not receive its arguments on the platform, see winrt.DelegateArgError.
+func NewAsyncOperationCompletedHandler[TResult any](callback AsyncOperationCompletedHandlerCallback[TResult]) *AsyncOperationCompletedHandler[TResult] {
+	// the IID depends on the type arguments
+	iid := winrt.IIDOf[*AsyncOperationCompletedHandler[TResult]]()
+
 	// create type instance
-	size := unsafe.Sizeof(*(*AsyncOperationCompletedHandler)(nil))
+	size := unsafe.Sizeof(*(*AsyncOperationCompletedHandler[TResult])(nil))
 	instPtr := kernel32.Malloc(size)
-	inst := (*AsyncOperationCompletedHandler)(instPtr)
+	inst := (*AsyncOperationCompletedHandler[TResult])(instPtr)
 
 	// get the callbacks for the VTable
 	callbacks := delegate.RegisterCallbacks(instPtr, inst)
@@ -79,48 +88,55 @@ func NewAsyncOperationCompletedHandler(iid *ole.GUID, callback AsyncOperationCom
 	return inst
 }
 
-func (r *AsyncOperationCompletedHandler) GetIID() *ole.GUID {
+func (r *AsyncOperationCompletedHandler[TResult]) GetIID() *ole.GUID {
 	return &r.IID
 }
 
+func (r *AsyncOperationCompletedHandler[TResult]) Signature() string {
+	return winrt.ParameterizedSignature(GUIDAsyncOperationCompletedHandler, winrt.SignatureOf[TResult]())
+}
+
 // addRef increments the reference counter by one
-func (r *AsyncOperationCompletedHandler) addRef() uintptr {
+func (r *AsyncOperationCompletedHandler[TResult]) addRef() uintptr {
 	r.Lock()
 	defer r.Unlock()
 	r.refs++
+	delegate.SetRefs(unsafe.Pointer(r), r.refs)
 	return r.refs
 }
 
 // removeRef decrements the reference counter by one. If it was already zero, it will just return zero.
-func (r *AsyncOperationCompletedHandler) removeRef() uintptr {
+func (r *AsyncOperationCompletedHandler[TResult]) removeRef() uintptr {
 	r.Lock()
 	defer r.Unlock()
 
 	if r.refs > 0 {
 		r.refs--
 	}
+	delegate.SetRefs(unsafe.Pointer(r), r.refs)
 
 	return r.refs
 }
 
-func (instance *AsyncOperationCompletedHandler) Invoke(instancePtr, rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8 unsafe.Pointer) uintptr {
-	asyncInfoPtr := rawArgs0
-	asyncStatusRaw := (int32)(uintptr(rawArgs1))
-
-	// See the quote above.
-	asyncInfo := (*IAsyncOperation)(asyncInfoPtr)
-	asyncStatus := (AsyncStatus)(asyncStatusRaw)
+func (instance *AsyncOperationCompletedHandler[TResult]) Invoke(instancePtr, rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8 unsafe.Pointer) uintptr {
+	// decode the arguments following the calling convention of the platform
+	abiArgs := winrt.NewDelegateArgs(rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8)
+	asyncInfo := winrt.NextDelegateArg[*IAsyncOperation[TResult]](abiArgs)
+	asyncStatus := winrt.NextDelegateArg[AsyncStatus](abiArgs)
 	if callback, ok := callbacksAsyncOperationCompletedHandler.get(instancePtr); ok {
-		callback(instance, asyncInfo, asyncStatus)
+		callback := callback.(AsyncOperationCompletedHandlerCallback[TResult])
+		if err := callback(instance, asyncInfo, asyncStatus); err != nil {
+			return winrt.HResultFromError(err)
+		}
 	}
 	return ole.S_OK
 }
 
-func (instance *AsyncOperationCompletedHandler) AddRef() uintptr {
+func (instance *AsyncOperationCompletedHandler[TResult]) AddRef() uintptr {
 	return instance.addRef()
 }
 
-func (instance *AsyncOperationCompletedHandler) Release() uintptr {
+func (instance *AsyncOperationCompletedHandler[TResult]) Release() uintptr {
 	rem := instance.removeRef()
 	if rem == 0 {
 		// We're done.
@@ -139,17 +155,17 @@ func (instance *AsyncOperationCompletedHandler) Release() uintptr {
 
 type asyncOperationCompletedHandlerCallbacks struct {
 	mu        *sync.Mutex
-	callbacks map[unsafe.Pointer]AsyncOperationCompletedHandlerCallback
+	callbacks map[unsafe.Pointer]interface{}
 }
 
-func (m *asyncOperationCompletedHandlerCallbacks) add(p unsafe.Pointer, v AsyncOperationCompletedHandlerCallback) {
+func (m *asyncOperationCompletedHandlerCallbacks) add(p unsafe.Pointer, v interface{}) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	m.callbacks[p] = v
 }
 
-func (m *asyncOperationCompletedHandlerCallbacks) get(p unsafe.Pointer) (AsyncOperationCompletedHandlerCallback, bool) {
+func (m *asyncOperationCompletedHandlerCallbacks) get(p unsafe.Pointer) (interface{}, bool) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
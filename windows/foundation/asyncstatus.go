@@ -5,13 +5,75 @@
 //nolint:all
 package foundation
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameAsyncStatus string = "Windows.Foundation.FoundationContract"
+const ContractVersionAsyncStatus uint32 = 0x00010000
+
+// AsyncStatus was introduced in Windows.Foundation.FoundationContract v1.0.
 type AsyncStatus int32
 
 const SignatureAsyncStatus string = "enum(Windows.Foundation.AsyncStatus;i4)"
 
+func (v AsyncStatus) Signature() string {
+	return SignatureAsyncStatus
+}
+
 const (
 	AsyncStatusCanceled  AsyncStatus = 2
 	AsyncStatusCompleted AsyncStatus = 1
 	AsyncStatusError     AsyncStatus = 3
 	AsyncStatusStarted   AsyncStatus = 0
 )
+
+var namesAsyncStatus = map[AsyncStatus]string{
+	AsyncStatusCanceled:  "Canceled",
+	AsyncStatusCompleted: "Completed",
+	AsyncStatusError:     "Error",
+	AsyncStatusStarted:   "Started",
+}
+
+var valuesAsyncStatus = map[string]AsyncStatus{
+	"Canceled":  AsyncStatusCanceled,
+	"Completed": AsyncStatusCompleted,
+	"Error":     AsyncStatusError,
+	"Started":   AsyncStatusStarted,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v AsyncStatus) String() string {
+	if name, ok := namesAsyncStatus[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseAsyncStatus parses the name of a value, as returned by String.
+func ParseAsyncStatus(s string) (AsyncStatus, error) {
+	if v, ok := valuesAsyncStatus[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid AsyncStatus %q", s)
+	}
+	return AsyncStatus(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v AsyncStatus) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *AsyncStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseAsyncStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -6,19 +6,30 @@
 package collections
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const GUIDIVector string = "913337e9-11a1-4345-a3a2-4e7f956e222d"
 const SignatureIVector string = "{913337e9-11a1-4345-a3a2-4e7f956e222d}"
+const ContractNameIVector string = "Windows.Foundation.FoundationContract"
+const ContractVersionIVector uint32 = 0x00010000
 
-type IVector struct {
+// IVector was introduced in Windows.Foundation.FoundationContract v1.0.
+type IVector[T any] struct {
 	ole.IInspectable
 }
 
+func (v *IVector[T]) Signature() string {
+	return winrt.ParameterizedSignature(GUIDIVector, winrt.SignatureOf[T]())
+}
+
 type IVectorVtbl struct {
 	ole.IInspectableVtbl
 
@@ -36,28 +47,53 @@ type IVectorVtbl struct {
 	ReplaceAll  uintptr
 }
 
-func (v *IVector) VTable() *IVectorVtbl {
+func (v *IVector[T]) VTable() *IVectorVtbl {
 	return (*IVectorVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func (v *IVector) GetAt(index uint32) (unsafe.Pointer, error) {
-	var out unsafe.Pointer
+// IsPresent reports whether the Windows.Foundation.Collections.IVector`1 interface is available in the running version of Windows.
+func (v *IVector[T]) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Foundation.Collections.IVector`1")
+}
+
+// IsMethodPresent reports whether the given method of IVector, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (v *IVector[T]) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetAt", "GetSize", "GetView", "IndexOf", "SetAt", "InsertAt", "RemoveAt", "Append", "RemoveAtEnd", "Clear", "GetMany", "ReplaceAll":
+		return v.IsPresent()
+	}
+	return false, fmt.Errorf("unknown method %q of IVector", name)
+}
+
+func (v *IVector[T]) GetAt(index uint32) (T, error) {
+	var outABI winrt.OutValue[T]
+	outPtr := outABI.Addr()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetAt,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(index),                // in uint32
-		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(index),             // in uint32
+		uintptr(outPtr),            // out T
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return *new(T), winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "GetAt")
 	}
 
+	out := outABI.Value()
 	return out, nil
 }
 
-func (v *IVector) GetSize() (uint32, error) {
+func (v *IVector[T]) GetSize() (uint32, error) {
 	var out uint32
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetSize,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -65,76 +101,114 @@ func (v *IVector) GetSize() (uint32, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "get_Size")
 	}
 
 	return out, nil
 }
 
-func (v *IVector) GetView() (*IVectorView, error) {
-	var out *IVectorView
+func (v *IVector[T]) GetView() (*IVectorView[T], error) {
+	var out *IVectorView[T]
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetView,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out IVectorView
+		uintptr(unsafe.Pointer(&out)), // out IVectorView[T]
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "GetView")
 	}
 
 	return out, nil
 }
 
-func (v *IVector) IndexOf(value unsafe.Pointer) (uint32, bool, error) {
+func (v *IVector[T]) IndexOf(value T) (uint32, bool, error) {
 	var index uint32
 	var out bool
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().IndexOf,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(value),                  // in unsafe.Pointer
-		uintptr(unsafe.Pointer(&index)), // out uint32
-		uintptr(unsafe.Pointer(&out)),   // out bool
-	)
+	valueABI, err := winrt.NewInValue(value)
+	if err != nil {
+		return 0, false, err
+	}
+	defer valueABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	valueSlots := valueABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 3+len(valueSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v)))      // this
+	callArgs = append(callArgs, valueSlots...)                   // in T
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(&index))) // out uint32
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(&out)))   // out bool
+	hr, _, _ := syscall.SyscallN(v.VTable().IndexOf, callArgs...)
 
 	if hr != 0 {
-		return 0, false, ole.NewError(hr)
+		return 0, false, winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "IndexOf")
 	}
 
 	return index, out, nil
 }
 
-func (v *IVector) SetAt(index uint32, value unsafe.Pointer) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().SetAt,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(index),             // in uint32
-		uintptr(value),             // in unsafe.Pointer
-	)
+func (v *IVector[T]) SetAt(index uint32, value T) error {
+	valueABI, err := winrt.NewInValue(value)
+	if err != nil {
+		return err
+	}
+	defer valueABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	valueSlots := valueABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 2+len(valueSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, uintptr(index))             // in uint32
+	callArgs = append(callArgs, valueSlots...)              // in T
+	hr, _, _ := syscall.SyscallN(v.VTable().SetAt, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "SetAt")
 	}
 
 	return nil
 }
 
-func (v *IVector) InsertAt(index uint32, value unsafe.Pointer) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().InsertAt,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(index),             // in uint32
-		uintptr(value),             // in unsafe.Pointer
-	)
+func (v *IVector[T]) InsertAt(index uint32, value T) error {
+	valueABI, err := winrt.NewInValue(value)
+	if err != nil {
+		return err
+	}
+	defer valueABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	valueSlots := valueABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 2+len(valueSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, uintptr(index))             // in uint32
+	callArgs = append(callArgs, valueSlots...)              // in T
+	hr, _, _ := syscall.SyscallN(v.VTable().InsertAt, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "InsertAt")
 	}
 
 	return nil
 }
 
-func (v *IVector) RemoveAt(index uint32) error {
+func (v *IVector[T]) RemoveAt(index uint32) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().RemoveAt,
 		uintptr(unsafe.Pointer(v)), // this
@@ -142,81 +216,115 @@ func (v *IVector) RemoveAt(index uint32) error {
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "RemoveAt")
 	}
 
 	return nil
 }
 
-func (v *IVector) Append(value unsafe.Pointer) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().Append,
-		uintptr(unsafe.Pointer(v)), // this
-		uintptr(value),             // in unsafe.Pointer
-	)
+func (v *IVector[T]) Append(value T) error {
+	valueABI, err := winrt.NewInValue(value)
+	if err != nil {
+		return err
+	}
+	defer valueABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	valueSlots := valueABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(valueSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, valueSlots...)              // in T
+	hr, _, _ := syscall.SyscallN(v.VTable().Append, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "Append")
 	}
 
 	return nil
 }
 
-func (v *IVector) RemoveAtEnd() error {
+func (v *IVector[T]) RemoveAtEnd() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().RemoveAtEnd,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "RemoveAtEnd")
 	}
 
 	return nil
 }
 
-func (v *IVector) Clear() error {
+func (v *IVector[T]) Clear() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().Clear,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "Clear")
 	}
 
 	return nil
 }
 
-func (v *IVector) GetMany(startIndex uint32, itemsSize uint32) ([]unsafe.Pointer, uint32, error) {
-	var items []unsafe.Pointer = make([]unsafe.Pointer, itemsSize)
+func (v *IVector[T]) GetMany(startIndex uint32, itemsSize uint32) ([]T, uint32, error) {
+	itemsABI := winrt.NewOutArray[T](itemsSize)
 	var out uint32
+	itemsPtr := itemsABI.Pointer()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetMany,
-		uintptr(unsafe.Pointer(v)),         // this
-		uintptr(startIndex),                // in uint32
-		uintptr(itemsSize),                 // in uint32
-		uintptr(unsafe.Pointer(&items[0])), // out unsafe.Pointer
-		uintptr(unsafe.Pointer(&out)),      // out uint32
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(startIndex),           // in uint32
+		uintptr(itemsSize),            // in uint32
+		uintptr(itemsPtr),             // out T
+		uintptr(unsafe.Pointer(&out)), // out uint32
 	)
 
 	if hr != 0 {
-		return nil, 0, ole.NewError(hr)
+		return nil, 0, winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "GetMany")
 	}
 
+	items := itemsABI.Values()
 	return items, out, nil
 }
 
-func (v *IVector) ReplaceAll(itemsSize uint32, items []unsafe.Pointer) error {
+func (v *IVector[T]) ReplaceAll(itemsSize uint32, items []T) error {
+	itemsABI, err := winrt.NewInArray(items)
+	if err != nil {
+		return err
+	}
+	defer itemsABI.Release()
+	itemsPtr := itemsABI.Pointer()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().ReplaceAll,
-		uintptr(unsafe.Pointer(v)),         // this
-		uintptr(itemsSize),                 // in uint32
-		uintptr(unsafe.Pointer(&items[0])), // in unsafe.Pointer
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(itemsSize),         // in uint32
+		uintptr(itemsPtr),          // in T
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.Collections.IVector`1", "ReplaceAll")
 	}
 
 	return nil
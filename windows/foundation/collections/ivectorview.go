@@ -6,19 +6,30 @@
 package collections
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const GUIDIVectorView string = "bbe1fa4c-b0e3-4583-baef-1f1b2e483e56"
 const SignatureIVectorView string = "{bbe1fa4c-b0e3-4583-baef-1f1b2e483e56}"
+const ContractNameIVectorView string = "Windows.Foundation.FoundationContract"
+const ContractVersionIVectorView uint32 = 0x00010000
 
-type IVectorView struct {
+// IVectorView was introduced in Windows.Foundation.FoundationContract v1.0.
+type IVectorView[T any] struct {
 	ole.IInspectable
 }
 
+func (v *IVectorView[T]) Signature() string {
+	return winrt.ParameterizedSignature(GUIDIVectorView, winrt.SignatureOf[T]())
+}
+
 type IVectorViewVtbl struct {
 	ole.IInspectableVtbl
 
@@ -28,28 +39,53 @@ type IVectorViewVtbl struct {
 	GetMany uintptr
 }
 
-func (v *IVectorView) VTable() *IVectorViewVtbl {
+func (v *IVectorView[T]) VTable() *IVectorViewVtbl {
 	return (*IVectorViewVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func (v *IVectorView) GetAt(index uint32) (unsafe.Pointer, error) {
-	var out unsafe.Pointer
+// IsPresent reports whether the Windows.Foundation.Collections.IVectorView`1 interface is available in the running version of Windows.
+func (v *IVectorView[T]) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Foundation.Collections.IVectorView`1")
+}
+
+// IsMethodPresent reports whether the given method of IVectorView, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (v *IVectorView[T]) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetAt", "GetSize", "IndexOf", "GetMany":
+		return v.IsPresent()
+	}
+	return false, fmt.Errorf("unknown method %q of IVectorView", name)
+}
+
+func (v *IVectorView[T]) GetAt(index uint32) (T, error) {
+	var outABI winrt.OutValue[T]
+	outPtr := outABI.Addr()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetAt,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(index),                // in uint32
-		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(index),             // in uint32
+		uintptr(outPtr),            // out T
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return *new(T), winrt.NewError(hr, "Windows.Foundation.Collections.IVectorView`1", "GetAt")
 	}
 
+	out := outABI.Value()
 	return out, nil
 }
 
-func (v *IVectorView) GetSize() (uint32, error) {
+func (v *IVectorView[T]) GetSize() (uint32, error) {
 	var out uint32
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetSize,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -57,45 +93,61 @@ func (v *IVectorView) GetSize() (uint32, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Foundation.Collections.IVectorView`1", "get_Size")
 	}
 
 	return out, nil
 }
 
-func (v *IVectorView) IndexOf(value unsafe.Pointer) (uint32, bool, error) {
+func (v *IVectorView[T]) IndexOf(value T) (uint32, bool, error) {
 	var index uint32
 	var out bool
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().IndexOf,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(value),                  // in unsafe.Pointer
-		uintptr(unsafe.Pointer(&index)), // out uint32
-		uintptr(unsafe.Pointer(&out)),   // out bool
-	)
+	valueABI, err := winrt.NewInValue(value)
+	if err != nil {
+		return 0, false, err
+	}
+	defer valueABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	valueSlots := valueABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 3+len(valueSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v)))      // this
+	callArgs = append(callArgs, valueSlots...)                   // in T
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(&index))) // out uint32
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(&out)))   // out bool
+	hr, _, _ := syscall.SyscallN(v.VTable().IndexOf, callArgs...)
 
 	if hr != 0 {
-		return 0, false, ole.NewError(hr)
+		return 0, false, winrt.NewError(hr, "Windows.Foundation.Collections.IVectorView`1", "IndexOf")
 	}
 
 	return index, out, nil
 }
 
-func (v *IVectorView) GetMany(startIndex uint32, itemsSize uint32) ([]unsafe.Pointer, uint32, error) {
-	var items []unsafe.Pointer = make([]unsafe.Pointer, itemsSize)
+func (v *IVectorView[T]) GetMany(startIndex uint32, itemsSize uint32) ([]T, uint32, error) {
+	itemsABI := winrt.NewOutArray[T](itemsSize)
 	var out uint32
+	itemsPtr := itemsABI.Pointer()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetMany,
-		uintptr(unsafe.Pointer(v)),         // this
-		uintptr(startIndex),                // in uint32
-		uintptr(itemsSize),                 // in uint32
-		uintptr(unsafe.Pointer(&items[0])), // out unsafe.Pointer
-		uintptr(unsafe.Pointer(&out)),      // out uint32
+		uintptr(unsafe.Pointer(v)),    // this
+		uintptr(startIndex),           // in uint32
+		uintptr(itemsSize),            // in uint32
+		uintptr(itemsPtr),             // out T
+		uintptr(unsafe.Pointer(&out)), // out uint32
 	)
 
 	if hr != 0 {
-		return nil, 0, ole.NewError(hr)
+		return nil, 0, winrt.NewError(hr, "Windows.Foundation.Collections.IVectorView`1", "GetMany")
 	}
 
+	items := itemsABI.Values()
 	return items, out, nil
 }
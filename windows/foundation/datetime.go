@@ -7,6 +7,14 @@ package foundation
 
 const SignatureDateTime string = "struct(Windows.Foundation.DateTime;i8)"
 
+const ContractNameDateTime string = "Windows.Foundation.FoundationContract"
+const ContractVersionDateTime uint32 = 0x00010000
+
+// DateTime was introduced in Windows.Foundation.FoundationContract v1.0.
 type DateTime struct {
 	UniversalTime int64
 }
+
+func (v DateTime) Signature() string {
+	return SignatureDateTime
+}
@@ -6,27 +6,64 @@
 package foundation
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureDeferral string = "rc(Windows.Foundation.Deferral;{d6269732-3b7f-46a7-b40b-4fdca2a2c693})"
 
+const ContractNameDeferral string = "Windows.Foundation.FoundationContract"
+const ContractVersionDeferral uint32 = 0x00010000
+
+// Deferral was introduced in Windows.Foundation.FoundationContract v1.0.
 type Deferral struct {
 	ole.IUnknown
 }
 
+func (impl *Deferral) Signature() string {
+	return SignatureDeferral
+}
+
+// IsPresent reports whether the Windows.Foundation.Deferral class is available in the running version of Windows.
+func (impl *Deferral) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Foundation.Deferral")
+}
+
+// IsMethodPresent reports whether the given method or static function of Deferral, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *Deferral) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "Complete":
+		return metadata.ApiInformationIsTypePresent("Windows.Foundation.IDeferral")
+	case "Close":
+		return metadata.ApiInformationIsTypePresent("Windows.Foundation.IClosable")
+	case "DeferralCreate":
+		return metadata.ApiInformationIsTypePresent("Windows.Foundation.IDeferralFactory")
+	}
+	return false, fmt.Errorf("unknown method %q of Deferral", name)
+}
+
 func (impl *Deferral) Complete() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiDeferral))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiDeferral, "Windows.Foundation.IDeferral")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iDeferral)(unsafe.Pointer(itf))
 	return v.Complete()
 }
 
 func (impl *Deferral) Close() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDIClosable))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDIClosable, "Windows.Foundation.IClosable")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*IClosable)(unsafe.Pointer(itf))
 	return v.Close()
@@ -35,10 +72,20 @@ func (impl *Deferral) Close() error {
 const GUIDiDeferral string = "d6269732-3b7f-46a7-b40b-4fdca2a2c693"
 const SignatureiDeferral string = "{d6269732-3b7f-46a7-b40b-4fdca2a2c693}"
 
+var IIDiDeferral = ole.GUID{Data1: 0xD6269732, Data2: 0x3B7F, Data3: 0x46A7, Data4: [8]byte{0xB4, 0x0B, 0x4F, 0xDC, 0xA2, 0xA2, 0xC6, 0x93}}
+
+const ContractNameiDeferral string = "Windows.Foundation.FoundationContract"
+const ContractVersioniDeferral uint32 = 0x00010000
+
+// iDeferral was introduced in Windows.Foundation.FoundationContract v1.0.
 type iDeferral struct {
 	ole.IInspectable
 }
 
+func (v *iDeferral) Signature() string {
+	return SignatureiDeferral
+}
+
 type iDeferralVtbl struct {
 	ole.IInspectableVtbl
 
@@ -50,13 +97,17 @@ func (v *iDeferral) VTable() *iDeferralVtbl {
 }
 
 func (v *iDeferral) Complete() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().Complete,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.IDeferral", "Complete")
 	}
 
 	return nil
@@ -65,10 +116,20 @@ func (v *iDeferral) Complete() error {
 const GUIDiDeferralFactory string = "65a1ecc5-3fb5-4832-8ca9-f061b281d13a"
 const SignatureiDeferralFactory string = "{65a1ecc5-3fb5-4832-8ca9-f061b281d13a}"
 
+var IIDiDeferralFactory = ole.GUID{Data1: 0x65A1ECC5, Data2: 0x3FB5, Data3: 0x4832, Data4: [8]byte{0x8C, 0xA9, 0xF0, 0x61, 0xB2, 0x81, 0xD1, 0x3A}}
+
+const ContractNameiDeferralFactory string = "Windows.Foundation.FoundationContract"
+const ContractVersioniDeferralFactory uint32 = 0x00010000
+
+// iDeferralFactory was introduced in Windows.Foundation.FoundationContract v1.0.
 type iDeferralFactory struct {
 	ole.IInspectable
 }
 
+func (v *iDeferralFactory) Signature() string {
+	return SignatureiDeferralFactory
+}
+
 type iDeferralFactoryVtbl struct {
 	ole.IInspectableVtbl
 
@@ -80,13 +141,18 @@ func (v *iDeferralFactory) VTable() *iDeferralFactoryVtbl {
 }
 
 func DeferralCreate(handler *DeferralCompletedHandler) (*Deferral, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Foundation.Deferral", ole.NewGUID(GUIDiDeferralFactory))
+	inspectable, err := winrt.GetActivationFactory("Windows.Foundation.Deferral", &IIDiDeferralFactory)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iDeferralFactory)(unsafe.Pointer(inspectable))
 
 	var out *Deferral
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().DeferralCreate,
 		uintptr(unsafe.Pointer(v)),       // this
@@ -95,7 +161,7 @@ func DeferralCreate(handler *DeferralCompletedHandler) (*Deferral, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Foundation.IDeferralFactory", "Create")
 	}
 
 	return out, nil
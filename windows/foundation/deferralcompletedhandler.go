@@ -11,6 +11,7 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/internal/delegate"
 	"github.com/saltosystems/winrt-go/internal/kernel32"
 )
@@ -18,6 +19,12 @@ import (
 const GUIDDeferralCompletedHandler string = "ed32a372-f3c8-4faa-9cfb-470148da3888"
 const SignatureDeferralCompletedHandler string = "delegate({ed32a372-f3c8-4faa-9cfb-470148da3888})"
 
+var IIDDeferralCompletedHandler = ole.GUID{Data1: 0xED32A372, Data2: 0xF3C8, Data3: 0x4FAA, Data4: [8]byte{0x9C, 0xFB, 0x47, 0x01, 0x48, 0xDA, 0x38, 0x88}}
+
+const ContractNameDeferralCompletedHandler string = "Windows.Foundation.FoundationContract"
+const ContractVersionDeferralCompletedHandler uint32 = 0x00010000
+
+// DeferralCompletedHandler was introduced in Windows.Foundation.FoundationContract v1.0.
 type DeferralCompletedHandler struct {
 	ole.IUnknown
 	sync.Mutex
@@ -30,7 +37,8 @@ type DeferralCompletedHandlerVtbl struct {
 	Invoke uintptr
 }
 
-type DeferralCompletedHandlerCallback func(instance *DeferralCompletedHandler)
+// DeferralCompletedHandlerCallback is called when the delegate is invoked. A non-nil error is reported to the caller as an HRESULT.
+type DeferralCompletedHandlerCallback func(instance *DeferralCompletedHandler) error
 
 var callbacksDeferralCompletedHandler = &deferralCompletedHandlerCallbacks{
 	mu:        &sync.Mutex{},
@@ -83,11 +91,16 @@ func (r *DeferralCompletedHandler) GetIID() *ole.GUID {
 	return &r.IID
 }
 
+func (r *DeferralCompletedHandler) Signature() string {
+	return SignatureDeferralCompletedHandler
+}
+
 // addRef increments the reference counter by one
 func (r *DeferralCompletedHandler) addRef() uintptr {
 	r.Lock()
 	defer r.Unlock()
 	r.refs++
+	delegate.SetRefs(unsafe.Pointer(r), r.refs)
 	return r.refs
 }
 
@@ -99,15 +112,16 @@ func (r *DeferralCompletedHandler) removeRef() uintptr {
 	if r.refs > 0 {
 		r.refs--
 	}
+	delegate.SetRefs(unsafe.Pointer(r), r.refs)
 
 	return r.refs
 }
 
 func (instance *DeferralCompletedHandler) Invoke(instancePtr, rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8 unsafe.Pointer) uintptr {
-
-	// See the quote above.
 	if callback, ok := callbacksDeferralCompletedHandler.get(instancePtr); ok {
-		callback(instance)
+		if err := callback(instance); err != nil {
+			return winrt.HResultFromError(err)
+		}
 	}
 	return ole.S_OK
 }
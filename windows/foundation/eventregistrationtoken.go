@@ -7,6 +7,14 @@ package foundation
 
 const SignatureEventRegistrationToken string = "struct(Windows.Foundation.EventRegistrationToken;i8)"
 
+const ContractNameEventRegistrationToken string = "Windows.Foundation.FoundationContract"
+const ContractVersionEventRegistrationToken uint32 = 0x00010000
+
+// EventRegistrationToken was introduced in Windows.Foundation.FoundationContract v1.0.
 type EventRegistrationToken struct {
 	Value int64
 }
+
+func (v EventRegistrationToken) Signature() string {
+	return SignatureEventRegistrationToken
+}
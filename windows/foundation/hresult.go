@@ -7,6 +7,14 @@ package foundation
 
 const SignatureHResult string = "struct(Windows.Foundation.HResult;i4)"
 
+const ContractNameHResult string = "Windows.Foundation.FoundationContract"
+const ContractVersionHResult uint32 = 0x00010000
+
+// HResult was introduced in Windows.Foundation.FoundationContract v1.0.
 type HResult struct {
 	Value int32
 }
+
+func (v HResult) Signature() string {
+	return SignatureHResult
+}
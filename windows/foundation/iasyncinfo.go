@@ -6,19 +6,33 @@
 package foundation
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const GUIDIAsyncInfo string = "00000036-0000-0000-c000-000000000046"
 const SignatureIAsyncInfo string = "{00000036-0000-0000-c000-000000000046}"
 
+var IIDIAsyncInfo = ole.GUID{Data1: 0x00000036, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+
+const ContractNameIAsyncInfo string = "Windows.Foundation.FoundationContract"
+const ContractVersionIAsyncInfo uint32 = 0x00010000
+
+// IAsyncInfo was introduced in Windows.Foundation.FoundationContract v1.0.
 type IAsyncInfo struct {
 	ole.IInspectable
 }
 
+func (v *IAsyncInfo) Signature() string {
+	return SignatureIAsyncInfo
+}
+
 type IAsyncInfoVtbl struct {
 	ole.IInspectableVtbl
 
@@ -33,8 +47,27 @@ func (v *IAsyncInfo) VTable() *IAsyncInfoVtbl {
 	return (*IAsyncInfoVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsPresent reports whether the Windows.Foundation.IAsyncInfo interface is available in the running version of Windows.
+func (v *IAsyncInfo) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Foundation.IAsyncInfo")
+}
+
+// IsMethodPresent reports whether the given method of IAsyncInfo, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (v *IAsyncInfo) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetId", "GetStatus", "GetErrorCode", "Cancel", "Close":
+		return v.IsPresent()
+	}
+	return false, fmt.Errorf("unknown method %q of IAsyncInfo", name)
+}
+
 func (v *IAsyncInfo) GetId() (uint32, error) {
 	var out uint32
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetId,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -42,7 +75,7 @@ func (v *IAsyncInfo) GetId() (uint32, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Foundation.IAsyncInfo", "get_Id")
 	}
 
 	return out, nil
@@ -50,6 +83,10 @@ func (v *IAsyncInfo) GetId() (uint32, error) {
 
 func (v *IAsyncInfo) GetStatus() (AsyncStatus, error) {
 	var out AsyncStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -57,7 +94,7 @@ func (v *IAsyncInfo) GetStatus() (AsyncStatus, error) {
 	)
 
 	if hr != 0 {
-		return AsyncStatusCanceled, ole.NewError(hr)
+		return AsyncStatusCanceled, winrt.NewError(hr, "Windows.Foundation.IAsyncInfo", "get_Status")
 	}
 
 	return out, nil
@@ -65,6 +102,10 @@ func (v *IAsyncInfo) GetStatus() (AsyncStatus, error) {
 
 func (v *IAsyncInfo) GetErrorCode() (HResult, error) {
 	var out HResult
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetErrorCode,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -72,33 +113,41 @@ func (v *IAsyncInfo) GetErrorCode() (HResult, error) {
 	)
 
 	if hr != 0 {
-		return HResult{}, ole.NewError(hr)
+		return HResult{}, winrt.NewError(hr, "Windows.Foundation.IAsyncInfo", "get_ErrorCode")
 	}
 
 	return out, nil
 }
 
 func (v *IAsyncInfo) Cancel() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().Cancel,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.IAsyncInfo", "Cancel")
 	}
 
 	return nil
 }
 
 func (v *IAsyncInfo) Close() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().Close,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.IAsyncInfo", "Close")
 	}
 
 	return nil
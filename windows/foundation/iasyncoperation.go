@@ -6,19 +6,30 @@
 package foundation
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const GUIDIAsyncOperation string = "9fc2b0bb-e446-44e2-aa61-9cab8f636af2"
 const SignatureIAsyncOperation string = "{9fc2b0bb-e446-44e2-aa61-9cab8f636af2}"
+const ContractNameIAsyncOperation string = "Windows.Foundation.FoundationContract"
+const ContractVersionIAsyncOperation uint32 = 0x00010000
 
-type IAsyncOperation struct {
+// IAsyncOperation was introduced in Windows.Foundation.FoundationContract v1.0.
+type IAsyncOperation[TResult any] struct {
 	ole.IInspectable
 }
 
+func (v *IAsyncOperation[TResult]) Signature() string {
+	return winrt.ParameterizedSignature(GUIDIAsyncOperation, winrt.SignatureOf[TResult]())
+}
+
 type IAsyncOperationVtbl struct {
 	ole.IInspectableVtbl
 
@@ -27,50 +38,79 @@ type IAsyncOperationVtbl struct {
 	GetResults   uintptr
 }
 
-func (v *IAsyncOperation) VTable() *IAsyncOperationVtbl {
+func (v *IAsyncOperation[TResult]) VTable() *IAsyncOperationVtbl {
 	return (*IAsyncOperationVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func (v *IAsyncOperation) SetCompleted(handler *AsyncOperationCompletedHandler) error {
+// IsPresent reports whether the Windows.Foundation.IAsyncOperation`1 interface is available in the running version of Windows.
+func (v *IAsyncOperation[TResult]) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Foundation.IAsyncOperation`1")
+}
+
+// IsMethodPresent reports whether the given method of IAsyncOperation, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (v *IAsyncOperation[TResult]) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "SetCompleted", "GetCompleted", "GetResults":
+		return v.IsPresent()
+	}
+	return false, fmt.Errorf("unknown method %q of IAsyncOperation", name)
+}
+
+func (v *IAsyncOperation[TResult]) SetCompleted(handler *AsyncOperationCompletedHandler[TResult]) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetCompleted,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in AsyncOperationCompletedHandler
+		uintptr(unsafe.Pointer(handler)), // in AsyncOperationCompletedHandler[TResult]
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.IAsyncOperation`1", "put_Completed")
 	}
 
 	return nil
 }
 
-func (v *IAsyncOperation) GetCompleted() (*AsyncOperationCompletedHandler, error) {
-	var out *AsyncOperationCompletedHandler
+func (v *IAsyncOperation[TResult]) GetCompleted() (*AsyncOperationCompletedHandler[TResult], error) {
+	var out *AsyncOperationCompletedHandler[TResult]
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCompleted,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out AsyncOperationCompletedHandler
+		uintptr(unsafe.Pointer(&out)), // out AsyncOperationCompletedHandler[TResult]
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Foundation.IAsyncOperation`1", "get_Completed")
 	}
 
 	return out, nil
 }
 
-func (v *IAsyncOperation) GetResults() (unsafe.Pointer, error) {
-	var out unsafe.Pointer
+func (v *IAsyncOperation[TResult]) GetResults() (TResult, error) {
+	var outABI winrt.OutValue[TResult]
+	outPtr := outABI.Addr()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetResults,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(outPtr),            // out TResult
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return *new(TResult), winrt.NewError(hr, "Windows.Foundation.IAsyncOperation`1", "GetResults")
 	}
 
+	out := outABI.Value()
 	return out, nil
 }
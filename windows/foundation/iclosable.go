@@ -6,19 +6,33 @@
 package foundation
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const GUIDIClosable string = "30d5a829-7fa4-4026-83bb-d75bae4ea99e"
 const SignatureIClosable string = "{30d5a829-7fa4-4026-83bb-d75bae4ea99e}"
 
+var IIDIClosable = ole.GUID{Data1: 0x30D5A829, Data2: 0x7FA4, Data3: 0x4026, Data4: [8]byte{0x83, 0xBB, 0xD7, 0x5B, 0xAE, 0x4E, 0xA9, 0x9E}}
+
+const ContractNameIClosable string = "Windows.Foundation.FoundationContract"
+const ContractVersionIClosable uint32 = 0x00010000
+
+// IClosable was introduced in Windows.Foundation.FoundationContract v1.0.
 type IClosable struct {
 	ole.IInspectable
 }
 
+func (v *IClosable) Signature() string {
+	return SignatureIClosable
+}
+
 type IClosableVtbl struct {
 	ole.IInspectableVtbl
 
@@ -29,14 +43,33 @@ func (v *IClosable) VTable() *IClosableVtbl {
 	return (*IClosableVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsPresent reports whether the Windows.Foundation.IClosable interface is available in the running version of Windows.
+func (v *IClosable) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Foundation.IClosable")
+}
+
+// IsMethodPresent reports whether the given method of IClosable, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (v *IClosable) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "Close":
+		return v.IsPresent()
+	}
+	return false, fmt.Errorf("unknown method %q of IClosable", name)
+}
+
 func (v *IClosable) Close() error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().Close,
 		uintptr(unsafe.Pointer(v)), // this
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Foundation.IClosable", "Close")
 	}
 
 	return nil
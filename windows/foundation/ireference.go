@@ -6,40 +6,72 @@
 package foundation
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const GUIDIReference string = "61c17706-2d65-11e0-9ae8-d48564015472"
 const SignatureIReference string = "{61c17706-2d65-11e0-9ae8-d48564015472}"
+const ContractNameIReference string = "Windows.Foundation.FoundationContract"
+const ContractVersionIReference uint32 = 0x00010000
 
-type IReference struct {
+// IReference was introduced in Windows.Foundation.FoundationContract v1.0.
+type IReference[T any] struct {
 	ole.IInspectable
 }
 
+func (v *IReference[T]) Signature() string {
+	return winrt.ParameterizedSignature(GUIDIReference, winrt.SignatureOf[T]())
+}
+
 type IReferenceVtbl struct {
 	ole.IInspectableVtbl
 
 	GetValue uintptr
 }
 
-func (v *IReference) VTable() *IReferenceVtbl {
+func (v *IReference[T]) VTable() *IReferenceVtbl {
 	return (*IReferenceVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func (v *IReference) GetValue() (unsafe.Pointer, error) {
-	var out unsafe.Pointer
+// IsPresent reports whether the Windows.Foundation.IReference`1 interface is available in the running version of Windows.
+func (v *IReference[T]) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Foundation.IReference`1")
+}
+
+// IsMethodPresent reports whether the given method of IReference, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (v *IReference[T]) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetValue":
+		return v.IsPresent()
+	}
+	return false, fmt.Errorf("unknown method %q of IReference", name)
+}
+
+func (v *IReference[T]) GetValue() (T, error) {
+	var outABI winrt.OutValue[T]
+	outPtr := outABI.Addr()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetValue,
-		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out unsafe.Pointer
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(outPtr),            // out T
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return *new(T), winrt.NewError(hr, "Windows.Foundation.IReference`1", "get_Value")
 	}
 
+	out := outABI.Value()
 	return out, nil
 }
@@ -7,6 +7,14 @@ package foundation
 
 const SignatureTimeSpan string = "struct(Windows.Foundation.TimeSpan;i8)"
 
+const ContractNameTimeSpan string = "Windows.Foundation.FoundationContract"
+const ContractVersionTimeSpan uint32 = 0x00010000
+
+// TimeSpan was introduced in Windows.Foundation.FoundationContract v1.0.
 type TimeSpan struct {
 	Duration int64
 }
+
+func (v TimeSpan) Signature() string {
+	return SignatureTimeSpan
+}
@@ -11,14 +11,18 @@ import (
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/internal/delegate"
 	"github.com/saltosystems/winrt-go/internal/kernel32"
 )
 
 const GUIDTypedEventHandler string = "9de1c534-6ae1-11e0-84e1-18a905bcc53f"
 const SignatureTypedEventHandler string = "delegate({9de1c534-6ae1-11e0-84e1-18a905bcc53f})"
+const ContractNameTypedEventHandler string = "Windows.Foundation.FoundationContract"
+const ContractVersionTypedEventHandler uint32 = 0x00010000
 
-type TypedEventHandler struct {
+// TypedEventHandler was introduced in Windows.Foundation.FoundationContract v1.0.
+type TypedEventHandler[TSender, TResult any] struct {
 	ole.IUnknown
 	sync.Mutex
 	refs uintptr
@@ -30,11 +34,12 @@ type TypedEventHandlerVtbl struct {
 	Invoke uintptr
 }
 
-type TypedEventHandlerCallback func(instance *TypedEventHandler, sender unsafe.Pointer, args unsafe.Pointer)
+// TypedEventHandlerCallback is called when the delegate is invoked. A non-nil error is reported to the caller as an HRESULT.
+type TypedEventHandlerCallback[TSender, TResult any] func(instance *TypedEventHandler[TSender, TResult], sender TSender, args TResult) error
 
 var callbacksTypedEventHandler = &typedEventHandlerCallbacks{
 	mu:        &sync.Mutex{},
-	callbacks: make(map[unsafe.Pointer]TypedEventHandlerCallback),
+	callbacks: make(map[unsafe.Pointer]interface{}),
 }
 
 var releaseChannelsTypedEventHandler = &typedEventHandlerReleaseChannels{
@@ -42,11 +47,21 @@ var releaseChannelsTypedEventHandler = &typedEventHandlerReleaseChannels{
 	chans: make(map[unsafe.Pointer]chan struct{}),
 }
 
-func NewTypedEventHandler(iid *ole.GUID, callback TypedEventHandlerCallback) *TypedEventHandler {
+// NewTypedEventHandler panics if the delegate can not receive its arguments on the platform, see winrt.DelegateArgError.
+func NewTypedEventHandler[TSender, TResult any](callback TypedEventHandlerCallback[TSender, TResult]) *TypedEventHandler[TSender, TResult] {
+	// the IID depends on the type arguments
+	iid := winrt.IIDOf[*TypedEventHandler[TSender, TResult]]()
+	if err := winrt.DelegateArgError[TSender](0); err != nil {
+		panic(err)
+	}
+	if err := winrt.DelegateArgError[TResult](1); err != nil {
+		panic(err)
+	}
+
 	// create type instance
-	size := unsafe.Sizeof(*(*TypedEventHandler)(nil))
+	size := unsafe.Sizeof(*(*TypedEventHandler[TSender, TResult])(nil))
 	instPtr := kernel32.Malloc(size)
-	inst := (*TypedEventHandler)(instPtr)
+	inst := (*TypedEventHandler[TSender, TResult])(instPtr)
 
 	// get the callbacks for the VTable
 	callbacks := delegate.RegisterCallbacks(instPtr, inst)
@@ -79,48 +94,55 @@ func NewTypedEventHandler(iid *ole.GUID, callback TypedEventHandlerCallback) *Ty
 	return inst
 }
 
-func (r *TypedEventHandler) GetIID() *ole.GUID {
+func (r *TypedEventHandler[TSender, TResult]) GetIID() *ole.GUID {
 	return &r.IID
 }
 
+func (r *TypedEventHandler[TSender, TResult]) Signature() string {
+	return winrt.ParameterizedSignature(GUIDTypedEventHandler, winrt.SignatureOf[TSender](), winrt.SignatureOf[TResult]())
+}
+
 // addRef increments the reference counter by one
-func (r *TypedEventHandler) addRef() uintptr {
+func (r *TypedEventHandler[TSender, TResult]) addRef() uintptr {
 	r.Lock()
 	defer r.Unlock()
 	r.refs++
+	delegate.SetRefs(unsafe.Pointer(r), r.refs)
 	return r.refs
 }
 
 // removeRef decrements the reference counter by one. If it was already zero, it will just return zero.
-func (r *TypedEventHandler) removeRef() uintptr {
+func (r *TypedEventHandler[TSender, TResult]) removeRef() uintptr {
 	r.Lock()
 	defer r.Unlock()
 
 	if r.refs > 0 {
 		r.refs--
 	}
+	delegate.SetRefs(unsafe.Pointer(r), r.refs)
 
 	return r.refs
 }
 
-func (instance *TypedEventHandler) Invoke(instancePtr, rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8 unsafe.Pointer) uintptr {
-	senderPtr := rawArgs0
-	argsPtr := rawArgs1
-
-	// See the quote above.
-	sender := (unsafe.Pointer)(senderPtr)
-	args := (unsafe.Pointer)(argsPtr)
+func (instance *TypedEventHandler[TSender, TResult]) Invoke(instancePtr, rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8 unsafe.Pointer) uintptr {
+	// decode the arguments following the calling convention of the platform
+	abiArgs := winrt.NewDelegateArgs(rawArgs0, rawArgs1, rawArgs2, rawArgs3, rawArgs4, rawArgs5, rawArgs6, rawArgs7, rawArgs8)
+	sender := winrt.NextDelegateArg[TSender](abiArgs)
+	args := winrt.NextDelegateArg[TResult](abiArgs)
 	if callback, ok := callbacksTypedEventHandler.get(instancePtr); ok {
-		callback(instance, sender, args)
+		callback := callback.(TypedEventHandlerCallback[TSender, TResult])
+		if err := callback(instance, sender, args); err != nil {
+			return winrt.HResultFromError(err)
+		}
 	}
 	return ole.S_OK
 }
 
-func (instance *TypedEventHandler) AddRef() uintptr {
+func (instance *TypedEventHandler[TSender, TResult]) AddRef() uintptr {
 	return instance.addRef()
 }
 
-func (instance *TypedEventHandler) Release() uintptr {
+func (instance *TypedEventHandler[TSender, TResult]) Release() uintptr {
 	rem := instance.removeRef()
 	if rem == 0 {
 		// We're done.
@@ -139,17 +161,17 @@ func (instance *TypedEventHandler) Release() uintptr {
 
 type typedEventHandlerCallbacks struct {
 	mu        *sync.Mutex
-	callbacks map[unsafe.Pointer]TypedEventHandlerCallback
+	callbacks map[unsafe.Pointer]interface{}
 }
 
-func (m *typedEventHandlerCallbacks) add(p unsafe.Pointer, v TypedEventHandlerCallback) {
+func (m *typedEventHandlerCallbacks) add(p unsafe.Pointer, v interface{}) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	m.callbacks[p] = v
 }
 
-func (m *typedEventHandlerCallbacks) get(p unsafe.Pointer) (TypedEventHandlerCallback, bool) {
+func (m *typedEventHandlerCallbacks) get(p unsafe.Pointer) (interface{}, bool) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -6,24 +6,55 @@
 package control
 
 import (
+	"fmt"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureCurrentSessionChangedEventArgs string = "rc(Windows.Media.Control.CurrentSessionChangedEventArgs;{6969cb39-0bfa-5fe0-8d73-09cc5e5408e1})"
 
+const ContractNameCurrentSessionChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionCurrentSessionChangedEventArgs uint32 = 0x00070000
+
+// CurrentSessionChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type CurrentSessionChangedEventArgs struct {
 	ole.IUnknown
 }
 
+func (impl *CurrentSessionChangedEventArgs) Signature() string {
+	return SignatureCurrentSessionChangedEventArgs
+}
+
+// IsPresent reports whether the Windows.Media.Control.CurrentSessionChangedEventArgs class is available in the running version of Windows.
+func (impl *CurrentSessionChangedEventArgs) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Media.Control.CurrentSessionChangedEventArgs")
+}
+
+// IsMethodPresent reports whether the given method or static function of CurrentSessionChangedEventArgs, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *CurrentSessionChangedEventArgs) IsMethodPresent(name string) (bool, error) {
+	return false, fmt.Errorf("unknown method %q of CurrentSessionChangedEventArgs", name)
+}
+
 const GUIDiCurrentSessionChangedEventArgs string = "6969cb39-0bfa-5fe0-8d73-09cc5e5408e1"
 const SignatureiCurrentSessionChangedEventArgs string = "{6969cb39-0bfa-5fe0-8d73-09cc5e5408e1}"
 
+var IIDiCurrentSessionChangedEventArgs = ole.GUID{Data1: 0x6969CB39, Data2: 0x0BFA, Data3: 0x5FE0, Data4: [8]byte{0x8D, 0x73, 0x09, 0xCC, 0x5E, 0x54, 0x08, 0xE1}}
+
+const ContractNameiCurrentSessionChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniCurrentSessionChangedEventArgs uint32 = 0x00070000
+
+// iCurrentSessionChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iCurrentSessionChangedEventArgs struct {
 	ole.IInspectable
 }
 
+func (v *iCurrentSessionChangedEventArgs) Signature() string {
+	return SignatureiCurrentSessionChangedEventArgs
+}
+
 type iCurrentSessionChangedEventArgsVtbl struct {
 	ole.IInspectableVtbl
 }
@@ -6,190 +6,383 @@
 package control
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/media"
 )
 
 const SignatureGlobalSystemMediaTransportControlsSession string = "rc(Windows.Media.Control.GlobalSystemMediaTransportControlsSession;{7148c835-9b14-5ae2-ab85-dc9b1c14e1a8})"
 
+const ContractNameGlobalSystemMediaTransportControlsSession string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGlobalSystemMediaTransportControlsSession uint32 = 0x00070000
+
+// GlobalSystemMediaTransportControlsSession was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type GlobalSystemMediaTransportControlsSession struct {
 	ole.IUnknown
 }
 
+func (impl *GlobalSystemMediaTransportControlsSession) Signature() string {
+	return SignatureGlobalSystemMediaTransportControlsSession
+}
+
+// IsPresent reports whether the Windows.Media.Control.GlobalSystemMediaTransportControlsSession class is available in the running version of Windows.
+func (impl *GlobalSystemMediaTransportControlsSession) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Media.Control.GlobalSystemMediaTransportControlsSession")
+}
+
+// IsMethodPresent reports whether the given method or static function of GlobalSystemMediaTransportControlsSession, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GlobalSystemMediaTransportControlsSession) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetSourceAppUserModelId", "TryGetMediaPropertiesAsync", "GetTimelineProperties", "GetPlaybackInfo", "TryPlayAsync", "TryPauseAsync", "TryStopAsync", "TryRecordAsync", "TryFastForwardAsync", "TryRewindAsync", "TrySkipNextAsync", "TrySkipPreviousAsync", "TryChangeChannelUpAsync", "TryChangeChannelDownAsync", "TryTogglePlayPauseAsync", "TryChangeAutoRepeatModeAsync", "TryChangePlaybackRateAsync", "TryChangeShuffleActiveAsync", "TryChangePlaybackPositionAsync", "AddTimelinePropertiesChanged", "OnTimelinePropertiesChanged", "RemoveTimelinePropertiesChanged", "AddPlaybackInfoChanged", "OnPlaybackInfoChanged", "RemovePlaybackInfoChanged", "AddMediaPropertiesChanged", "OnMediaPropertiesChanged", "RemoveMediaPropertiesChanged":
+		return metadata.ApiInformationIsTypePresent("Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	}
+	return false, fmt.Errorf("unknown method %q of GlobalSystemMediaTransportControlsSession", name)
+}
+
 func (impl *GlobalSystemMediaTransportControlsSession) GetSourceAppUserModelId() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return "", err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.GetSourceAppUserModelId()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryGetMediaPropertiesAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryGetMediaPropertiesAsync() (*IAsyncOperationGlobalSystemMediaTransportControlsSessionMediaProperties, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryGetMediaPropertiesAsync()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSession) GetTimelineProperties() (*GlobalSystemMediaTransportControlsSessionTimelineProperties, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.GetTimelineProperties()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSession) GetPlaybackInfo() (*GlobalSystemMediaTransportControlsSessionPlaybackInfo, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.GetPlaybackInfo()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryPlayAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryPlayAsync() (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryPlayAsync()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryPauseAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryPauseAsync() (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryPauseAsync()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryStopAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryStopAsync() (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryStopAsync()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryRecordAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryRecordAsync() (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryRecordAsync()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryFastForwardAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryFastForwardAsync() (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryFastForwardAsync()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryRewindAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryRewindAsync() (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryRewindAsync()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TrySkipNextAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TrySkipNextAsync() (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TrySkipNextAsync()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TrySkipPreviousAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TrySkipPreviousAsync() (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TrySkipPreviousAsync()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryChangeChannelUpAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryChangeChannelUpAsync() (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryChangeChannelUpAsync()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryChangeChannelDownAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryChangeChannelDownAsync() (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryChangeChannelDownAsync()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryTogglePlayPauseAsync() (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryTogglePlayPauseAsync() (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryTogglePlayPauseAsync()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryChangeAutoRepeatModeAsync(requestedAutoRepeatMode media.MediaPlaybackAutoRepeatMode) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryChangeAutoRepeatModeAsync(requestedAutoRepeatMode media.MediaPlaybackAutoRepeatMode) (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryChangeAutoRepeatModeAsync(requestedAutoRepeatMode)
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryChangePlaybackRateAsync(requestedPlaybackRate float64) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryChangePlaybackRateAsync(requestedPlaybackRate float64) (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryChangePlaybackRateAsync(requestedPlaybackRate)
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryChangeShuffleActiveAsync(requestedShuffleState bool) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryChangeShuffleActiveAsync(requestedShuffleState bool) (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryChangeShuffleActiveAsync(requestedShuffleState)
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) TryChangePlaybackPositionAsync(requestedPlaybackPosition int64) (*foundation.IAsyncOperation, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) TryChangePlaybackPositionAsync(requestedPlaybackPosition int64) (*IAsyncOperationBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.TryChangePlaybackPositionAsync(requestedPlaybackPosition)
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) AddTimelinePropertiesChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) AddTimelinePropertiesChanged(handler *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *TimelinePropertiesChangedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.AddTimelinePropertiesChanged(handler)
 }
 
+// OnTimelinePropertiesChanged registers the given function as a handler of the event, using AddTimelinePropertiesChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveTimelinePropertiesChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GlobalSystemMediaTransportControlsSession) OnTimelinePropertiesChanged(callback func(sender *GlobalSystemMediaTransportControlsSession, args *TimelinePropertiesChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GlobalSystemMediaTransportControlsSession, *TimelinePropertiesChangedEventArgs](func(_ *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *TimelinePropertiesChangedEventArgs], sender *GlobalSystemMediaTransportControlsSession, args *TimelinePropertiesChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddTimelinePropertiesChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveTimelinePropertiesChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GlobalSystemMediaTransportControlsSession) RemoveTimelinePropertiesChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.RemoveTimelinePropertiesChanged(token)
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) AddPlaybackInfoChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) AddPlaybackInfoChanged(handler *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *PlaybackInfoChangedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.AddPlaybackInfoChanged(handler)
 }
 
+// OnPlaybackInfoChanged registers the given function as a handler of the event, using AddPlaybackInfoChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemovePlaybackInfoChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GlobalSystemMediaTransportControlsSession) OnPlaybackInfoChanged(callback func(sender *GlobalSystemMediaTransportControlsSession, args *PlaybackInfoChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GlobalSystemMediaTransportControlsSession, *PlaybackInfoChangedEventArgs](func(_ *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *PlaybackInfoChangedEventArgs], sender *GlobalSystemMediaTransportControlsSession, args *PlaybackInfoChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddPlaybackInfoChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemovePlaybackInfoChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GlobalSystemMediaTransportControlsSession) RemovePlaybackInfoChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.RemovePlaybackInfoChanged(token)
 }
 
-func (impl *GlobalSystemMediaTransportControlsSession) AddMediaPropertiesChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+func (impl *GlobalSystemMediaTransportControlsSession) AddMediaPropertiesChanged(handler *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *MediaPropertiesChangedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.AddMediaPropertiesChanged(handler)
 }
 
+// OnMediaPropertiesChanged registers the given function as a handler of the event, using AddMediaPropertiesChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveMediaPropertiesChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GlobalSystemMediaTransportControlsSession) OnMediaPropertiesChanged(callback func(sender *GlobalSystemMediaTransportControlsSession, args *MediaPropertiesChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GlobalSystemMediaTransportControlsSession, *MediaPropertiesChangedEventArgs](func(_ *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *MediaPropertiesChangedEventArgs], sender *GlobalSystemMediaTransportControlsSession, args *MediaPropertiesChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddMediaPropertiesChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveMediaPropertiesChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GlobalSystemMediaTransportControlsSession) RemoveMediaPropertiesChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSession))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSession, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSession)(unsafe.Pointer(itf))
 	return v.RemoveMediaPropertiesChanged(token)
@@ -198,10 +391,20 @@ func (impl *GlobalSystemMediaTransportControlsSession) RemoveMediaPropertiesChan
 const GUIDiGlobalSystemMediaTransportControlsSession string = "7148c835-9b14-5ae2-ab85-dc9b1c14e1a8"
 const SignatureiGlobalSystemMediaTransportControlsSession string = "{7148c835-9b14-5ae2-ab85-dc9b1c14e1a8}"
 
+var IIDiGlobalSystemMediaTransportControlsSession = ole.GUID{Data1: 0x7148C835, Data2: 0x9B14, Data3: 0x5AE2, Data4: [8]byte{0xAB, 0x85, 0xDC, 0x9B, 0x1C, 0x14, 0xE1, 0xA8}}
+
+const ContractNameiGlobalSystemMediaTransportControlsSession string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGlobalSystemMediaTransportControlsSession uint32 = 0x00070000
+
+// iGlobalSystemMediaTransportControlsSession was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iGlobalSystemMediaTransportControlsSession struct {
 	ole.IInspectable
 }
 
+func (v *iGlobalSystemMediaTransportControlsSession) Signature() string {
+	return SignatureiGlobalSystemMediaTransportControlsSession
+}
+
 type iGlobalSystemMediaTransportControlsSessionVtbl struct {
 	ole.IInspectableVtbl
 
@@ -238,6 +441,10 @@ func (v *iGlobalSystemMediaTransportControlsSession) VTable() *iGlobalSystemMedi
 
 func (v *iGlobalSystemMediaTransportControlsSession) GetSourceAppUserModelId() (string, error) {
 	var outHStr ole.HString
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetSourceAppUserModelId,
 		uintptr(unsafe.Pointer(v)),        // this
@@ -245,7 +452,7 @@ func (v *iGlobalSystemMediaTransportControlsSession) GetSourceAppUserModelId() (
 	)
 
 	if hr != 0 {
-		return "", ole.NewError(hr)
+		return "", winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "get_SourceAppUserModelId")
 	}
 
 	out := outHStr.String()
@@ -253,16 +460,20 @@ func (v *iGlobalSystemMediaTransportControlsSession) GetSourceAppUserModelId() (
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryGetMediaPropertiesAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryGetMediaPropertiesAsync() (*IAsyncOperationGlobalSystemMediaTransportControlsSessionMediaProperties, error) {
+	var out *IAsyncOperationGlobalSystemMediaTransportControlsSessionMediaProperties
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryGetMediaPropertiesAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationGlobalSystemMediaTransportControlsSessionMediaProperties
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryGetMediaPropertiesAsync")
 	}
 
 	return out, nil
@@ -270,6 +481,10 @@ func (v *iGlobalSystemMediaTransportControlsSession) TryGetMediaPropertiesAsync(
 
 func (v *iGlobalSystemMediaTransportControlsSession) GetTimelineProperties() (*GlobalSystemMediaTransportControlsSessionTimelineProperties, error) {
 	var out *GlobalSystemMediaTransportControlsSessionTimelineProperties
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetTimelineProperties,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -277,7 +492,7 @@ func (v *iGlobalSystemMediaTransportControlsSession) GetTimelineProperties() (*G
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "GetTimelineProperties")
 	}
 
 	return out, nil
@@ -285,6 +500,10 @@ func (v *iGlobalSystemMediaTransportControlsSession) GetTimelineProperties() (*G
 
 func (v *iGlobalSystemMediaTransportControlsSession) GetPlaybackInfo() (*GlobalSystemMediaTransportControlsSessionPlaybackInfo, error) {
 	var out *GlobalSystemMediaTransportControlsSessionPlaybackInfo
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetPlaybackInfo,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -292,326 +511,530 @@ func (v *iGlobalSystemMediaTransportControlsSession) GetPlaybackInfo() (*GlobalS
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "GetPlaybackInfo")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryPlayAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryPlayAsync() (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryPlayAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryPlayAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryPauseAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryPauseAsync() (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryPauseAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryPauseAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryStopAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryStopAsync() (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryStopAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryStopAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryRecordAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryRecordAsync() (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryRecordAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryRecordAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryFastForwardAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryFastForwardAsync() (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryFastForwardAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryFastForwardAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryRewindAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryRewindAsync() (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryRewindAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryRewindAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TrySkipNextAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TrySkipNextAsync() (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TrySkipNextAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TrySkipNextAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TrySkipPreviousAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TrySkipPreviousAsync() (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TrySkipPreviousAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TrySkipPreviousAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryChangeChannelUpAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryChangeChannelUpAsync() (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryChangeChannelUpAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryChangeChannelUpAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryChangeChannelDownAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryChangeChannelDownAsync() (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryChangeChannelDownAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryChangeChannelDownAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryTogglePlayPauseAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryTogglePlayPauseAsync() (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryTogglePlayPauseAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryTogglePlayPauseAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryChangeAutoRepeatModeAsync(requestedAutoRepeatMode media.MediaPlaybackAutoRepeatMode) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryChangeAutoRepeatModeAsync(requestedAutoRepeatMode media.MediaPlaybackAutoRepeatMode) (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryChangeAutoRepeatModeAsync,
 		uintptr(unsafe.Pointer(v)),       // this
 		uintptr(requestedAutoRepeatMode), // in media.MediaPlaybackAutoRepeatMode
-		uintptr(unsafe.Pointer(&out)),    // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)),    // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryChangeAutoRepeatModeAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryChangePlaybackRateAsync(requestedPlaybackRate float64) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().TryChangePlaybackRateAsync,
-		uintptr(unsafe.Pointer(v)),     // this
-		uintptr(requestedPlaybackRate), // in float64
-		uintptr(unsafe.Pointer(&out)),  // out foundation.IAsyncOperation
-	)
+func (v *iGlobalSystemMediaTransportControlsSession) TryChangePlaybackRateAsync(requestedPlaybackRate float64) (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	requestedPlaybackRateABI, err := winrt.NewInValue(requestedPlaybackRate)
+	if err != nil {
+		return nil, err
+	}
+	defer requestedPlaybackRateABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	requestedPlaybackRateSlots := requestedPlaybackRateABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 2+len(requestedPlaybackRateSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v)))    // this
+	callArgs = append(callArgs, requestedPlaybackRateSlots...) // in float64
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(&out))) // out IAsyncOperationBoolean
+	hr, _, _ := syscall.SyscallN(v.VTable().TryChangePlaybackRateAsync, callArgs...)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryChangePlaybackRateAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryChangeShuffleActiveAsync(requestedShuffleState bool) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+func (v *iGlobalSystemMediaTransportControlsSession) TryChangeShuffleActiveAsync(requestedShuffleState bool) (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().TryChangeShuffleActiveAsync,
 		uintptr(unsafe.Pointer(v)),                                // this
 		uintptr(*(*byte)(unsafe.Pointer(&requestedShuffleState))), // in bool
-		uintptr(unsafe.Pointer(&out)),                             // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)),                             // out IAsyncOperationBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryChangeShuffleActiveAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) TryChangePlaybackPositionAsync(requestedPlaybackPosition int64) (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().TryChangePlaybackPositionAsync,
-		uintptr(unsafe.Pointer(v)),         // this
-		uintptr(requestedPlaybackPosition), // in int64
-		uintptr(unsafe.Pointer(&out)),      // out foundation.IAsyncOperation
-	)
+func (v *iGlobalSystemMediaTransportControlsSession) TryChangePlaybackPositionAsync(requestedPlaybackPosition int64) (*IAsyncOperationBoolean, error) {
+	var out *IAsyncOperationBoolean
+	requestedPlaybackPositionABI, err := winrt.NewInValue(requestedPlaybackPosition)
+	if err != nil {
+		return nil, err
+	}
+	defer requestedPlaybackPositionABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	requestedPlaybackPositionSlots := requestedPlaybackPositionABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 2+len(requestedPlaybackPositionSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v)))        // this
+	callArgs = append(callArgs, requestedPlaybackPositionSlots...) // in int64
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(&out)))     // out IAsyncOperationBoolean
+	hr, _, _ := syscall.SyscallN(v.VTable().TryChangePlaybackPositionAsync, callArgs...)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "TryChangePlaybackPositionAsync")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) AddTimelinePropertiesChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGlobalSystemMediaTransportControlsSession) AddTimelinePropertiesChanged(handler *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *TimelinePropertiesChangedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddTimelinePropertiesChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *TimelinePropertiesChangedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "add_TimelinePropertiesChanged")
 	}
 
 	return out, nil
 }
 
+// OnTimelinePropertiesChanged registers the given function as a handler of the event, using AddTimelinePropertiesChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveTimelinePropertiesChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGlobalSystemMediaTransportControlsSession) OnTimelinePropertiesChanged(callback func(sender *GlobalSystemMediaTransportControlsSession, args *TimelinePropertiesChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GlobalSystemMediaTransportControlsSession, *TimelinePropertiesChangedEventArgs](func(_ *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *TimelinePropertiesChangedEventArgs], sender *GlobalSystemMediaTransportControlsSession, args *TimelinePropertiesChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddTimelinePropertiesChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveTimelinePropertiesChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGlobalSystemMediaTransportControlsSession) RemoveTimelinePropertiesChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveTimelinePropertiesChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveTimelinePropertiesChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "remove_TimelinePropertiesChanged")
 	}
 
 	return nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) AddPlaybackInfoChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGlobalSystemMediaTransportControlsSession) AddPlaybackInfoChanged(handler *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *PlaybackInfoChangedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddPlaybackInfoChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *PlaybackInfoChangedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "add_PlaybackInfoChanged")
 	}
 
 	return out, nil
 }
 
+// OnPlaybackInfoChanged registers the given function as a handler of the event, using AddPlaybackInfoChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemovePlaybackInfoChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGlobalSystemMediaTransportControlsSession) OnPlaybackInfoChanged(callback func(sender *GlobalSystemMediaTransportControlsSession, args *PlaybackInfoChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GlobalSystemMediaTransportControlsSession, *PlaybackInfoChangedEventArgs](func(_ *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *PlaybackInfoChangedEventArgs], sender *GlobalSystemMediaTransportControlsSession, args *PlaybackInfoChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddPlaybackInfoChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemovePlaybackInfoChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGlobalSystemMediaTransportControlsSession) RemovePlaybackInfoChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemovePlaybackInfoChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemovePlaybackInfoChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "remove_PlaybackInfoChanged")
 	}
 
 	return nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSession) AddMediaPropertiesChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGlobalSystemMediaTransportControlsSession) AddMediaPropertiesChanged(handler *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *MediaPropertiesChangedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddMediaPropertiesChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *MediaPropertiesChangedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "add_MediaPropertiesChanged")
 	}
 
 	return out, nil
 }
 
+// OnMediaPropertiesChanged registers the given function as a handler of the event, using AddMediaPropertiesChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveMediaPropertiesChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGlobalSystemMediaTransportControlsSession) OnMediaPropertiesChanged(callback func(sender *GlobalSystemMediaTransportControlsSession, args *MediaPropertiesChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GlobalSystemMediaTransportControlsSession, *MediaPropertiesChangedEventArgs](func(_ *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSession, *MediaPropertiesChangedEventArgs], sender *GlobalSystemMediaTransportControlsSession, args *MediaPropertiesChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddMediaPropertiesChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveMediaPropertiesChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGlobalSystemMediaTransportControlsSession) RemoveMediaPropertiesChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveMediaPropertiesChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveMediaPropertiesChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSession", "remove_MediaPropertiesChanged")
 	}
 
 	return nil
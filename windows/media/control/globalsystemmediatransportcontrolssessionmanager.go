@@ -6,57 +6,164 @@
 package control
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
-	"github.com/saltosystems/winrt-go/windows/foundation/collections"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGlobalSystemMediaTransportControlsSessionManager string = "rc(Windows.Media.Control.GlobalSystemMediaTransportControlsSessionManager;{cace8eac-e86e-504a-ab31-5ff8ff1bce49})"
 
+const ContractNameGlobalSystemMediaTransportControlsSessionManager string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGlobalSystemMediaTransportControlsSessionManager uint32 = 0x00070000
+
+// GlobalSystemMediaTransportControlsSessionManager was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type GlobalSystemMediaTransportControlsSessionManager struct {
 	ole.IUnknown
 }
 
+func (impl *GlobalSystemMediaTransportControlsSessionManager) Signature() string {
+	return SignatureGlobalSystemMediaTransportControlsSessionManager
+}
+
+// IsPresent reports whether the Windows.Media.Control.GlobalSystemMediaTransportControlsSessionManager class is available in the running version of Windows.
+func (impl *GlobalSystemMediaTransportControlsSessionManager) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Media.Control.GlobalSystemMediaTransportControlsSessionManager")
+}
+
+// IsMethodPresent reports whether the given method or static function of GlobalSystemMediaTransportControlsSessionManager, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GlobalSystemMediaTransportControlsSessionManager) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetCurrentSession", "GetSessions", "AddCurrentSessionChanged", "OnCurrentSessionChanged", "RemoveCurrentSessionChanged", "AddSessionsChanged", "OnSessionsChanged", "RemoveSessionsChanged":
+		return metadata.ApiInformationIsTypePresent("Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager")
+	case "GlobalSystemMediaTransportControlsSessionManagerRequestAsync":
+		return metadata.ApiInformationIsTypePresent("Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManagerStatics")
+	}
+	return false, fmt.Errorf("unknown method %q of GlobalSystemMediaTransportControlsSessionManager", name)
+}
+
 func (impl *GlobalSystemMediaTransportControlsSessionManager) GetCurrentSession() (*GlobalSystemMediaTransportControlsSession, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionManager))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionManager, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionManager)(unsafe.Pointer(itf))
 	return v.GetCurrentSession()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSessionManager) GetSessions() (*collections.IVectorView, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionManager))
+func (impl *GlobalSystemMediaTransportControlsSessionManager) GetSessions() (*IVectorViewGlobalSystemMediaTransportControlsSession, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionManager, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionManager)(unsafe.Pointer(itf))
 	return v.GetSessions()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSessionManager) AddCurrentSessionChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionManager))
+func (impl *GlobalSystemMediaTransportControlsSessionManager) AddCurrentSessionChanged(handler *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *CurrentSessionChangedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionManager, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionManager)(unsafe.Pointer(itf))
 	return v.AddCurrentSessionChanged(handler)
 }
 
+// OnCurrentSessionChanged registers the given function as a handler of the event, using AddCurrentSessionChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveCurrentSessionChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GlobalSystemMediaTransportControlsSessionManager) OnCurrentSessionChanged(callback func(sender *GlobalSystemMediaTransportControlsSessionManager, args *CurrentSessionChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *CurrentSessionChangedEventArgs](func(_ *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *CurrentSessionChangedEventArgs], sender *GlobalSystemMediaTransportControlsSessionManager, args *CurrentSessionChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddCurrentSessionChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveCurrentSessionChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GlobalSystemMediaTransportControlsSessionManager) RemoveCurrentSessionChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionManager))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionManager, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionManager)(unsafe.Pointer(itf))
 	return v.RemoveCurrentSessionChanged(token)
 }
 
-func (impl *GlobalSystemMediaTransportControlsSessionManager) AddSessionsChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionManager))
+func (impl *GlobalSystemMediaTransportControlsSessionManager) AddSessionsChanged(handler *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *SessionsChangedEventArgs]) (foundation.EventRegistrationToken, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionManager, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager")
+	if err != nil {
+		return foundation.EventRegistrationToken{}, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionManager)(unsafe.Pointer(itf))
 	return v.AddSessionsChanged(handler)
 }
 
+// OnSessionsChanged registers the given function as a handler of the event, using AddSessionsChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveSessionsChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (impl *GlobalSystemMediaTransportControlsSessionManager) OnSessionsChanged(callback func(sender *GlobalSystemMediaTransportControlsSessionManager, args *SessionsChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *SessionsChangedEventArgs](func(_ *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *SessionsChangedEventArgs], sender *GlobalSystemMediaTransportControlsSessionManager, args *SessionsChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := impl.AddSessionsChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	impl.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer impl.Release()
+			removeErr = impl.RemoveSessionsChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (impl *GlobalSystemMediaTransportControlsSessionManager) RemoveSessionsChanged(token foundation.EventRegistrationToken) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionManager))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionManager, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionManager)(unsafe.Pointer(itf))
 	return v.RemoveSessionsChanged(token)
@@ -65,10 +172,20 @@ func (impl *GlobalSystemMediaTransportControlsSessionManager) RemoveSessionsChan
 const GUIDiGlobalSystemMediaTransportControlsSessionManager string = "cace8eac-e86e-504a-ab31-5ff8ff1bce49"
 const SignatureiGlobalSystemMediaTransportControlsSessionManager string = "{cace8eac-e86e-504a-ab31-5ff8ff1bce49}"
 
+var IIDiGlobalSystemMediaTransportControlsSessionManager = ole.GUID{Data1: 0xCACE8EAC, Data2: 0xE86E, Data3: 0x504A, Data4: [8]byte{0xAB, 0x31, 0x5F, 0xF8, 0xFF, 0x1B, 0xCE, 0x49}}
+
+const ContractNameiGlobalSystemMediaTransportControlsSessionManager string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGlobalSystemMediaTransportControlsSessionManager uint32 = 0x00070000
+
+// iGlobalSystemMediaTransportControlsSessionManager was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iGlobalSystemMediaTransportControlsSessionManager struct {
 	ole.IInspectable
 }
 
+func (v *iGlobalSystemMediaTransportControlsSessionManager) Signature() string {
+	return SignatureiGlobalSystemMediaTransportControlsSessionManager
+}
+
 type iGlobalSystemMediaTransportControlsSessionManagerVtbl struct {
 	ole.IInspectableVtbl
 
@@ -86,6 +203,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionManager) VTable() *iGlobalSys
 
 func (v *iGlobalSystemMediaTransportControlsSessionManager) GetCurrentSession() (*GlobalSystemMediaTransportControlsSession, error) {
 	var out *GlobalSystemMediaTransportControlsSession
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCurrentSession,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -93,82 +214,174 @@ func (v *iGlobalSystemMediaTransportControlsSessionManager) GetCurrentSession()
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager", "GetCurrentSession")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSessionManager) GetSessions() (*collections.IVectorView, error) {
-	var out *collections.IVectorView
+func (v *iGlobalSystemMediaTransportControlsSessionManager) GetSessions() (*IVectorViewGlobalSystemMediaTransportControlsSession, error) {
+	var out *IVectorViewGlobalSystemMediaTransportControlsSession
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetSessions,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVectorView
+		uintptr(unsafe.Pointer(&out)), // out IVectorViewGlobalSystemMediaTransportControlsSession
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager", "GetSessions")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSessionManager) AddCurrentSessionChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGlobalSystemMediaTransportControlsSessionManager) AddCurrentSessionChanged(handler *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *CurrentSessionChangedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddCurrentSessionChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *CurrentSessionChangedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager", "add_CurrentSessionChanged")
 	}
 
 	return out, nil
 }
 
+// OnCurrentSessionChanged registers the given function as a handler of the event, using AddCurrentSessionChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveCurrentSessionChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGlobalSystemMediaTransportControlsSessionManager) OnCurrentSessionChanged(callback func(sender *GlobalSystemMediaTransportControlsSessionManager, args *CurrentSessionChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *CurrentSessionChangedEventArgs](func(_ *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *CurrentSessionChangedEventArgs], sender *GlobalSystemMediaTransportControlsSessionManager, args *CurrentSessionChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddCurrentSessionChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveCurrentSessionChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGlobalSystemMediaTransportControlsSessionManager) RemoveCurrentSessionChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveCurrentSessionChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveCurrentSessionChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager", "remove_CurrentSessionChanged")
 	}
 
 	return nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSessionManager) AddSessionsChanged(handler *foundation.TypedEventHandler) (foundation.EventRegistrationToken, error) {
+func (v *iGlobalSystemMediaTransportControlsSessionManager) AddSessionsChanged(handler *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *SessionsChangedEventArgs]) (foundation.EventRegistrationToken, error) {
 	var out foundation.EventRegistrationToken
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().AddSessionsChanged,
 		uintptr(unsafe.Pointer(v)),       // this
-		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler
+		uintptr(unsafe.Pointer(handler)), // in foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *SessionsChangedEventArgs]
 		uintptr(unsafe.Pointer(&out)),    // out foundation.EventRegistrationToken
 	)
 
 	if hr != 0 {
-		return foundation.EventRegistrationToken{}, ole.NewError(hr)
+		return foundation.EventRegistrationToken{}, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager", "add_SessionsChanged")
 	}
 
 	return out, nil
 }
 
+// OnSessionsChanged registers the given function as a handler of the event, using AddSessionsChanged. The function is called
+// from a thread owned by Windows, and the interfaces it receives are only valid during the call. The returned
+// function removes the handler using RemoveSessionsChanged, only the first call has any effect. The object is kept
+// alive until the handler is removed.
+func (v *iGlobalSystemMediaTransportControlsSessionManager) OnSessionsChanged(callback func(sender *GlobalSystemMediaTransportControlsSessionManager, args *SessionsChangedEventArgs)) (unsubscribe func() error, err error) {
+	handler := foundation.NewTypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *SessionsChangedEventArgs](func(_ *foundation.TypedEventHandler[*GlobalSystemMediaTransportControlsSessionManager, *SessionsChangedEventArgs], sender *GlobalSystemMediaTransportControlsSessionManager, args *SessionsChangedEventArgs) error {
+		callback(sender, args)
+		return nil
+	})
+	// the event source holds its own reference while the handler is registered. Ours is released through
+	// the vtable, so the handler is also removed from the delegate registry once it is no longer referenced.
+	defer handler.IUnknown.Release()
+
+	token, err := v.AddSessionsChanged(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	v.AddRef()
+	var once sync.Once
+	var removeErr error
+	return func() error {
+		once.Do(func() {
+			defer v.Release()
+			removeErr = v.RemoveSessionsChanged(token)
+		})
+		return removeErr
+	}, nil
+}
+
 func (v *iGlobalSystemMediaTransportControlsSessionManager) RemoveSessionsChanged(token foundation.EventRegistrationToken) error {
-	hr, _, _ := syscall.SyscallN(
-		v.VTable().RemoveSessionsChanged,
-		uintptr(unsafe.Pointer(v)),      // this
-		uintptr(unsafe.Pointer(&token)), // in foundation.EventRegistrationToken
-	)
+	tokenABI, err := winrt.NewInValue(token)
+	if err != nil {
+		return err
+	}
+	defer tokenABI.Release()
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tokenSlots := tokenABI.ABI()
+	// No function is called between converting the pointers to uintptr and the call, so the stack can not move.
+	callArgs := make([]uintptr, 0, 1+len(tokenSlots))
+	callArgs = append(callArgs, uintptr(unsafe.Pointer(v))) // this
+	callArgs = append(callArgs, tokenSlots...)              // in foundation.EventRegistrationToken
+	hr, _, _ := syscall.SyscallN(v.VTable().RemoveSessionsChanged, callArgs...)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManager", "remove_SessionsChanged")
 	}
 
 	return nil
@@ -177,10 +390,20 @@ func (v *iGlobalSystemMediaTransportControlsSessionManager) RemoveSessionsChange
 const GUIDiGlobalSystemMediaTransportControlsSessionManagerStatics string = "2050c4ee-11a0-57de-aed7-c97c70338245"
 const SignatureiGlobalSystemMediaTransportControlsSessionManagerStatics string = "{2050c4ee-11a0-57de-aed7-c97c70338245}"
 
+var IIDiGlobalSystemMediaTransportControlsSessionManagerStatics = ole.GUID{Data1: 0x2050C4EE, Data2: 0x11A0, Data3: 0x57DE, Data4: [8]byte{0xAE, 0xD7, 0xC9, 0x7C, 0x70, 0x33, 0x82, 0x45}}
+
+const ContractNameiGlobalSystemMediaTransportControlsSessionManagerStatics string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGlobalSystemMediaTransportControlsSessionManagerStatics uint32 = 0x00070000
+
+// iGlobalSystemMediaTransportControlsSessionManagerStatics was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iGlobalSystemMediaTransportControlsSessionManagerStatics struct {
 	ole.IInspectable
 }
 
+func (v *iGlobalSystemMediaTransportControlsSessionManagerStatics) Signature() string {
+	return SignatureiGlobalSystemMediaTransportControlsSessionManagerStatics
+}
+
 type iGlobalSystemMediaTransportControlsSessionManagerStaticsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -191,22 +414,27 @@ func (v *iGlobalSystemMediaTransportControlsSessionManagerStatics) VTable() *iGl
 	return (*iGlobalSystemMediaTransportControlsSessionManagerStaticsVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func GlobalSystemMediaTransportControlsSessionManagerRequestAsync() (*foundation.IAsyncOperation, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Media.Control.GlobalSystemMediaTransportControlsSessionManager", ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionManagerStatics))
+func GlobalSystemMediaTransportControlsSessionManagerRequestAsync() (*IAsyncOperationGlobalSystemMediaTransportControlsSessionManager, error) {
+	inspectable, err := winrt.GetActivationFactory("Windows.Media.Control.GlobalSystemMediaTransportControlsSessionManager", &IIDiGlobalSystemMediaTransportControlsSessionManagerStatics)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionManagerStatics)(unsafe.Pointer(inspectable))
 
-	var out *foundation.IAsyncOperation
+	var out *IAsyncOperationGlobalSystemMediaTransportControlsSessionManager
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GlobalSystemMediaTransportControlsSessionManagerRequestAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationGlobalSystemMediaTransportControlsSessionManager
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionManagerStatics", "RequestAsync")
 	}
 
 	return out, nil
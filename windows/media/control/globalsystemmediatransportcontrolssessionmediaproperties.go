@@ -6,86 +6,141 @@
 package control
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
-	"github.com/saltosystems/winrt-go/windows/foundation"
-	"github.com/saltosystems/winrt-go/windows/foundation/collections"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 	"github.com/saltosystems/winrt-go/windows/storage/streams"
 )
 
 const SignatureGlobalSystemMediaTransportControlsSessionMediaProperties string = "rc(Windows.Media.Control.GlobalSystemMediaTransportControlsSessionMediaProperties;{68856cf6-adb4-54b2-ac16-05837907acb6})"
 
+const ContractNameGlobalSystemMediaTransportControlsSessionMediaProperties string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGlobalSystemMediaTransportControlsSessionMediaProperties uint32 = 0x00070000
+
+// GlobalSystemMediaTransportControlsSessionMediaProperties was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type GlobalSystemMediaTransportControlsSessionMediaProperties struct {
 	ole.IUnknown
 }
 
+func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) Signature() string {
+	return SignatureGlobalSystemMediaTransportControlsSessionMediaProperties
+}
+
+// IsPresent reports whether the Windows.Media.Control.GlobalSystemMediaTransportControlsSessionMediaProperties class is available in the running version of Windows.
+func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Media.Control.GlobalSystemMediaTransportControlsSessionMediaProperties")
+}
+
+// IsMethodPresent reports whether the given method or static function of GlobalSystemMediaTransportControlsSessionMediaProperties, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetTitle", "GetSubtitle", "GetAlbumArtist", "GetArtist", "GetAlbumTitle", "GetTrackNumber", "GetGenres", "GetAlbumTrackCount", "GetPlaybackType", "GetThumbnail":
+		return metadata.ApiInformationIsTypePresent("Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties")
+	}
+	return false, fmt.Errorf("unknown method %q of GlobalSystemMediaTransportControlsSessionMediaProperties", name)
+}
+
 func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetTitle() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionMediaProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionMediaProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties")
+	if err != nil {
+		return "", err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionMediaProperties)(unsafe.Pointer(itf))
 	return v.GetTitle()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetSubtitle() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionMediaProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionMediaProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties")
+	if err != nil {
+		return "", err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionMediaProperties)(unsafe.Pointer(itf))
 	return v.GetSubtitle()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetAlbumArtist() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionMediaProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionMediaProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties")
+	if err != nil {
+		return "", err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionMediaProperties)(unsafe.Pointer(itf))
 	return v.GetAlbumArtist()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetArtist() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionMediaProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionMediaProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties")
+	if err != nil {
+		return "", err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionMediaProperties)(unsafe.Pointer(itf))
 	return v.GetArtist()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetAlbumTitle() (string, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionMediaProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionMediaProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties")
+	if err != nil {
+		return "", err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionMediaProperties)(unsafe.Pointer(itf))
 	return v.GetAlbumTitle()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetTrackNumber() (int32, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionMediaProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionMediaProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionMediaProperties)(unsafe.Pointer(itf))
 	return v.GetTrackNumber()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetGenres() (*collections.IVectorView, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionMediaProperties))
+func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetGenres() (*IVectorViewString, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionMediaProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionMediaProperties)(unsafe.Pointer(itf))
 	return v.GetGenres()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetAlbumTrackCount() (int32, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionMediaProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionMediaProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionMediaProperties)(unsafe.Pointer(itf))
 	return v.GetAlbumTrackCount()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetPlaybackType() (*foundation.IReference, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionMediaProperties))
+func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetPlaybackType() (*IReferenceMediaPlaybackType, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionMediaProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionMediaProperties)(unsafe.Pointer(itf))
 	return v.GetPlaybackType()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetThumbnail() (*streams.IRandomAccessStreamReference, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionMediaProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionMediaProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionMediaProperties)(unsafe.Pointer(itf))
 	return v.GetThumbnail()
@@ -94,10 +149,20 @@ func (impl *GlobalSystemMediaTransportControlsSessionMediaProperties) GetThumbna
 const GUIDiGlobalSystemMediaTransportControlsSessionMediaProperties string = "68856cf6-adb4-54b2-ac16-05837907acb6"
 const SignatureiGlobalSystemMediaTransportControlsSessionMediaProperties string = "{68856cf6-adb4-54b2-ac16-05837907acb6}"
 
+var IIDiGlobalSystemMediaTransportControlsSessionMediaProperties = ole.GUID{Data1: 0x68856CF6, Data2: 0xADB4, Data3: 0x54B2, Data4: [8]byte{0xAC, 0x16, 0x05, 0x83, 0x79, 0x07, 0xAC, 0xB6}}
+
+const ContractNameiGlobalSystemMediaTransportControlsSessionMediaProperties string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGlobalSystemMediaTransportControlsSessionMediaProperties uint32 = 0x00070000
+
+// iGlobalSystemMediaTransportControlsSessionMediaProperties was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iGlobalSystemMediaTransportControlsSessionMediaProperties struct {
 	ole.IInspectable
 }
 
+func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) Signature() string {
+	return SignatureiGlobalSystemMediaTransportControlsSessionMediaProperties
+}
+
 type iGlobalSystemMediaTransportControlsSessionMediaPropertiesVtbl struct {
 	ole.IInspectableVtbl
 
@@ -119,6 +184,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) VTable() *iG
 
 func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetTitle() (string, error) {
 	var outHStr ole.HString
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetTitle,
 		uintptr(unsafe.Pointer(v)),        // this
@@ -126,7 +195,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetTitle() (
 	)
 
 	if hr != 0 {
-		return "", ole.NewError(hr)
+		return "", winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties", "get_Title")
 	}
 
 	out := outHStr.String()
@@ -136,6 +205,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetTitle() (
 
 func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetSubtitle() (string, error) {
 	var outHStr ole.HString
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetSubtitle,
 		uintptr(unsafe.Pointer(v)),        // this
@@ -143,7 +216,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetSubtitle(
 	)
 
 	if hr != 0 {
-		return "", ole.NewError(hr)
+		return "", winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties", "get_Subtitle")
 	}
 
 	out := outHStr.String()
@@ -153,6 +226,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetSubtitle(
 
 func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetAlbumArtist() (string, error) {
 	var outHStr ole.HString
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetAlbumArtist,
 		uintptr(unsafe.Pointer(v)),        // this
@@ -160,7 +237,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetAlbumArti
 	)
 
 	if hr != 0 {
-		return "", ole.NewError(hr)
+		return "", winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties", "get_AlbumArtist")
 	}
 
 	out := outHStr.String()
@@ -170,6 +247,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetAlbumArti
 
 func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetArtist() (string, error) {
 	var outHStr ole.HString
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetArtist,
 		uintptr(unsafe.Pointer(v)),        // this
@@ -177,7 +258,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetArtist()
 	)
 
 	if hr != 0 {
-		return "", ole.NewError(hr)
+		return "", winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties", "get_Artist")
 	}
 
 	out := outHStr.String()
@@ -187,6 +268,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetArtist()
 
 func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetAlbumTitle() (string, error) {
 	var outHStr ole.HString
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetAlbumTitle,
 		uintptr(unsafe.Pointer(v)),        // this
@@ -194,7 +279,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetAlbumTitl
 	)
 
 	if hr != 0 {
-		return "", ole.NewError(hr)
+		return "", winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties", "get_AlbumTitle")
 	}
 
 	out := outHStr.String()
@@ -204,6 +289,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetAlbumTitl
 
 func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetTrackNumber() (int32, error) {
 	var out int32
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetTrackNumber,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -211,22 +300,26 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetTrackNumb
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties", "get_TrackNumber")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetGenres() (*collections.IVectorView, error) {
-	var out *collections.IVectorView
+func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetGenres() (*IVectorViewString, error) {
+	var out *IVectorViewString
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetGenres,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out collections.IVectorView
+		uintptr(unsafe.Pointer(&out)), // out IVectorViewString
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties", "get_Genres")
 	}
 
 	return out, nil
@@ -234,6 +327,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetGenres()
 
 func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetAlbumTrackCount() (int32, error) {
 	var out int32
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetAlbumTrackCount,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -241,22 +338,26 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetAlbumTrac
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties", "get_AlbumTrackCount")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetPlaybackType() (*foundation.IReference, error) {
-	var out *foundation.IReference
+func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetPlaybackType() (*IReferenceMediaPlaybackType, error) {
+	var out *IReferenceMediaPlaybackType
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetPlaybackType,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IReference
+		uintptr(unsafe.Pointer(&out)), // out IReferenceMediaPlaybackType
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties", "get_PlaybackType")
 	}
 
 	return out, nil
@@ -264,6 +365,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetPlaybackT
 
 func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetThumbnail() (*streams.IRandomAccessStreamReference, error) {
 	var out *streams.IRandomAccessStreamReference
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetThumbnail,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -271,7 +376,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionMediaProperties) GetThumbnail
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionMediaProperties", "get_Thumbnail")
 	}
 
 	return out, nil
@@ -6,118 +6,190 @@
 package control
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGlobalSystemMediaTransportControlsSessionPlaybackControls string = "rc(Windows.Media.Control.GlobalSystemMediaTransportControlsSessionPlaybackControls;{6501a3e6-bc7a-503a-bb1b-68f158f3fb03})"
 
+const ContractNameGlobalSystemMediaTransportControlsSessionPlaybackControls string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGlobalSystemMediaTransportControlsSessionPlaybackControls uint32 = 0x00070000
+
+// GlobalSystemMediaTransportControlsSessionPlaybackControls was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type GlobalSystemMediaTransportControlsSessionPlaybackControls struct {
 	ole.IUnknown
 }
 
+func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) Signature() string {
+	return SignatureGlobalSystemMediaTransportControlsSessionPlaybackControls
+}
+
+// IsPresent reports whether the Windows.Media.Control.GlobalSystemMediaTransportControlsSessionPlaybackControls class is available in the running version of Windows.
+func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Media.Control.GlobalSystemMediaTransportControlsSessionPlaybackControls")
+}
+
+// IsMethodPresent reports whether the given method or static function of GlobalSystemMediaTransportControlsSessionPlaybackControls, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetIsPlayEnabled", "GetIsPauseEnabled", "GetIsStopEnabled", "GetIsRecordEnabled", "GetIsFastForwardEnabled", "GetIsRewindEnabled", "GetIsNextEnabled", "GetIsPreviousEnabled", "GetIsChannelUpEnabled", "GetIsChannelDownEnabled", "GetIsPlayPauseToggleEnabled", "GetIsShuffleEnabled", "GetIsRepeatEnabled", "GetIsPlaybackRateEnabled", "GetIsPlaybackPositionEnabled":
+		return metadata.ApiInformationIsTypePresent("Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	}
+	return false, fmt.Errorf("unknown method %q of GlobalSystemMediaTransportControlsSessionPlaybackControls", name)
+}
+
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlayEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsPlayEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPauseEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsPauseEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsStopEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsStopEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRecordEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsRecordEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsFastForwardEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsFastForwardEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRewindEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsRewindEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsNextEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsNextEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPreviousEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsPreviousEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsChannelUpEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsChannelUpEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsChannelDownEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsChannelDownEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlayPauseToggleEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsPlayPauseToggleEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsShuffleEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsShuffleEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRepeatEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsRepeatEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlaybackRateEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsPlaybackRateEnabled()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlaybackPositionEnabled() (bool, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls")
+	if err != nil {
+		return false, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackControls)(unsafe.Pointer(itf))
 	return v.GetIsPlaybackPositionEnabled()
@@ -126,10 +198,20 @@ func (impl *GlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlay
 const GUIDiGlobalSystemMediaTransportControlsSessionPlaybackControls string = "6501a3e6-bc7a-503a-bb1b-68f158f3fb03"
 const SignatureiGlobalSystemMediaTransportControlsSessionPlaybackControls string = "{6501a3e6-bc7a-503a-bb1b-68f158f3fb03}"
 
+var IIDiGlobalSystemMediaTransportControlsSessionPlaybackControls = ole.GUID{Data1: 0x6501A3E6, Data2: 0xBC7A, Data3: 0x503A, Data4: [8]byte{0xBB, 0x1B, 0x68, 0xF1, 0x58, 0xF3, 0xFB, 0x03}}
+
+const ContractNameiGlobalSystemMediaTransportControlsSessionPlaybackControls string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGlobalSystemMediaTransportControlsSessionPlaybackControls uint32 = 0x00070000
+
+// iGlobalSystemMediaTransportControlsSessionPlaybackControls was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iGlobalSystemMediaTransportControlsSessionPlaybackControls struct {
 	ole.IInspectable
 }
 
+func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) Signature() string {
+	return SignatureiGlobalSystemMediaTransportControlsSessionPlaybackControls
+}
+
 type iGlobalSystemMediaTransportControlsSessionPlaybackControlsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -156,6 +238,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) VTable() *i
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlayEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsPlayEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -163,7 +249,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlayEn
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsPlayEnabled")
 	}
 
 	return out, nil
@@ -171,6 +257,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlayEn
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPauseEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsPauseEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -178,7 +268,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPauseE
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsPauseEnabled")
 	}
 
 	return out, nil
@@ -186,6 +276,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPauseE
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsStopEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsStopEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -193,7 +287,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsStopEn
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsStopEnabled")
 	}
 
 	return out, nil
@@ -201,6 +295,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsStopEn
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRecordEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsRecordEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -208,7 +306,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRecord
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsRecordEnabled")
 	}
 
 	return out, nil
@@ -216,6 +314,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRecord
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsFastForwardEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsFastForwardEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -223,7 +325,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsFastFo
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsFastForwardEnabled")
 	}
 
 	return out, nil
@@ -231,6 +333,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsFastFo
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRewindEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsRewindEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -238,7 +344,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRewind
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsRewindEnabled")
 	}
 
 	return out, nil
@@ -246,6 +352,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRewind
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsNextEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsNextEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -253,7 +363,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsNextEn
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsNextEnabled")
 	}
 
 	return out, nil
@@ -261,6 +371,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsNextEn
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPreviousEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsPreviousEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -268,7 +382,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPrevio
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsPreviousEnabled")
 	}
 
 	return out, nil
@@ -276,6 +390,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPrevio
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsChannelUpEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsChannelUpEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -283,7 +401,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsChanne
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsChannelUpEnabled")
 	}
 
 	return out, nil
@@ -291,6 +409,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsChanne
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsChannelDownEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsChannelDownEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -298,7 +420,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsChanne
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsChannelDownEnabled")
 	}
 
 	return out, nil
@@ -306,6 +428,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsChanne
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlayPauseToggleEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsPlayPauseToggleEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -313,7 +439,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlayPa
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsPlayPauseToggleEnabled")
 	}
 
 	return out, nil
@@ -321,6 +447,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlayPa
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsShuffleEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsShuffleEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -328,7 +458,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsShuffl
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsShuffleEnabled")
 	}
 
 	return out, nil
@@ -336,6 +466,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsShuffl
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRepeatEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsRepeatEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -343,7 +477,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRepeat
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsRepeatEnabled")
 	}
 
 	return out, nil
@@ -351,6 +485,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsRepeat
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlaybackRateEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsPlaybackRateEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -358,7 +496,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlayba
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsPlaybackRateEnabled")
 	}
 
 	return out, nil
@@ -366,6 +504,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlayba
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlaybackPositionEnabled() (bool, error) {
 	var out bool
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsPlaybackPositionEnabled,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -373,7 +515,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackControls) GetIsPlayba
 	)
 
 	if hr != 0 {
-		return false, ole.NewError(hr)
+		return false, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackControls", "get_IsPlaybackPositionEnabled")
 	}
 
 	return out, nil
@@ -6,56 +6,100 @@
 package control
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
-	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGlobalSystemMediaTransportControlsSessionPlaybackInfo string = "rc(Windows.Media.Control.GlobalSystemMediaTransportControlsSessionPlaybackInfo;{94b4b6cf-e8ba-51ad-87a7-c10ade106127})"
 
+const ContractNameGlobalSystemMediaTransportControlsSessionPlaybackInfo string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGlobalSystemMediaTransportControlsSessionPlaybackInfo uint32 = 0x00070000
+
+// GlobalSystemMediaTransportControlsSessionPlaybackInfo was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type GlobalSystemMediaTransportControlsSessionPlaybackInfo struct {
 	ole.IUnknown
 }
 
+func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) Signature() string {
+	return SignatureGlobalSystemMediaTransportControlsSessionPlaybackInfo
+}
+
+// IsPresent reports whether the Windows.Media.Control.GlobalSystemMediaTransportControlsSessionPlaybackInfo class is available in the running version of Windows.
+func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Media.Control.GlobalSystemMediaTransportControlsSessionPlaybackInfo")
+}
+
+// IsMethodPresent reports whether the given method or static function of GlobalSystemMediaTransportControlsSessionPlaybackInfo, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetControls", "GetPlaybackStatus", "GetPlaybackType", "GetAutoRepeatMode", "GetPlaybackRate", "GetIsShuffleActive":
+		return metadata.ApiInformationIsTypePresent("Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo")
+	}
+	return false, fmt.Errorf("unknown method %q of GlobalSystemMediaTransportControlsSessionPlaybackInfo", name)
+}
+
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) GetControls() (*GlobalSystemMediaTransportControlsSessionPlaybackControls, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackInfo)(unsafe.Pointer(itf))
 	return v.GetControls()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) GetPlaybackStatus() (GlobalSystemMediaTransportControlsSessionPlaybackStatus, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo")
+	if err != nil {
+		return GlobalSystemMediaTransportControlsSessionPlaybackStatusClosed, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackInfo)(unsafe.Pointer(itf))
 	return v.GetPlaybackStatus()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) GetPlaybackType() (*foundation.IReference, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo))
+func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) GetPlaybackType() (*IReferenceMediaPlaybackType, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackInfo)(unsafe.Pointer(itf))
 	return v.GetPlaybackType()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) GetAutoRepeatMode() (*foundation.IReference, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo))
+func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) GetAutoRepeatMode() (*IReferenceMediaPlaybackAutoRepeatMode, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackInfo)(unsafe.Pointer(itf))
 	return v.GetAutoRepeatMode()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) GetPlaybackRate() (*foundation.IReference, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo))
+func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) GetPlaybackRate() (*IReferenceDouble, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackInfo)(unsafe.Pointer(itf))
 	return v.GetPlaybackRate()
 }
 
-func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) GetIsShuffleActive() (*foundation.IReference, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo))
+func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) GetIsShuffleActive() (*IReferenceBoolean, error) {
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionPlaybackInfo)(unsafe.Pointer(itf))
 	return v.GetIsShuffleActive()
@@ -64,10 +108,20 @@ func (impl *GlobalSystemMediaTransportControlsSessionPlaybackInfo) GetIsShuffleA
 const GUIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo string = "94b4b6cf-e8ba-51ad-87a7-c10ade106127"
 const SignatureiGlobalSystemMediaTransportControlsSessionPlaybackInfo string = "{94b4b6cf-e8ba-51ad-87a7-c10ade106127}"
 
+var IIDiGlobalSystemMediaTransportControlsSessionPlaybackInfo = ole.GUID{Data1: 0x94B4B6CF, Data2: 0xE8BA, Data3: 0x51AD, Data4: [8]byte{0x87, 0xA7, 0xC1, 0x0A, 0xDE, 0x10, 0x61, 0x27}}
+
+const ContractNameiGlobalSystemMediaTransportControlsSessionPlaybackInfo string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGlobalSystemMediaTransportControlsSessionPlaybackInfo uint32 = 0x00070000
+
+// iGlobalSystemMediaTransportControlsSessionPlaybackInfo was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iGlobalSystemMediaTransportControlsSessionPlaybackInfo struct {
 	ole.IInspectable
 }
 
+func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) Signature() string {
+	return SignatureiGlobalSystemMediaTransportControlsSessionPlaybackInfo
+}
+
 type iGlobalSystemMediaTransportControlsSessionPlaybackInfoVtbl struct {
 	ole.IInspectableVtbl
 
@@ -85,6 +139,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) VTable() *iGlob
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetControls() (*GlobalSystemMediaTransportControlsSessionPlaybackControls, error) {
 	var out *GlobalSystemMediaTransportControlsSessionPlaybackControls
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetControls,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -92,7 +150,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetControls() (
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo", "get_Controls")
 	}
 
 	return out, nil
@@ -100,6 +158,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetControls() (
 
 func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetPlaybackStatus() (GlobalSystemMediaTransportControlsSessionPlaybackStatus, error) {
 	var out GlobalSystemMediaTransportControlsSessionPlaybackStatus
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetPlaybackStatus,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -107,67 +169,83 @@ func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetPlaybackStat
 	)
 
 	if hr != 0 {
-		return GlobalSystemMediaTransportControlsSessionPlaybackStatusClosed, ole.NewError(hr)
+		return GlobalSystemMediaTransportControlsSessionPlaybackStatusClosed, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo", "get_PlaybackStatus")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetPlaybackType() (*foundation.IReference, error) {
-	var out *foundation.IReference
+func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetPlaybackType() (*IReferenceMediaPlaybackType, error) {
+	var out *IReferenceMediaPlaybackType
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetPlaybackType,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IReference
+		uintptr(unsafe.Pointer(&out)), // out IReferenceMediaPlaybackType
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo", "get_PlaybackType")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetAutoRepeatMode() (*foundation.IReference, error) {
-	var out *foundation.IReference
+func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetAutoRepeatMode() (*IReferenceMediaPlaybackAutoRepeatMode, error) {
+	var out *IReferenceMediaPlaybackAutoRepeatMode
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetAutoRepeatMode,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IReference
+		uintptr(unsafe.Pointer(&out)), // out IReferenceMediaPlaybackAutoRepeatMode
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo", "get_AutoRepeatMode")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetPlaybackRate() (*foundation.IReference, error) {
-	var out *foundation.IReference
+func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetPlaybackRate() (*IReferenceDouble, error) {
+	var out *IReferenceDouble
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetPlaybackRate,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IReference
+		uintptr(unsafe.Pointer(&out)), // out IReferenceDouble
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo", "get_PlaybackRate")
 	}
 
 	return out, nil
 }
 
-func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetIsShuffleActive() (*foundation.IReference, error) {
-	var out *foundation.IReference
+func (v *iGlobalSystemMediaTransportControlsSessionPlaybackInfo) GetIsShuffleActive() (*IReferenceBoolean, error) {
+	var out *IReferenceBoolean
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetIsShuffleActive,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IReference
+		uintptr(unsafe.Pointer(&out)), // out IReferenceBoolean
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionPlaybackInfo", "get_IsShuffleActive")
 	}
 
 	return out, nil
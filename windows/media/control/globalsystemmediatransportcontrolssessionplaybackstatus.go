@@ -5,10 +5,23 @@
 //nolint:all
 package control
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameGlobalSystemMediaTransportControlsSessionPlaybackStatus string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGlobalSystemMediaTransportControlsSessionPlaybackStatus uint32 = 0x00070000
+
+// GlobalSystemMediaTransportControlsSessionPlaybackStatus was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type GlobalSystemMediaTransportControlsSessionPlaybackStatus int32
 
 const SignatureGlobalSystemMediaTransportControlsSessionPlaybackStatus string = "enum(Windows.Media.Control.GlobalSystemMediaTransportControlsSessionPlaybackStatus;i4)"
 
+func (v GlobalSystemMediaTransportControlsSessionPlaybackStatus) Signature() string {
+	return SignatureGlobalSystemMediaTransportControlsSessionPlaybackStatus
+}
+
 const (
 	GlobalSystemMediaTransportControlsSessionPlaybackStatusClosed   GlobalSystemMediaTransportControlsSessionPlaybackStatus = 0
 	GlobalSystemMediaTransportControlsSessionPlaybackStatusOpened   GlobalSystemMediaTransportControlsSessionPlaybackStatus = 1
@@ -17,3 +30,56 @@ const (
 	GlobalSystemMediaTransportControlsSessionPlaybackStatusPlaying  GlobalSystemMediaTransportControlsSessionPlaybackStatus = 4
 	GlobalSystemMediaTransportControlsSessionPlaybackStatusPaused   GlobalSystemMediaTransportControlsSessionPlaybackStatus = 5
 )
+
+var namesGlobalSystemMediaTransportControlsSessionPlaybackStatus = map[GlobalSystemMediaTransportControlsSessionPlaybackStatus]string{
+	GlobalSystemMediaTransportControlsSessionPlaybackStatusClosed:   "Closed",
+	GlobalSystemMediaTransportControlsSessionPlaybackStatusOpened:   "Opened",
+	GlobalSystemMediaTransportControlsSessionPlaybackStatusChanging: "Changing",
+	GlobalSystemMediaTransportControlsSessionPlaybackStatusStopped:  "Stopped",
+	GlobalSystemMediaTransportControlsSessionPlaybackStatusPlaying:  "Playing",
+	GlobalSystemMediaTransportControlsSessionPlaybackStatusPaused:   "Paused",
+}
+
+var valuesGlobalSystemMediaTransportControlsSessionPlaybackStatus = map[string]GlobalSystemMediaTransportControlsSessionPlaybackStatus{
+	"Closed":   GlobalSystemMediaTransportControlsSessionPlaybackStatusClosed,
+	"Opened":   GlobalSystemMediaTransportControlsSessionPlaybackStatusOpened,
+	"Changing": GlobalSystemMediaTransportControlsSessionPlaybackStatusChanging,
+	"Stopped":  GlobalSystemMediaTransportControlsSessionPlaybackStatusStopped,
+	"Playing":  GlobalSystemMediaTransportControlsSessionPlaybackStatusPlaying,
+	"Paused":   GlobalSystemMediaTransportControlsSessionPlaybackStatusPaused,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v GlobalSystemMediaTransportControlsSessionPlaybackStatus) String() string {
+	if name, ok := namesGlobalSystemMediaTransportControlsSessionPlaybackStatus[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseGlobalSystemMediaTransportControlsSessionPlaybackStatus parses the name of a value, as returned by String.
+func ParseGlobalSystemMediaTransportControlsSessionPlaybackStatus(s string) (GlobalSystemMediaTransportControlsSessionPlaybackStatus, error) {
+	if v, ok := valuesGlobalSystemMediaTransportControlsSessionPlaybackStatus[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid GlobalSystemMediaTransportControlsSessionPlaybackStatus %q", s)
+	}
+	return GlobalSystemMediaTransportControlsSessionPlaybackStatus(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v GlobalSystemMediaTransportControlsSessionPlaybackStatus) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *GlobalSystemMediaTransportControlsSessionPlaybackStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseGlobalSystemMediaTransportControlsSessionPlaybackStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -6,56 +6,101 @@
 package control
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureGlobalSystemMediaTransportControlsSessionTimelineProperties string = "rc(Windows.Media.Control.GlobalSystemMediaTransportControlsSessionTimelineProperties;{ede34136-6f25-588d-8ecf-ea5b6735aaa5})"
 
+const ContractNameGlobalSystemMediaTransportControlsSessionTimelineProperties string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionGlobalSystemMediaTransportControlsSessionTimelineProperties uint32 = 0x00070000
+
+// GlobalSystemMediaTransportControlsSessionTimelineProperties was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type GlobalSystemMediaTransportControlsSessionTimelineProperties struct {
 	ole.IUnknown
 }
 
+func (impl *GlobalSystemMediaTransportControlsSessionTimelineProperties) Signature() string {
+	return SignatureGlobalSystemMediaTransportControlsSessionTimelineProperties
+}
+
+// IsPresent reports whether the Windows.Media.Control.GlobalSystemMediaTransportControlsSessionTimelineProperties class is available in the running version of Windows.
+func (impl *GlobalSystemMediaTransportControlsSessionTimelineProperties) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Media.Control.GlobalSystemMediaTransportControlsSessionTimelineProperties")
+}
+
+// IsMethodPresent reports whether the given method or static function of GlobalSystemMediaTransportControlsSessionTimelineProperties, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *GlobalSystemMediaTransportControlsSessionTimelineProperties) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetStartTime", "GetEndTime", "GetMinSeekTime", "GetMaxSeekTime", "GetPosition", "GetLastUpdatedTime":
+		return metadata.ApiInformationIsTypePresent("Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties")
+	}
+	return false, fmt.Errorf("unknown method %q of GlobalSystemMediaTransportControlsSessionTimelineProperties", name)
+}
+
 func (impl *GlobalSystemMediaTransportControlsSessionTimelineProperties) GetStartTime() (foundation.TimeSpan, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionTimelineProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionTimelineProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties")
+	if err != nil {
+		return foundation.TimeSpan{}, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionTimelineProperties)(unsafe.Pointer(itf))
 	return v.GetStartTime()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionTimelineProperties) GetEndTime() (foundation.TimeSpan, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionTimelineProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionTimelineProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties")
+	if err != nil {
+		return foundation.TimeSpan{}, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionTimelineProperties)(unsafe.Pointer(itf))
 	return v.GetEndTime()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionTimelineProperties) GetMinSeekTime() (foundation.TimeSpan, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionTimelineProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionTimelineProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties")
+	if err != nil {
+		return foundation.TimeSpan{}, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionTimelineProperties)(unsafe.Pointer(itf))
 	return v.GetMinSeekTime()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionTimelineProperties) GetMaxSeekTime() (foundation.TimeSpan, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionTimelineProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionTimelineProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties")
+	if err != nil {
+		return foundation.TimeSpan{}, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionTimelineProperties)(unsafe.Pointer(itf))
 	return v.GetMaxSeekTime()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionTimelineProperties) GetPosition() (foundation.TimeSpan, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionTimelineProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionTimelineProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties")
+	if err != nil {
+		return foundation.TimeSpan{}, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionTimelineProperties)(unsafe.Pointer(itf))
 	return v.GetPosition()
 }
 
 func (impl *GlobalSystemMediaTransportControlsSessionTimelineProperties) GetLastUpdatedTime() (foundation.DateTime, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDiGlobalSystemMediaTransportControlsSessionTimelineProperties))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDiGlobalSystemMediaTransportControlsSessionTimelineProperties, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties")
+	if err != nil {
+		return foundation.DateTime{}, err
+	}
 	defer itf.Release()
 	v := (*iGlobalSystemMediaTransportControlsSessionTimelineProperties)(unsafe.Pointer(itf))
 	return v.GetLastUpdatedTime()
@@ -64,10 +109,20 @@ func (impl *GlobalSystemMediaTransportControlsSessionTimelineProperties) GetLast
 const GUIDiGlobalSystemMediaTransportControlsSessionTimelineProperties string = "ede34136-6f25-588d-8ecf-ea5b6735aaa5"
 const SignatureiGlobalSystemMediaTransportControlsSessionTimelineProperties string = "{ede34136-6f25-588d-8ecf-ea5b6735aaa5}"
 
+var IIDiGlobalSystemMediaTransportControlsSessionTimelineProperties = ole.GUID{Data1: 0xEDE34136, Data2: 0x6F25, Data3: 0x588D, Data4: [8]byte{0x8E, 0xCF, 0xEA, 0x5B, 0x67, 0x35, 0xAA, 0xA5}}
+
+const ContractNameiGlobalSystemMediaTransportControlsSessionTimelineProperties string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniGlobalSystemMediaTransportControlsSessionTimelineProperties uint32 = 0x00070000
+
+// iGlobalSystemMediaTransportControlsSessionTimelineProperties was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iGlobalSystemMediaTransportControlsSessionTimelineProperties struct {
 	ole.IInspectable
 }
 
+func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) Signature() string {
+	return SignatureiGlobalSystemMediaTransportControlsSessionTimelineProperties
+}
+
 type iGlobalSystemMediaTransportControlsSessionTimelinePropertiesVtbl struct {
 	ole.IInspectableVtbl
 
@@ -85,6 +140,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) VTable()
 
 func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetStartTime() (foundation.TimeSpan, error) {
 	var out foundation.TimeSpan
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetStartTime,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -92,7 +151,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetStartT
 	)
 
 	if hr != 0 {
-		return foundation.TimeSpan{}, ole.NewError(hr)
+		return foundation.TimeSpan{}, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties", "get_StartTime")
 	}
 
 	return out, nil
@@ -100,6 +159,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetStartT
 
 func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetEndTime() (foundation.TimeSpan, error) {
 	var out foundation.TimeSpan
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetEndTime,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -107,7 +170,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetEndTim
 	)
 
 	if hr != 0 {
-		return foundation.TimeSpan{}, ole.NewError(hr)
+		return foundation.TimeSpan{}, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties", "get_EndTime")
 	}
 
 	return out, nil
@@ -115,6 +178,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetEndTim
 
 func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetMinSeekTime() (foundation.TimeSpan, error) {
 	var out foundation.TimeSpan
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetMinSeekTime,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -122,7 +189,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetMinSee
 	)
 
 	if hr != 0 {
-		return foundation.TimeSpan{}, ole.NewError(hr)
+		return foundation.TimeSpan{}, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties", "get_MinSeekTime")
 	}
 
 	return out, nil
@@ -130,6 +197,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetMinSee
 
 func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetMaxSeekTime() (foundation.TimeSpan, error) {
 	var out foundation.TimeSpan
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetMaxSeekTime,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -137,7 +208,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetMaxSee
 	)
 
 	if hr != 0 {
-		return foundation.TimeSpan{}, ole.NewError(hr)
+		return foundation.TimeSpan{}, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties", "get_MaxSeekTime")
 	}
 
 	return out, nil
@@ -145,6 +216,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetMaxSee
 
 func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetPosition() (foundation.TimeSpan, error) {
 	var out foundation.TimeSpan
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetPosition,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -152,7 +227,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetPositi
 	)
 
 	if hr != 0 {
-		return foundation.TimeSpan{}, ole.NewError(hr)
+		return foundation.TimeSpan{}, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties", "get_Position")
 	}
 
 	return out, nil
@@ -160,6 +235,10 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetPositi
 
 func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetLastUpdatedTime() (foundation.DateTime, error) {
 	var out foundation.DateTime
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetLastUpdatedTime,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -167,7 +246,7 @@ func (v *iGlobalSystemMediaTransportControlsSessionTimelineProperties) GetLastUp
 	)
 
 	if hr != 0 {
-		return foundation.DateTime{}, ole.NewError(hr)
+		return foundation.DateTime{}, winrt.NewError(hr, "Windows.Media.Control.IGlobalSystemMediaTransportControlsSessionTimelineProperties", "get_LastUpdatedTime")
 	}
 
 	return out, nil
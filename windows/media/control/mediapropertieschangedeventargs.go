@@ -6,24 +6,55 @@
 package control
 
 import (
+	"fmt"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureMediaPropertiesChangedEventArgs string = "rc(Windows.Media.Control.MediaPropertiesChangedEventArgs;{7d3741cb-adf0-5cef-91ba-cfabcdd77678})"
 
+const ContractNameMediaPropertiesChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionMediaPropertiesChangedEventArgs uint32 = 0x00070000
+
+// MediaPropertiesChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type MediaPropertiesChangedEventArgs struct {
 	ole.IUnknown
 }
 
+func (impl *MediaPropertiesChangedEventArgs) Signature() string {
+	return SignatureMediaPropertiesChangedEventArgs
+}
+
+// IsPresent reports whether the Windows.Media.Control.MediaPropertiesChangedEventArgs class is available in the running version of Windows.
+func (impl *MediaPropertiesChangedEventArgs) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Media.Control.MediaPropertiesChangedEventArgs")
+}
+
+// IsMethodPresent reports whether the given method or static function of MediaPropertiesChangedEventArgs, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *MediaPropertiesChangedEventArgs) IsMethodPresent(name string) (bool, error) {
+	return false, fmt.Errorf("unknown method %q of MediaPropertiesChangedEventArgs", name)
+}
+
 const GUIDiMediaPropertiesChangedEventArgs string = "7d3741cb-adf0-5cef-91ba-cfabcdd77678"
 const SignatureiMediaPropertiesChangedEventArgs string = "{7d3741cb-adf0-5cef-91ba-cfabcdd77678}"
 
+var IIDiMediaPropertiesChangedEventArgs = ole.GUID{Data1: 0x7D3741CB, Data2: 0xADF0, Data3: 0x5CEF, Data4: [8]byte{0x91, 0xBA, 0xCF, 0xAB, 0xCD, 0xD7, 0x76, 0x78}}
+
+const ContractNameiMediaPropertiesChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniMediaPropertiesChangedEventArgs uint32 = 0x00070000
+
+// iMediaPropertiesChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iMediaPropertiesChangedEventArgs struct {
 	ole.IInspectable
 }
 
+func (v *iMediaPropertiesChangedEventArgs) Signature() string {
+	return SignatureiMediaPropertiesChangedEventArgs
+}
+
 type iMediaPropertiesChangedEventArgsVtbl struct {
 	ole.IInspectableVtbl
 }
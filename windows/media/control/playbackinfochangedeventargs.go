@@ -6,24 +6,55 @@
 package control
 
 import (
+	"fmt"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignaturePlaybackInfoChangedEventArgs string = "rc(Windows.Media.Control.PlaybackInfoChangedEventArgs;{786756c2-bc0d-50a5-8807-054291fef139})"
 
+const ContractNamePlaybackInfoChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionPlaybackInfoChangedEventArgs uint32 = 0x00070000
+
+// PlaybackInfoChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type PlaybackInfoChangedEventArgs struct {
 	ole.IUnknown
 }
 
+func (impl *PlaybackInfoChangedEventArgs) Signature() string {
+	return SignaturePlaybackInfoChangedEventArgs
+}
+
+// IsPresent reports whether the Windows.Media.Control.PlaybackInfoChangedEventArgs class is available in the running version of Windows.
+func (impl *PlaybackInfoChangedEventArgs) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Media.Control.PlaybackInfoChangedEventArgs")
+}
+
+// IsMethodPresent reports whether the given method or static function of PlaybackInfoChangedEventArgs, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *PlaybackInfoChangedEventArgs) IsMethodPresent(name string) (bool, error) {
+	return false, fmt.Errorf("unknown method %q of PlaybackInfoChangedEventArgs", name)
+}
+
 const GUIDiPlaybackInfoChangedEventArgs string = "786756c2-bc0d-50a5-8807-054291fef139"
 const SignatureiPlaybackInfoChangedEventArgs string = "{786756c2-bc0d-50a5-8807-054291fef139}"
 
+var IIDiPlaybackInfoChangedEventArgs = ole.GUID{Data1: 0x786756C2, Data2: 0xBC0D, Data3: 0x50A5, Data4: [8]byte{0x88, 0x07, 0x05, 0x42, 0x91, 0xFE, 0xF1, 0x39}}
+
+const ContractNameiPlaybackInfoChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniPlaybackInfoChangedEventArgs uint32 = 0x00070000
+
+// iPlaybackInfoChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iPlaybackInfoChangedEventArgs struct {
 	ole.IInspectable
 }
 
+func (v *iPlaybackInfoChangedEventArgs) Signature() string {
+	return SignatureiPlaybackInfoChangedEventArgs
+}
+
 type iPlaybackInfoChangedEventArgsVtbl struct {
 	ole.IInspectableVtbl
 }
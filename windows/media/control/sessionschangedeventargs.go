@@ -6,24 +6,55 @@
 package control
 
 import (
+	"fmt"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureSessionsChangedEventArgs string = "rc(Windows.Media.Control.SessionsChangedEventArgs;{bbf0cd32-42c4-5a58-b317-f34bbfbd26e0})"
 
+const ContractNameSessionsChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionSessionsChangedEventArgs uint32 = 0x00070000
+
+// SessionsChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type SessionsChangedEventArgs struct {
 	ole.IUnknown
 }
 
+func (impl *SessionsChangedEventArgs) Signature() string {
+	return SignatureSessionsChangedEventArgs
+}
+
+// IsPresent reports whether the Windows.Media.Control.SessionsChangedEventArgs class is available in the running version of Windows.
+func (impl *SessionsChangedEventArgs) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Media.Control.SessionsChangedEventArgs")
+}
+
+// IsMethodPresent reports whether the given method or static function of SessionsChangedEventArgs, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *SessionsChangedEventArgs) IsMethodPresent(name string) (bool, error) {
+	return false, fmt.Errorf("unknown method %q of SessionsChangedEventArgs", name)
+}
+
 const GUIDiSessionsChangedEventArgs string = "bbf0cd32-42c4-5a58-b317-f34bbfbd26e0"
 const SignatureiSessionsChangedEventArgs string = "{bbf0cd32-42c4-5a58-b317-f34bbfbd26e0}"
 
+var IIDiSessionsChangedEventArgs = ole.GUID{Data1: 0xBBF0CD32, Data2: 0x42C4, Data3: 0x5A58, Data4: [8]byte{0xB3, 0x17, 0xF3, 0x4B, 0xBF, 0xBD, 0x26, 0xE0}}
+
+const ContractNameiSessionsChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniSessionsChangedEventArgs uint32 = 0x00070000
+
+// iSessionsChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iSessionsChangedEventArgs struct {
 	ole.IInspectable
 }
 
+func (v *iSessionsChangedEventArgs) Signature() string {
+	return SignatureiSessionsChangedEventArgs
+}
+
 type iSessionsChangedEventArgsVtbl struct {
 	ole.IInspectableVtbl
 }
@@ -6,24 +6,55 @@
 package control
 
 import (
+	"fmt"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureTimelinePropertiesChangedEventArgs string = "rc(Windows.Media.Control.TimelinePropertiesChangedEventArgs;{29033a2f-c923-5a77-bcaf-055ff415ad32})"
 
+const ContractNameTimelinePropertiesChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionTimelinePropertiesChangedEventArgs uint32 = 0x00070000
+
+// TimelinePropertiesChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type TimelinePropertiesChangedEventArgs struct {
 	ole.IUnknown
 }
 
+func (impl *TimelinePropertiesChangedEventArgs) Signature() string {
+	return SignatureTimelinePropertiesChangedEventArgs
+}
+
+// IsPresent reports whether the Windows.Media.Control.TimelinePropertiesChangedEventArgs class is available in the running version of Windows.
+func (impl *TimelinePropertiesChangedEventArgs) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Media.Control.TimelinePropertiesChangedEventArgs")
+}
+
+// IsMethodPresent reports whether the given method or static function of TimelinePropertiesChangedEventArgs, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *TimelinePropertiesChangedEventArgs) IsMethodPresent(name string) (bool, error) {
+	return false, fmt.Errorf("unknown method %q of TimelinePropertiesChangedEventArgs", name)
+}
+
 const GUIDiTimelinePropertiesChangedEventArgs string = "29033a2f-c923-5a77-bcaf-055ff415ad32"
 const SignatureiTimelinePropertiesChangedEventArgs string = "{29033a2f-c923-5a77-bcaf-055ff415ad32}"
 
+var IIDiTimelinePropertiesChangedEventArgs = ole.GUID{Data1: 0x29033A2F, Data2: 0xC923, Data3: 0x5A77, Data4: [8]byte{0xBC, 0xAF, 0x05, 0x5F, 0xF4, 0x15, 0xAD, 0x32}}
+
+const ContractNameiTimelinePropertiesChangedEventArgs string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniTimelinePropertiesChangedEventArgs uint32 = 0x00070000
+
+// iTimelinePropertiesChangedEventArgs was introduced in Windows.Foundation.UniversalApiContract v7.0.
 type iTimelinePropertiesChangedEventArgs struct {
 	ole.IInspectable
 }
 
+func (v *iTimelinePropertiesChangedEventArgs) Signature() string {
+	return SignatureiTimelinePropertiesChangedEventArgs
+}
+
 type iTimelinePropertiesChangedEventArgsVtbl struct {
 	ole.IInspectableVtbl
 }
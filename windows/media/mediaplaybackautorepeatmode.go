@@ -5,12 +5,72 @@
 //nolint:all
 package media
 
+import (
+	"fmt"
+	"strconv"
+)
+
+const ContractNameMediaPlaybackAutoRepeatMode string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionMediaPlaybackAutoRepeatMode uint32 = 0x00010000
+
+// MediaPlaybackAutoRepeatMode was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type MediaPlaybackAutoRepeatMode int32
 
 const SignatureMediaPlaybackAutoRepeatMode string = "enum(Windows.Media.MediaPlaybackAutoRepeatMode;i4)"
 
+func (v MediaPlaybackAutoRepeatMode) Signature() string {
+	return SignatureMediaPlaybackAutoRepeatMode
+}
+
 const (
 	MediaPlaybackAutoRepeatModeNone  MediaPlaybackAutoRepeatMode = 0
 	MediaPlaybackAutoRepeatModeTrack MediaPlaybackAutoRepeatMode = 1
 	MediaPlaybackAutoRepeatModeList  MediaPlaybackAutoRepeatMode = 2
 )
+
+var namesMediaPlaybackAutoRepeatMode = map[MediaPlaybackAutoRepeatMode]string{
+	MediaPlaybackAutoRepeatModeNone:  "None",
+	MediaPlaybackAutoRepeatModeTrack: "Track",
+	MediaPlaybackAutoRepeatModeList:  "List",
+}
+
+var valuesMediaPlaybackAutoRepeatMode = map[string]MediaPlaybackAutoRepeatMode{
+	"None":  MediaPlaybackAutoRepeatModeNone,
+	"Track": MediaPlaybackAutoRepeatModeTrack,
+	"List":  MediaPlaybackAutoRepeatModeList,
+}
+
+// String returns the name of v, or its numeric value if it is unknown.
+func (v MediaPlaybackAutoRepeatMode) String() string {
+	if name, ok := namesMediaPlaybackAutoRepeatMode[v]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// ParseMediaPlaybackAutoRepeatMode parses the name of a value, as returned by String.
+func ParseMediaPlaybackAutoRepeatMode(s string) (MediaPlaybackAutoRepeatMode, error) {
+	if v, ok := valuesMediaPlaybackAutoRepeatMode[s]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid MediaPlaybackAutoRepeatMode %q", s)
+	}
+	return MediaPlaybackAutoRepeatMode(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v MediaPlaybackAutoRepeatMode) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *MediaPlaybackAutoRepeatMode) UnmarshalText(text []byte) error {
+	parsed, err := ParseMediaPlaybackAutoRepeatMode(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
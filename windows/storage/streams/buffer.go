@@ -6,34 +6,72 @@
 package streams
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureBuffer string = "rc(Windows.Storage.Streams.Buffer;{905a0fe0-bc53-11df-8c49-001e4fc686da})"
 
+const ContractNameBuffer string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionBuffer uint32 = 0x00010000
+
+// Buffer was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type Buffer struct {
 	ole.IUnknown
 }
 
+func (impl *Buffer) Signature() string {
+	return SignatureBuffer
+}
+
+// IsPresent reports whether the Windows.Storage.Streams.Buffer class is available in the running version of Windows.
+func (impl *Buffer) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.Buffer")
+}
+
+// IsMethodPresent reports whether the given method or static function of Buffer, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *Buffer) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetCapacity", "GetLength", "SetLength":
+		return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.IBuffer")
+	case "BufferCreate":
+		return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.IBufferFactory")
+	}
+	return false, fmt.Errorf("unknown method %q of Buffer", name)
+}
+
 func (impl *Buffer) GetCapacity() (uint32, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDIBuffer))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDIBuffer, "Windows.Storage.Streams.IBuffer")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*IBuffer)(unsafe.Pointer(itf))
 	return v.GetCapacity()
 }
 
 func (impl *Buffer) GetLength() (uint32, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDIBuffer))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDIBuffer, "Windows.Storage.Streams.IBuffer")
+	if err != nil {
+		return 0, err
+	}
 	defer itf.Release()
 	v := (*IBuffer)(unsafe.Pointer(itf))
 	return v.GetLength()
 }
 
 func (impl *Buffer) SetLength(value uint32) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDIBuffer))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDIBuffer, "Windows.Storage.Streams.IBuffer")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*IBuffer)(unsafe.Pointer(itf))
 	return v.SetLength(value)
@@ -42,10 +80,20 @@ func (impl *Buffer) SetLength(value uint32) error {
 const GUIDiBufferFactory string = "71af914d-c10f-484b-bc50-14bc623b3a27"
 const SignatureiBufferFactory string = "{71af914d-c10f-484b-bc50-14bc623b3a27}"
 
+var IIDiBufferFactory = ole.GUID{Data1: 0x71AF914D, Data2: 0xC10F, Data3: 0x484B, Data4: [8]byte{0xBC, 0x50, 0x14, 0xBC, 0x62, 0x3B, 0x3A, 0x27}}
+
+const ContractNameiBufferFactory string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniBufferFactory uint32 = 0x00010000
+
+// iBufferFactory was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iBufferFactory struct {
 	ole.IInspectable
 }
 
+func (v *iBufferFactory) Signature() string {
+	return SignatureiBufferFactory
+}
+
 type iBufferFactoryVtbl struct {
 	ole.IInspectableVtbl
 
@@ -57,13 +105,18 @@ func (v *iBufferFactory) VTable() *iBufferFactoryVtbl {
 }
 
 func BufferCreate(capacity uint32) (*Buffer, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Storage.Streams.Buffer", ole.NewGUID(GUIDiBufferFactory))
+	inspectable, err := winrt.GetActivationFactory("Windows.Storage.Streams.Buffer", &IIDiBufferFactory)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iBufferFactory)(unsafe.Pointer(inspectable))
 
 	var out *Buffer
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().BufferCreate,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -72,7 +125,7 @@ func BufferCreate(capacity uint32) (*Buffer, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Storage.Streams.IBufferFactory", "Create")
 	}
 
 	return out, nil
@@ -6,20 +6,52 @@
 package streams
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureDataReader string = "rc(Windows.Storage.Streams.DataReader;{e2b50029-b4c1-4314-a4b8-fb813a2f275e})"
 
+const ContractNameDataReader string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionDataReader uint32 = 0x00010000
+
+// DataReader was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type DataReader struct {
 	ole.IUnknown
 }
 
+func (impl *DataReader) Signature() string {
+	return SignatureDataReader
+}
+
+// IsPresent reports whether the Windows.Storage.Streams.DataReader class is available in the running version of Windows.
+func (impl *DataReader) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.DataReader")
+}
+
+// IsMethodPresent reports whether the given method or static function of DataReader, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *DataReader) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "ReadBytes":
+		return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.IDataReader")
+	case "DataReaderFromBuffer":
+		return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.IDataReaderStatics")
+	}
+	return false, fmt.Errorf("unknown method %q of DataReader", name)
+}
+
 func (impl *DataReader) ReadBytes(valueSize uint32) ([]uint8, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDIDataReader))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDIDataReader, "Windows.Storage.Streams.IDataReader")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*IDataReader)(unsafe.Pointer(itf))
 	return v.ReadBytes(valueSize)
@@ -28,10 +60,20 @@ func (impl *DataReader) ReadBytes(valueSize uint32) ([]uint8, error) {
 const GUIDiDataReaderStatics string = "11fcbfc8-f93a-471b-b121-f379e349313c"
 const SignatureiDataReaderStatics string = "{11fcbfc8-f93a-471b-b121-f379e349313c}"
 
+var IIDiDataReaderStatics = ole.GUID{Data1: 0x11FCBFC8, Data2: 0xF93A, Data3: 0x471B, Data4: [8]byte{0xB1, 0x21, 0xF3, 0x79, 0xE3, 0x49, 0x31, 0x3C}}
+
+const ContractNameiDataReaderStatics string = "Windows.Foundation.UniversalApiContract"
+const ContractVersioniDataReaderStatics uint32 = 0x00010000
+
+// iDataReaderStatics was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type iDataReaderStatics struct {
 	ole.IInspectable
 }
 
+func (v *iDataReaderStatics) Signature() string {
+	return SignatureiDataReaderStatics
+}
+
 type iDataReaderStaticsVtbl struct {
 	ole.IInspectableVtbl
 
@@ -43,13 +85,18 @@ func (v *iDataReaderStatics) VTable() *iDataReaderStaticsVtbl {
 }
 
 func DataReaderFromBuffer(buffer *IBuffer) (*DataReader, error) {
-	inspectable, err := ole.RoGetActivationFactory("Windows.Storage.Streams.DataReader", ole.NewGUID(GUIDiDataReaderStatics))
+	inspectable, err := winrt.GetActivationFactory("Windows.Storage.Streams.DataReader", &IIDiDataReaderStatics)
 	if err != nil {
 		return nil, err
 	}
+	defer inspectable.Release()
 	v := (*iDataReaderStatics)(unsafe.Pointer(inspectable))
 
 	var out *DataReader
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().DataReaderFromBuffer,
 		uintptr(unsafe.Pointer(v)),      // this
@@ -58,7 +105,7 @@ func DataReaderFromBuffer(buffer *IBuffer) (*DataReader, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Storage.Streams.IDataReaderStatics", "FromBuffer")
 	}
 
 	return out, nil
@@ -6,20 +6,48 @@
 package streams
 
 import (
+	"fmt"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
 	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const SignatureDataWriter string = "rc(Windows.Storage.Streams.DataWriter;{64b89265-d341-4922-b38a-dd4af8808c4e})"
 
+const ContractNameDataWriter string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionDataWriter uint32 = 0x00010000
+
+// DataWriter was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type DataWriter struct {
 	ole.IUnknown
 }
 
+func (impl *DataWriter) Signature() string {
+	return SignatureDataWriter
+}
+
+// IsPresent reports whether the Windows.Storage.Streams.DataWriter class is available in the running version of Windows.
+func (impl *DataWriter) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.DataWriter")
+}
+
+// IsMethodPresent reports whether the given method or static function of DataWriter, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (impl *DataWriter) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "WriteBytes", "DetachBuffer":
+		return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.IDataWriter")
+	case "Close":
+		return metadata.ApiInformationIsTypePresent("Windows.Foundation.IClosable")
+	}
+	return false, fmt.Errorf("unknown method %q of DataWriter", name)
+}
+
 func NewDataWriter() (*DataWriter, error) {
-	inspectable, err := ole.RoActivateInstance("Windows.Storage.Streams.DataWriter")
+	inspectable, err := winrt.ActivateInstance("Windows.Storage.Streams.DataWriter")
 	if err != nil {
 		return nil, err
 	}
@@ -27,21 +55,30 @@ func NewDataWriter() (*DataWriter, error) {
 }
 
 func (impl *DataWriter) WriteBytes(valueSize uint32, value []uint8) error {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDIDataWriter))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDIDataWriter, "Windows.Storage.Streams.IDataWriter")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*IDataWriter)(unsafe.Pointer(itf))
 	return v.WriteBytes(valueSize, value)
 }
 
 func (impl *DataWriter) DetachBuffer() (*IBuffer, error) {
-	itf := impl.MustQueryInterface(ole.NewGUID(GUIDIDataWriter))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &IIDIDataWriter, "Windows.Storage.Streams.IDataWriter")
+	if err != nil {
+		return nil, err
+	}
 	defer itf.Release()
 	v := (*IDataWriter)(unsafe.Pointer(itf))
 	return v.DetachBuffer()
 }
 
 func (impl *DataWriter) Close() error {
-	itf := impl.MustQueryInterface(ole.NewGUID(foundation.GUIDIClosable))
+	itf, err := winrt.QueryInterface(&impl.IUnknown, &foundation.IIDIClosable, "Windows.Foundation.IClosable")
+	if err != nil {
+		return err
+	}
 	defer itf.Release()
 	v := (*foundation.IClosable)(unsafe.Pointer(itf))
 	return v.Close()
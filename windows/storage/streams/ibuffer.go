@@ -6,19 +6,33 @@
 package streams
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const GUIDIBuffer string = "905a0fe0-bc53-11df-8c49-001e4fc686da"
 const SignatureIBuffer string = "{905a0fe0-bc53-11df-8c49-001e4fc686da}"
 
+var IIDIBuffer = ole.GUID{Data1: 0x905A0FE0, Data2: 0xBC53, Data3: 0x11DF, Data4: [8]byte{0x8C, 0x49, 0x00, 0x1E, 0x4F, 0xC6, 0x86, 0xDA}}
+
+const ContractNameIBuffer string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionIBuffer uint32 = 0x00010000
+
+// IBuffer was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type IBuffer struct {
 	ole.IInspectable
 }
 
+func (v *IBuffer) Signature() string {
+	return SignatureIBuffer
+}
+
 type IBufferVtbl struct {
 	ole.IInspectableVtbl
 
@@ -31,8 +45,27 @@ func (v *IBuffer) VTable() *IBufferVtbl {
 	return (*IBufferVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsPresent reports whether the Windows.Storage.Streams.IBuffer interface is available in the running version of Windows.
+func (v *IBuffer) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.IBuffer")
+}
+
+// IsMethodPresent reports whether the given method of IBuffer, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (v *IBuffer) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "GetCapacity", "GetLength", "SetLength":
+		return v.IsPresent()
+	}
+	return false, fmt.Errorf("unknown method %q of IBuffer", name)
+}
+
 func (v *IBuffer) GetCapacity() (uint32, error) {
 	var out uint32
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetCapacity,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -40,7 +73,7 @@ func (v *IBuffer) GetCapacity() (uint32, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Storage.Streams.IBuffer", "get_Capacity")
 	}
 
 	return out, nil
@@ -48,6 +81,10 @@ func (v *IBuffer) GetCapacity() (uint32, error) {
 
 func (v *IBuffer) GetLength() (uint32, error) {
 	var out uint32
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().GetLength,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -55,13 +92,17 @@ func (v *IBuffer) GetLength() (uint32, error) {
 	)
 
 	if hr != 0 {
-		return 0, ole.NewError(hr)
+		return 0, winrt.NewError(hr, "Windows.Storage.Streams.IBuffer", "get_Length")
 	}
 
 	return out, nil
 }
 
 func (v *IBuffer) SetLength(value uint32) error {
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().SetLength,
 		uintptr(unsafe.Pointer(v)), // this
@@ -69,7 +110,7 @@ func (v *IBuffer) SetLength(value uint32) error {
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Storage.Streams.IBuffer", "put_Length")
 	}
 
 	return nil
@@ -6,19 +6,33 @@
 package streams
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const GUIDIDataReader string = "e2b50029-b4c1-4314-a4b8-fb813a2f275e"
 const SignatureIDataReader string = "{e2b50029-b4c1-4314-a4b8-fb813a2f275e}"
 
+var IIDIDataReader = ole.GUID{Data1: 0xE2B50029, Data2: 0xB4C1, Data3: 0x4314, Data4: [8]byte{0xA4, 0xB8, 0xFB, 0x81, 0x3A, 0x2F, 0x27, 0x5E}}
+
+const ContractNameIDataReader string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionIDataReader uint32 = 0x00010000
+
+// IDataReader was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type IDataReader struct {
 	ole.IInspectable
 }
 
+func (v *IDataReader) Signature() string {
+	return SignatureIDataReader
+}
+
 type IDataReaderVtbl struct {
 	ole.IInspectableVtbl
 
@@ -54,17 +68,37 @@ func (v *IDataReader) VTable() *IDataReaderVtbl {
 	return (*IDataReaderVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsPresent reports whether the Windows.Storage.Streams.IDataReader interface is available in the running version of Windows.
+func (v *IDataReader) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.IDataReader")
+}
+
+// IsMethodPresent reports whether the given method of IDataReader, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (v *IDataReader) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "ReadBytes":
+		return v.IsPresent()
+	}
+	return false, fmt.Errorf("unknown method %q of IDataReader", name)
+}
+
 func (v *IDataReader) ReadBytes(valueSize uint32) ([]uint8, error) {
 	var value []uint8 = make([]uint8, valueSize)
+	valuePtr := winrt.ArrayPointer(value)
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().ReadBytes,
-		uintptr(unsafe.Pointer(v)),         // this
-		uintptr(valueSize),                 // in uint32
-		uintptr(unsafe.Pointer(&value[0])), // out uint8
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(valueSize),         // in uint32
+		uintptr(valuePtr),          // out uint8
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Storage.Streams.IDataReader", "ReadBytes")
 	}
 
 	return value, nil
@@ -6,19 +6,33 @@
 package streams
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const GUIDIDataWriter string = "64b89265-d341-4922-b38a-dd4af8808c4e"
 const SignatureIDataWriter string = "{64b89265-d341-4922-b38a-dd4af8808c4e}"
 
+var IIDIDataWriter = ole.GUID{Data1: 0x64B89265, Data2: 0xD341, Data3: 0x4922, Data4: [8]byte{0xB3, 0x8A, 0xDD, 0x4A, 0xF8, 0x80, 0x8C, 0x4E}}
+
+const ContractNameIDataWriter string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionIDataWriter uint32 = 0x00010000
+
+// IDataWriter was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type IDataWriter struct {
 	ole.IInspectable
 }
 
+func (v *IDataWriter) Signature() string {
+	return SignatureIDataWriter
+}
+
 type IDataWriterVtbl struct {
 	ole.IInspectableVtbl
 
@@ -55,16 +69,36 @@ func (v *IDataWriter) VTable() *IDataWriterVtbl {
 	return (*IDataWriterVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
+// IsPresent reports whether the Windows.Storage.Streams.IDataWriter interface is available in the running version of Windows.
+func (v *IDataWriter) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.IDataWriter")
+}
+
+// IsMethodPresent reports whether the given method of IDataWriter, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (v *IDataWriter) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "WriteBytes", "DetachBuffer":
+		return v.IsPresent()
+	}
+	return false, fmt.Errorf("unknown method %q of IDataWriter", name)
+}
+
 func (v *IDataWriter) WriteBytes(valueSize uint32, value []uint8) error {
+	valuePtr := winrt.ArrayPointer(value)
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().WriteBytes,
-		uintptr(unsafe.Pointer(v)),         // this
-		uintptr(valueSize),                 // in uint32
-		uintptr(unsafe.Pointer(&value[0])), // in uint8
+		uintptr(unsafe.Pointer(v)), // this
+		uintptr(valueSize),         // in uint32
+		uintptr(valuePtr),          // in uint8
 	)
 
 	if hr != 0 {
-		return ole.NewError(hr)
+		return winrt.NewError(hr, "Windows.Storage.Streams.IDataWriter", "WriteBytes")
 	}
 
 	return nil
@@ -72,6 +106,10 @@ func (v *IDataWriter) WriteBytes(valueSize uint32, value []uint8) error {
 
 func (v *IDataWriter) DetachBuffer() (*IBuffer, error) {
 	var out *IBuffer
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().DetachBuffer,
 		uintptr(unsafe.Pointer(v)),    // this
@@ -79,7 +117,7 @@ func (v *IDataWriter) DetachBuffer() (*IBuffer, error) {
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Storage.Streams.IDataWriter", "DetachBuffer")
 	}
 
 	return out, nil
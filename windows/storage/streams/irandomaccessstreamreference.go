@@ -6,20 +6,33 @@
 package streams
 
 import (
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/go-ole/go-ole"
-	"github.com/saltosystems/winrt-go/windows/foundation"
+	"github.com/saltosystems/winrt-go"
+	"github.com/saltosystems/winrt-go/windows/foundation/metadata"
 )
 
 const GUIDIRandomAccessStreamReference string = "33ee3134-1dd6-4e3a-8067-d1c162e8642b"
 const SignatureIRandomAccessStreamReference string = "{33ee3134-1dd6-4e3a-8067-d1c162e8642b}"
 
+var IIDIRandomAccessStreamReference = ole.GUID{Data1: 0x33EE3134, Data2: 0x1DD6, Data3: 0x4E3A, Data4: [8]byte{0x80, 0x67, 0xD1, 0xC1, 0x62, 0xE8, 0x64, 0x2B}}
+
+const ContractNameIRandomAccessStreamReference string = "Windows.Foundation.UniversalApiContract"
+const ContractVersionIRandomAccessStreamReference uint32 = 0x00010000
+
+// IRandomAccessStreamReference was introduced in Windows.Foundation.UniversalApiContract v1.0.
 type IRandomAccessStreamReference struct {
 	ole.IInspectable
 }
 
+func (v *IRandomAccessStreamReference) Signature() string {
+	return SignatureIRandomAccessStreamReference
+}
+
 type IRandomAccessStreamReferenceVtbl struct {
 	ole.IInspectableVtbl
 
@@ -30,16 +43,35 @@ func (v *IRandomAccessStreamReference) VTable() *IRandomAccessStreamReferenceVtb
 	return (*IRandomAccessStreamReferenceVtbl)(unsafe.Pointer(v.RawVTable))
 }
 
-func (v *IRandomAccessStreamReference) OpenReadAsync() (*foundation.IAsyncOperation, error) {
-	var out *foundation.IAsyncOperation
+// IsPresent reports whether the Windows.Storage.Streams.IRandomAccessStreamReference interface is available in the running version of Windows.
+func (v *IRandomAccessStreamReference) IsPresent() (bool, error) {
+	return metadata.ApiInformationIsTypePresent("Windows.Storage.Streams.IRandomAccessStreamReference")
+}
+
+// IsMethodPresent reports whether the given method of IRandomAccessStreamReference, identified by its Go name,
+// is available in the running version of Windows. It may be called using a nil receiver.
+func (v *IRandomAccessStreamReference) IsMethodPresent(name string) (bool, error) {
+	switch name {
+	case "OpenReadAsync":
+		return v.IsPresent()
+	}
+	return false, fmt.Errorf("unknown method %q of IRandomAccessStreamReference", name)
+}
+
+func (v *IRandomAccessStreamReference) OpenReadAsync() (*IAsyncOperationIRandomAccessStreamWithContentType, error) {
+	var out *IAsyncOperationIRandomAccessStreamWithContentType
+	// the restricted error information of a failed call is stored per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
 	hr, _, _ := syscall.SyscallN(
 		v.VTable().OpenReadAsync,
 		uintptr(unsafe.Pointer(v)),    // this
-		uintptr(unsafe.Pointer(&out)), // out foundation.IAsyncOperation
+		uintptr(unsafe.Pointer(&out)), // out IAsyncOperationIRandomAccessStreamWithContentType
 	)
 
 	if hr != 0 {
-		return nil, ole.NewError(hr)
+		return nil, winrt.NewError(hr, "Windows.Storage.Streams.IRandomAccessStreamReference", "OpenReadAsync")
 	}
 
 	return out, nil